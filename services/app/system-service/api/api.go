@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/config"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/handler"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/jobs"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/permission_catalog"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 
 	"github.com/jinguoxing/idrm-go-base/middleware"
@@ -48,12 +51,20 @@ func main() {
 	// 初始化服务上下文
 	ctx := svc.NewServiceContext(c)
 
+	// 初始化权限目录（仅补登记缺失的模块，不覆盖已编辑的内容）
+	permission_catalog.SeedFromFile(context.Background(), ctx, c.PermissionCatalog.SeedFile)
+
 	// 注册 Swagger 路由 (必须在其他路由之前)
 	handler.RegisterSwaggerHandlers(server)
 
 	// 注册路由
 	handler.RegisterHandlers(server, ctx)
 
+	// 启动异步任务 worker 池
+	jobs.RegisterRunners(ctx)
+	ctx.JobQueue.Start()
+	defer ctx.JobQueue.Stop()
+
 	fmt.Printf("启动 API 服务: %s:%d\n", c.Host, c.Port)
 	server.Start()
 }
@@ -0,0 +1,107 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// snapshotKeyPrefix 用户授权快照（authz:snapshot:{generation}:{userId}）
+	snapshotKeyPrefix = "authz:snapshot:"
+	// generationKey 全局缓存代数，权限模板或角色变更时递增，使全部用户快照失效
+	generationKey = "authz:generation"
+
+	// DefaultCacheTTL 授权快照默认缓存时长
+	DefaultCacheTTL = 5 * time.Minute
+)
+
+// Cache 基于 Redis 的用户授权快照缓存
+// 方法对 nil 接收者安全：未配置缓存时读取始终未命中，失效操作为空操作
+type Cache struct {
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+// NewCache 创建授权快照缓存，ttl 不大于 0 时使用默认时长
+func NewCache(rdb *redis.Client, ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &Cache{rdb: rdb, ttl: ttl}
+}
+
+// Key 返回当前缓存代数下的用户快照键，未配置缓存时返回空字符串
+// 读取与回写应使用同一个键：解析期间缓存代数递增时，旧快照只会写入旧代数的键
+func (c *Cache) Key(ctx context.Context, userId string) (string, error) {
+	if c == nil {
+		return "", nil
+	}
+	return c.snapshotKey(ctx, userId)
+}
+
+// Get 按 Key 返回的快照键读取用户授权快照，未命中时返回 nil
+func (c *Cache) Get(ctx context.Context, key string) (*Snapshot, error) {
+	if c == nil || key == "" {
+		return nil, nil
+	}
+	data, err := c.rdb.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("读取授权快照失败: %w", err)
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("解析授权快照失败: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// Set 按 Key 返回的快照键写入用户授权快照
+func (c *Cache) Set(ctx context.Context, key string, snapshot *Snapshot) error {
+	if c == nil || key == "" {
+		return nil
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("序列化授权快照失败: %w", err)
+	}
+	return c.rdb.Set(ctx, key, data, c.ttl).Err()
+}
+
+// InvalidateUser 使单个用户的授权快照失效（角色绑定变更时调用）
+func (c *Cache) InvalidateUser(ctx context.Context, userId string) error {
+	if c == nil {
+		return nil
+	}
+	key, err := c.snapshotKey(ctx, userId)
+	if err != nil {
+		return err
+	}
+	return c.rdb.Del(ctx, key).Err()
+}
+
+// InvalidateAll 使全部用户的授权快照失效（权限模板或角色变更时调用）
+// 通过递增缓存代数实现，旧代数下的快照随 TTL 自然过期
+func (c *Cache) InvalidateAll(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+	return c.rdb.Incr(ctx, generationKey).Err()
+}
+
+// snapshotKey 返回当前缓存代数下的用户快照键
+func (c *Cache) snapshotKey(ctx context.Context, userId string) (string, error) {
+	generation, err := c.rdb.Get(ctx, generationKey).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return "", fmt.Errorf("读取授权缓存代数失败: %w", err)
+	}
+	return snapshotKeyPrefix + strconv.FormatInt(generation, 10) + ":" + userId, nil
+}
@@ -0,0 +1,61 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// DecisionPoint 策略决策点（PDP），供其他服务批量查询授权判定
+// 判定规则与 Resolver 一致，用户授权快照缓存在 Redis 中
+type DecisionPoint struct {
+	resolver *Resolver
+	cache    *Cache
+}
+
+// NewDecisionPoint 创建策略决策点，cache 为 nil 时每次实时解析
+func NewDecisionPoint(resolver *Resolver, cache *Cache) *DecisionPoint {
+	return &DecisionPoint{
+		resolver: resolver,
+		cache:    cache,
+	}
+}
+
+// Snapshot 获取用户授权快照，优先读取缓存；缓存读写失败仅记录日志，不影响判定
+// 快照键在解析前确定一次，避免解析期间缓存失效后把旧快照写入新代数
+func (p *DecisionPoint) Snapshot(ctx context.Context, userId string) (*Snapshot, error) {
+	// 1. 确定快照键并读取缓存
+	key, err := p.cache.Key(ctx, userId)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("读取授权缓存代数失败: userId=%s, error=%v", userId, err)
+	}
+	snapshot, err := p.cache.Get(ctx, key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("读取授权快照缓存失败: userId=%s, error=%v", userId, err)
+	}
+	if snapshot != nil {
+		return snapshot, nil
+	}
+
+	// 2. 实时解析角色绑定
+	grants, err := p.resolver.Grants(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	snapshot = NewSnapshot(grants)
+
+	// 3. 回写缓存（使用解析前确定的快照键）
+	if err := p.cache.Set(ctx, key, snapshot); err != nil {
+		logx.WithContext(ctx).Errorf("写入授权快照缓存失败: userId=%s, error=%v", userId, err)
+	}
+	return snapshot, nil
+}
+
+// Decide 判定用户是否允许对指定资源类型执行动作
+func (p *DecisionPoint) Decide(ctx context.Context, userId, resourceType, action string) (Decision, error) {
+	snapshot, err := p.Snapshot(ctx, userId)
+	if err != nil {
+		return Decision{}, err
+	}
+	return snapshot.Decide(resourceType, action), nil
+}
@@ -0,0 +1,119 @@
+package authz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTestCache 创建基于 miniredis 的授权快照缓存
+func setupTestCache(t *testing.T) *Cache {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	return NewCache(rdb, time.Minute)
+}
+
+func TestDecisionPoint_DecideReturnsMatchingGrant(t *testing.T) {
+	db := setupResolverTestDB(t)
+	createTemplate(t, db, "viewer", permissiontemplates.StatusPublished, `{"user":{"actions":["read"],"scope":""}}`, "")
+	createTemplate(t, db, "admin", permissiontemplates.StatusPublished, `{"*":{"actions":["*"],"scope":"global"}}`, "")
+	roleId := createRole(t, db, "dept_viewer", roles.ScopeOrganization, "viewer")
+	createBinding(t, db, "user-1", "org-1", roleId, "")
+	createBinding(t, db, "user-2", "org-2", "", "admin")
+	pdp := NewDecisionPoint(newTestResolver(db), setupTestCache(t))
+	ctx := context.Background()
+
+	decision, err := pdp.Decide(ctx, "user-1", "user", "read")
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, "dept_viewer", decision.Grant.RoleCode)
+	assert.Equal(t, "viewer", decision.Grant.TemplateCode)
+	assert.Equal(t, 1, decision.Grant.TemplateVersion)
+	assert.Equal(t, ScopeOrganization, decision.Scope)
+
+	decision, err = pdp.Decide(ctx, "user-1", "user", "delete")
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Nil(t, decision.Grant)
+
+	decision, err = pdp.Decide(ctx, "user-2", "metadata", "write")
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+	assert.Empty(t, decision.Grant.RoleId)
+	assert.Equal(t, ScopeGlobal, decision.Scope)
+}
+
+func TestDecisionPoint_CachesSnapshotUntilInvalidated(t *testing.T) {
+	db := setupResolverTestDB(t)
+	createTemplate(t, db, "viewer", permissiontemplates.StatusPublished, `{"user":{"actions":["read"],"scope":"global"}}`, "")
+	createBinding(t, db, "user-1", "org-1", "", "viewer")
+	cache := setupTestCache(t)
+	pdp := NewDecisionPoint(newTestResolver(db), cache)
+	ctx := context.Background()
+
+	decision, err := pdp.Decide(ctx, "user-1", "user", "read")
+	require.NoError(t, err)
+	require.True(t, decision.Allowed)
+
+	// 停用模板后缓存仍命中旧快照
+	require.NoError(t, db.Model(&permissiontemplates.PermissionTemplate{}).Where("code = ?", "viewer").
+		Update("status", permissiontemplates.StatusDisabled).Error)
+	decision, err = pdp.Decide(ctx, "user-1", "user", "read")
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+
+	// 全局失效后重新解析
+	require.NoError(t, cache.InvalidateAll(ctx))
+	decision, err = pdp.Decide(ctx, "user-1", "user", "read")
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+
+	// 新增绑定后单用户失效
+	createTemplate(t, db, "editor", permissiontemplates.StatusPublished, `{"user":{"actions":["update"],"scope":"global"}}`, "")
+	createBinding(t, db, "user-1", "org-1", "", "editor")
+	require.NoError(t, cache.InvalidateUser(ctx, "user-1"))
+	decision, err = pdp.Decide(ctx, "user-1", "user", "update")
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+}
+
+func TestCache_NilCacheIsNoop(t *testing.T) {
+	var cache *Cache
+	ctx := context.Background()
+
+	key, err := cache.Key(ctx, "user-1")
+	assert.NoError(t, err)
+	assert.Empty(t, key)
+	snapshot, err := cache.Get(ctx, key)
+	assert.NoError(t, err)
+	assert.Nil(t, snapshot)
+	assert.NoError(t, cache.Set(ctx, key, &Snapshot{}))
+	assert.NoError(t, cache.InvalidateUser(ctx, "user-1"))
+	assert.NoError(t, cache.InvalidateAll(ctx))
+}
+
+func TestCache_StaleSnapshotNotVisibleAfterInvalidation(t *testing.T) {
+	cache := setupTestCache(t)
+	ctx := context.Background()
+
+	// 解析期间全局失效：旧快照写入旧代数的键，新代数下仍未命中
+	staleKey, err := cache.Key(ctx, "user-1")
+	require.NoError(t, err)
+	require.NoError(t, cache.InvalidateAll(ctx))
+	require.NoError(t, cache.Set(ctx, staleKey, &Snapshot{}))
+
+	key, err := cache.Key(ctx, "user-1")
+	require.NoError(t, err)
+	assert.NotEqual(t, staleKey, key)
+	snapshot, err := cache.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+}
@@ -0,0 +1,166 @@
+package authz
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+)
+
+// 数据范围（与权限模板、角色的范围取值一致）
+const (
+	ScopeGlobal       = permissiontemplates.ScopeGlobal
+	ScopeOrganization = permissiontemplates.ScopeOrganization
+	ScopeDomain       = permissiontemplates.ScopeDomain
+	ScopeProject      = permissiontemplates.ScopeProject
+	// 组织架构数据范围：本部门、本部门及下级部门、仅本人
+	ScopeDepartment     = permissiontemplates.ScopeDepartment
+	ScopeDepartmentTree = permissiontemplates.ScopeDepartmentTree
+	ScopeSelf           = permissiontemplates.ScopeSelf
+)
+
+// ModulePermission 单个模块合并后的权限
+type ModulePermission struct {
+	Actions []string // 动作列表（去重排序）
+	Scopes  []string // 生效的数据范围（去重排序）
+	OrgIds  []string // organization 范围下可访问的组织ID（去重排序）
+}
+
+// EffectivePermissions 用户合并后的有效权限
+type EffectivePermissions struct {
+	Modules       map[string]*ModulePermission // 模块 -> 权限
+	AdvancedPerms []string                     // 已启用的高级权限点（去重排序）
+	Grants        []*Grant                     // 授权来源
+}
+
+// Effective 合并用户全部角色绑定的有效权限
+func (r *Resolver) Effective(ctx context.Context, userId string) (*EffectivePermissions, error) {
+	grants, err := r.Grants(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	return Merge(grants), nil
+}
+
+// Merge 合并多个授权来源的策略矩阵与高级权限点
+func Merge(grants []*Grant) *EffectivePermissions {
+	modules := make(map[string]*moduleSet)
+	advanced := make(map[string]struct{})
+
+	for _, grant := range grants {
+		for module, entry := range grant.Matrix {
+			set, ok := modules[module]
+			if !ok {
+				set = newModuleSet()
+				modules[module] = set
+			}
+			for _, action := range entry.Actions {
+				set.actions[action] = struct{}{}
+			}
+			scope := grantScope(grant, entry)
+			set.scopes[scope] = struct{}{}
+			if scope == ScopeOrganization && grant.Binding.OrgId != "" {
+				set.orgIds[grant.Binding.OrgId] = struct{}{}
+			}
+		}
+		for key, perm := range grant.AdvancedPerms {
+			if perm.Enabled {
+				advanced[key] = struct{}{}
+			}
+		}
+	}
+
+	result := &EffectivePermissions{
+		Modules:       make(map[string]*ModulePermission, len(modules)),
+		AdvancedPerms: sortedKeys(advanced),
+		Grants:        grants,
+	}
+	for module, set := range modules {
+		result.Modules[module] = &ModulePermission{
+			Actions: sortedKeys(set.actions),
+			Scopes:  sortedKeys(set.scopes),
+			OrgIds:  sortedKeys(set.orgIds),
+		}
+	}
+	return result
+}
+
+// Allows 判断合并后的权限是否包含指定模块动作（支持 * 通配）
+func (e *EffectivePermissions) Allows(module, action string) bool {
+	for _, key := range []string{module, Wildcard} {
+		perm, ok := e.Modules[key]
+		if !ok {
+			continue
+		}
+		for _, a := range perm.Actions {
+			if a == action || a == Wildcard {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AllowsKey 判断是否拥有菜单/按钮权限标识
+// 权限标识命中已启用的高级权限点，或按最后一个冒号拆分为 模块:动作 后被策略矩阵允许
+func (e *EffectivePermissions) AllowsKey(key string) bool {
+	for _, perm := range e.AdvancedPerms {
+		if perm == key {
+			return true
+		}
+	}
+	module, action, ok := SplitPermissionKey(key)
+	if !ok {
+		return false
+	}
+	return e.Allows(module, action)
+}
+
+// SplitPermissionKey 按最后一个冒号将权限标识拆分为 模块:动作，格式不符时返回 false
+func SplitPermissionKey(key string) (module, action string, ok bool) {
+	idx := strings.LastIndex(key, ":")
+	if idx <= 0 || idx == len(key)-1 {
+		return "", "", false
+	}
+	return key[:idx], key[idx+1:], true
+}
+
+// grantScope 解析策略条目的数据范围：条目范围 > 角色范围 > 模板建议范围 > 全局
+func grantScope(grant *Grant, entry PolicyMatrixEntry) string {
+	if entry.Scope != "" {
+		return entry.Scope
+	}
+	if grant.Role != nil && grant.Role.Scope != "" {
+		return grant.Role.Scope
+	}
+	if grant.Template.ScopeSuggestion != nil && *grant.Template.ScopeSuggestion != "" {
+		return *grant.Template.ScopeSuggestion
+	}
+	return ScopeGlobal
+}
+
+// moduleSet 合并过程中的模块权限集合
+type moduleSet struct {
+	actions map[string]struct{}
+	scopes  map[string]struct{}
+	orgIds  map[string]struct{}
+}
+
+func newModuleSet() *moduleSet {
+	return &moduleSet{
+		actions: make(map[string]struct{}),
+		scopes:  make(map[string]struct{}),
+		orgIds:  make(map[string]struct{}),
+	}
+}
+
+// sortedKeys 返回集合的有序键列表
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,280 @@
+package authz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/testutil"
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupResolverTestDB 创建测试数据库
+func setupResolverTestDB(t *testing.T) *gorm.DB {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(&rolebindings.RoleBinding{})
+	require.NoError(t, err)
+
+	testutil.CreatePermissionTables(t, db)
+
+	return db
+}
+
+func newTestResolver(db *gorm.DB) *Resolver {
+	return NewResolver(rolebindings.NewModel(db), roles.NewModel(db), permissiontemplates.NewModel(db), permissiontemplateversions.NewModel(db))
+}
+
+// createTemplate 创建测试权限模板
+func createTemplate(t *testing.T, db *gorm.DB, code, status, policyMatrix, advancedPerms string) {
+	now := time.Now()
+	template := &permissiontemplates.PermissionTemplate{
+		Id:           "tpl-" + code,
+		Name:         code,
+		Code:         code,
+		Status:       status,
+		PolicyMatrix: datatypes.JSON(policyMatrix),
+		Version:      1,
+		CreatedBy:    "system",
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if advancedPerms != "" {
+		template.AdvancedPerms = datatypes.JSON(advancedPerms)
+	}
+	require.NoError(t, db.Create(template).Error)
+}
+
+// createRole 创建引用指定模板的测试角色
+func createRole(t *testing.T, db *gorm.DB, code, scope, templateCode string) string {
+	now := time.Now()
+	role := &roles.Role{
+		Id:                "role-" + code,
+		Code:              code,
+		Name:              code,
+		Scope:             scope,
+		TemplateId:        "tpl-" + templateCode,
+		TemplateVersion:   1,
+		TemplateAppliedAt: now,
+		CreatedBy:         "system",
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	require.NoError(t, db.Create(role).Error)
+	return role.Id
+}
+
+// createBinding 创建测试角色绑定（roleId 为空时按 permissionRole 绑定）
+func createBinding(t *testing.T, db *gorm.DB, userId, orgId, roleId, permissionRole string) {
+	binding := &rolebindings.RoleBinding{
+		UserId: userId,
+		OrgId:  orgId,
+	}
+	if roleId != "" {
+		binding.RoleId = &roleId
+	}
+	if permissionRole != "" {
+		binding.PermissionRole = &permissionRole
+	}
+	require.NoError(t, db.Create(binding).Error)
+}
+
+func TestEffective_MergesBindingsAcrossOrgs(t *testing.T) {
+	db := setupResolverTestDB(t)
+	createTemplate(t, db, "viewer", permissiontemplates.StatusPublished,
+		`{"user":{"actions":["read"],"scope":""},"menu":{"actions":["read"],"scope":"global"}}`, "")
+	createTemplate(t, db, "editor", permissiontemplates.StatusPublished,
+		`{"user":{"actions":["read","update"],"scope":""}}`, `{"export_data":{"enabled":true},"approve":{"enabled":false}}`)
+	viewerRoleId := createRole(t, db, "dept_viewer", roles.ScopeOrganization, "viewer")
+	createBinding(t, db, "user-1", "org-primary", viewerRoleId, "")
+	createBinding(t, db, "user-1", "org-aux", "", "editor")
+
+	effective, err := newTestResolver(db).Effective(context.Background(), "user-1")
+
+	require.NoError(t, err)
+	require.Len(t, effective.Grants, 2)
+	require.Contains(t, effective.Modules, "user")
+	assert.Equal(t, []string{"read", "update"}, effective.Modules["user"].Actions)
+	assert.Equal(t, []string{ScopeGlobal, ScopeOrganization}, effective.Modules["user"].Scopes)
+	assert.Equal(t, []string{"org-primary"}, effective.Modules["user"].OrgIds)
+	assert.Equal(t, []string{ScopeGlobal}, effective.Modules["menu"].Scopes)
+	assert.Equal(t, []string{"export_data"}, effective.AdvancedPerms)
+}
+
+func TestEffective_IgnoresUnpublishedTemplate(t *testing.T) {
+	db := setupResolverTestDB(t)
+	createTemplate(t, db, "draft_tpl", permissiontemplates.StatusDraft, `{"user":{"actions":["delete"],"scope":"global"}}`, "")
+	createBinding(t, db, "user-1", "org-1", "", "draft_tpl")
+
+	effective, err := newTestResolver(db).Effective(context.Background(), "user-1")
+
+	require.NoError(t, err)
+	assert.Empty(t, effective.Grants)
+	assert.Empty(t, effective.Modules)
+	assert.False(t, effective.Allows("user", "delete"))
+}
+
+func TestEffective_UsesPinnedVersionSnapshot(t *testing.T) {
+	db := setupResolverTestDB(t)
+	createTemplate(t, db, "editor", permissiontemplates.StatusPublished, `{"user":{"actions":["read","delete"],"scope":"global"}}`, "")
+	require.NoError(t, db.Model(&permissiontemplates.PermissionTemplate{}).Where("code = ?", "editor").Update("version", 2).Error)
+	require.NoError(t, db.Create(&permissiontemplateversions.PermissionTemplateVersion{
+		Id: "ver-editor-1", TemplateId: "tpl-editor", Version: 1, Name: "editor", Code: "editor",
+		PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read"],"scope":"global"}}`),
+		PublishedBy:  "system", PublishedAt: time.Now(),
+	}).Error)
+	pinnedRoleId := createRole(t, db, "pinned_editor", roles.ScopeGlobal, "editor")
+	createBinding(t, db, "user-1", "org-1", pinnedRoleId, "")
+	createBinding(t, db, "user-2", "org-1", "", "editor")
+
+	// 角色固定版本 1，按快照授权
+	pinned, err := newTestResolver(db).Effective(context.Background(), "user-1")
+	require.NoError(t, err)
+	require.Len(t, pinned.Grants, 1)
+	assert.Equal(t, 1, pinned.Grants[0].Version)
+	assert.True(t, pinned.Allows("user", "read"))
+	assert.False(t, pinned.Allows("user", "delete"))
+
+	// 历史绑定直接引用模板编码，按模板当前版本授权
+	current, err := newTestResolver(db).Effective(context.Background(), "user-2")
+	require.NoError(t, err)
+	require.Len(t, current.Grants, 1)
+	assert.Equal(t, 2, current.Grants[0].Version)
+	assert.True(t, current.Allows("user", "delete"))
+}
+
+func TestGrantsWithOverride_SimulatesTemplateChange(t *testing.T) {
+	db := setupResolverTestDB(t)
+	createTemplate(t, db, "editor", permissiontemplates.StatusPublished, `{"user":{"actions":["read","delete"],"scope":"global"}}`, "")
+	require.NoError(t, db.Create(&permissiontemplateversions.PermissionTemplateVersion{
+		Id: "ver-editor-1", TemplateId: "tpl-editor", Version: 1, Name: "editor", Code: "editor",
+		PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read","delete"],"scope":"global"}}`),
+		PublishedBy:  "system", PublishedAt: time.Now(),
+	}).Error)
+	pinnedRoleId := createRole(t, db, "pinned_editor", roles.ScopeGlobal, "editor")
+	createBinding(t, db, "user-1", "org-1", pinnedRoleId, "")
+	createBinding(t, db, "user-2", "org-1", "", "editor")
+	resolver := newTestResolver(db)
+	ctx := context.Background()
+
+	template, err := permissiontemplates.NewModel(db).FindOne(ctx, "tpl-editor")
+	require.NoError(t, err)
+	published := *template
+	published.Version = 2
+	published.PolicyMatrix = datatypes.JSON(`{"user":{"actions":["read"],"scope":"global"}}`)
+
+	// 发布新版本：固定版本 1 的角色仍按快照授权，历史绑定按新内容授权
+	pinned, err := resolver.GrantsWithOverride(ctx, "user-1", &published)
+	require.NoError(t, err)
+	require.Len(t, pinned, 1)
+	assert.True(t, Merge(pinned).Allows("user", "delete"))
+	legacy, err := resolver.GrantsWithOverride(ctx, "user-2", &published)
+	require.NoError(t, err)
+	require.Len(t, legacy, 1)
+	assert.False(t, Merge(legacy).Allows("user", "delete"))
+
+	// 停用：全部授权失效
+	disabled := *template
+	disabled.Status = permissiontemplates.StatusDisabled
+	grants, err := resolver.GrantsWithOverride(ctx, "user-1", &disabled)
+	require.NoError(t, err)
+	assert.Empty(t, grants)
+
+	// 模拟不修改数据库
+	grants, err = resolver.Grants(ctx, "user-2")
+	require.NoError(t, err)
+	assert.True(t, Merge(grants).Allows("user", "delete"))
+}
+
+func TestMergeInherited_AddsAndRemovesActions(t *testing.T) {
+	parent := map[string]PolicyMatrixEntry{
+		"user": {Actions: []string{"read", "delete"}, Scope: "global"},
+		"role": {Actions: []string{"read"}, Scope: "organization"},
+		"menu": {Actions: []string{"read"}},
+	}
+	own := map[string]PolicyMatrixEntry{
+		"user":  {Actions: []string{"update"}},
+		"audit": {Actions: []string{"read"}, Scope: "global"},
+	}
+	removals := map[string][]string{"user": {"delete"}, "role": {Wildcard}, "menu": {"read"}}
+
+	merged := MergeInherited(parent, own, removals)
+
+	assert.Equal(t, map[string]PolicyMatrixEntry{
+		"user":  {Actions: []string{"read", "update"}, Scope: "global"},
+		"audit": {Actions: []string{"read"}, Scope: "global"},
+	}, merged)
+	assert.Equal(t, []string{"read", "delete"}, parent["user"].Actions, "不修改父模板策略矩阵")
+}
+
+func TestEffective_InheritsPublishedParentTemplate(t *testing.T) {
+	db := setupResolverTestDB(t)
+	createTemplate(t, db, "base", permissiontemplates.StatusPublished, `{"user":{"actions":["read","delete"],"scope":"global"}}`, "")
+	createTemplate(t, db, "auditor", permissiontemplates.StatusPublished, `{"audit":{"actions":["read"],"scope":"global"}}`, "")
+	require.NoError(t, db.Model(&permissiontemplates.PermissionTemplate{}).Where("id = ?", "tpl-auditor").Updates(map[string]interface{}{
+		"parent_id":       "tpl-base",
+		"policy_removals": `{"user":["delete"]}`,
+	}).Error)
+	roleId := createRole(t, db, "auditor_role", roles.ScopeGlobal, "auditor")
+	createBinding(t, db, "user-1", "org-1", roleId, "")
+	resolver := newTestResolver(db)
+	ctx := context.Background()
+
+	effective, err := resolver.Effective(ctx, "user-1")
+	require.NoError(t, err)
+	assert.True(t, effective.Allows("audit", "read"))
+	assert.True(t, effective.Allows("user", "read"))
+	assert.False(t, effective.Allows("user", "delete"))
+
+	// 父模板停用后不再参与继承
+	require.NoError(t, db.Model(&permissiontemplates.PermissionTemplate{}).Where("id = ?", "tpl-base").Update("status", permissiontemplates.StatusDisabled).Error)
+	effective, err = resolver.Effective(ctx, "user-1")
+	require.NoError(t, err)
+	assert.True(t, effective.Allows("audit", "read"))
+	assert.False(t, effective.Allows("user", "read"))
+}
+
+func TestInheritedMatrix_RejectsCycle(t *testing.T) {
+	db := setupResolverTestDB(t)
+	createTemplate(t, db, "a", permissiontemplates.StatusPublished, `{"user":{"actions":["read"],"scope":"global"}}`, "")
+	createTemplate(t, db, "b", permissiontemplates.StatusPublished, `{"role":{"actions":["read"],"scope":"global"}}`, "")
+	require.NoError(t, db.Exec("UPDATE permission_templates SET parent_id = 'tpl-b' WHERE id = 'tpl-a'").Error)
+	require.NoError(t, db.Exec("UPDATE permission_templates SET parent_id = 'tpl-a' WHERE id = 'tpl-b'").Error)
+	templateModel := permissiontemplates.NewModel(db)
+	ctx := context.Background()
+
+	template, err := templateModel.FindOne(ctx, "tpl-a")
+	require.NoError(t, err)
+	_, err = InheritedMatrix(ctx, templateModel, template, nil)
+	assert.Equal(t, permissiontemplates.ErrPermissionTemplateInheritanceCycle, err)
+}
+
+func TestEffectivePermissions_AllowsKey(t *testing.T) {
+	effective := Merge([]*Grant{{
+		Binding:       &rolebindings.RoleBinding{OrgId: "org-1"},
+		Template:      &permissiontemplates.PermissionTemplate{Code: "tpl"},
+		Matrix:        map[string]PolicyMatrixEntry{"menu": {Actions: []string{Wildcard}}, "user": {Actions: []string{"read"}}},
+		AdvancedPerms: map[string]AdvancedPermEntry{"system:audit:export": {Enabled: true}},
+	}})
+
+	assert.True(t, effective.AllowsKey("menu:user_list"))
+	assert.True(t, effective.AllowsKey("user:read"))
+	assert.True(t, effective.AllowsKey("system:audit:export"))
+	assert.False(t, effective.AllowsKey("user:delete"))
+	assert.False(t, effective.AllowsKey("user"))
+	assert.False(t, effective.AllowsKey("org:read"))
+}
@@ -0,0 +1,121 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+)
+
+// MaxInheritanceDepth 模板继承链的最大深度（超过时按循环继承处理）
+const MaxInheritanceDepth = 10
+
+// InheritedMatrix 解析模板的有效策略矩阵：父模板的有效策略矩阵合并模板自身的策略矩阵，再去除显式移除项
+// 父模板仅在已发布时参与继承；override 非空时替换继承链上同 ID 的模板（用于预览模板变更影响）
+func InheritedMatrix(ctx context.Context, templateModel permissiontemplates.Model, template, override *permissiontemplates.PermissionTemplate) (map[string]PolicyMatrixEntry, error) {
+	visited := map[string]struct{}{template.Id: {}}
+	return inheritedMatrix(ctx, templateModel, template, override, visited)
+}
+
+// inheritedMatrix 递归解析有效策略矩阵，visited 记录继承链上已访问的模板ID
+func inheritedMatrix(ctx context.Context, templateModel permissiontemplates.Model, template, override *permissiontemplates.PermissionTemplate, visited map[string]struct{}) (map[string]PolicyMatrixEntry, error) {
+	own := make(map[string]PolicyMatrixEntry)
+	if len(template.PolicyMatrix) > 0 {
+		if err := json.Unmarshal(template.PolicyMatrix, &own); err != nil {
+			return nil, fmt.Errorf("解析策略矩阵失败: template=%s, %w", template.Code, err)
+		}
+	}
+	if template.ParentId == nil || *template.ParentId == "" {
+		return own, nil
+	}
+
+	// 1. 检测循环继承
+	parentId := *template.ParentId
+	if _, ok := visited[parentId]; ok || len(visited) > MaxInheritanceDepth {
+		return nil, permissiontemplates.ErrPermissionTemplateInheritanceCycle
+	}
+	visited[parentId] = struct{}{}
+
+	// 2. 解析显式移除项
+	removals := make(map[string][]string)
+	if len(template.PolicyRemovals) > 0 && string(template.PolicyRemovals) != "null" {
+		if err := json.Unmarshal(template.PolicyRemovals, &removals); err != nil {
+			return nil, fmt.Errorf("解析策略移除项失败: template=%s, %w", template.Code, err)
+		}
+	}
+
+	// 3. 递归解析已发布父模板的有效策略矩阵
+	parent, err := findTemplate(templateModel.FindOne(ctx, parentId))
+	if err != nil {
+		return nil, err
+	}
+	if override != nil && override.Id == parentId {
+		parent = override
+	}
+	var parentMatrix map[string]PolicyMatrixEntry
+	if parent != nil && parent.Status == permissiontemplates.StatusPublished {
+		parentMatrix, err = inheritedMatrix(ctx, templateModel, parent, override, visited)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return MergeInherited(parentMatrix, own, removals), nil
+}
+
+// MergeInherited 合并继承的策略矩阵：模块动作取并集，模块数据范围以自身为准（自身未设置时沿用父模板）
+// removals 中的动作从合并结果移除，动作为 * 时移除整个模块，移除后没有动作的模块不再保留
+func MergeInherited(parent, own map[string]PolicyMatrixEntry, removals map[string][]string) map[string]PolicyMatrixEntry {
+	merged := make(map[string]PolicyMatrixEntry, len(parent)+len(own))
+	for module, entry := range parent {
+		merged[module] = PolicyMatrixEntry{Actions: append([]string(nil), entry.Actions...), Scope: entry.Scope}
+	}
+	for module, entry := range own {
+		inherited := merged[module]
+		actions := inherited.Actions
+		for _, action := range entry.Actions {
+			if !containsAction(actions, action) {
+				actions = append(actions, action)
+			}
+		}
+		scope := entry.Scope
+		if scope == "" {
+			scope = inherited.Scope
+		}
+		merged[module] = PolicyMatrixEntry{Actions: actions, Scope: scope}
+	}
+
+	for module, removed := range removals {
+		entry, ok := merged[module]
+		if !ok {
+			continue
+		}
+		if containsAction(removed, Wildcard) {
+			delete(merged, module)
+			continue
+		}
+		actions := make([]string, 0, len(entry.Actions))
+		for _, action := range entry.Actions {
+			if !containsAction(removed, action) {
+				actions = append(actions, action)
+			}
+		}
+		if len(actions) == 0 {
+			delete(merged, module)
+			continue
+		}
+		merged[module] = PolicyMatrixEntry{Actions: actions, Scope: entry.Scope}
+	}
+	return merged
+}
+
+// containsAction 判断动作列表是否包含指定动作
+func containsAction(actions []string, action string) bool {
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
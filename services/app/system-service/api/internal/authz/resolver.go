@@ -0,0 +1,215 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+)
+
+// Wildcard 通配符，策略矩阵中模块或动作为 * 时表示全部
+const Wildcard = "*"
+
+// PolicyMatrixEntry 策略矩阵条目（与 types.PolicyMatrixEntry 的 JSON 结构一致）
+type PolicyMatrixEntry struct {
+	Actions []string `json:"actions"`
+	Scope   string   `json:"scope"`
+}
+
+// AdvancedPermEntry 高级权限点条目（与 types.AdvancedPermEntry 的 JSON 结构一致）
+type AdvancedPermEntry struct {
+	Enabled bool                   `json:"enabled"`
+	Config  map[string]interface{} `json:"config"`
+}
+
+// Grant 单个角色绑定解析出的授权来源
+type Grant struct {
+	Binding       *rolebindings.RoleBinding
+	Role          *roles.Role // 历史绑定直接引用模板编码时为 nil
+	Template      *permissiontemplates.PermissionTemplate
+	Version       int // 生效的模板版本（角色固定旧版本时为快照版本）
+	Matrix        map[string]PolicyMatrixEntry
+	AdvancedPerms map[string]AdvancedPermEntry
+}
+
+// Resolver 基于角色绑定与已发布权限模板的权限解析器
+// 角色绑定优先通过 RoleId 引用角色，再由角色关联的来源模板授权；
+// 未设置 RoleId 的历史绑定按 PermissionRole 依次匹配角色编码、权限模板编码。仅已发布的模板参与授权；
+// 角色固定的模板版本早于模板当前版本时，按该版本的发布快照授权；继承父模板的模板按有效策略矩阵授权
+type Resolver struct {
+	roleBindingModel               rolebindings.Model
+	roleModel                      roles.Model
+	permissionTemplateModel        permissiontemplates.Model
+	permissionTemplateVersionModel permissiontemplateversions.Model
+}
+
+// NewResolver 创建权限解析器
+func NewResolver(roleBindingModel rolebindings.Model, roleModel roles.Model, permissionTemplateModel permissiontemplates.Model, permissionTemplateVersionModel permissiontemplateversions.Model) *Resolver {
+	return &Resolver{
+		roleBindingModel:               roleBindingModel,
+		roleModel:                      roleModel,
+		permissionTemplateModel:        permissionTemplateModel,
+		permissionTemplateVersionModel: permissionTemplateVersionModel,
+	}
+}
+
+// Grants 解析用户全部角色绑定对应的已发布模板，未解析到模板的绑定被忽略
+func (r *Resolver) Grants(ctx context.Context, userId string) ([]*Grant, error) {
+	return r.grants(ctx, userId, nil)
+}
+
+// GrantsWithOverride 以 override 替换同 ID 的权限模板后解析用户授权，用于预览模板发布、停用后的权限变化
+// override 的版本号高于角色固定版本时，角色仍按固定版本的发布快照授权
+func (r *Resolver) GrantsWithOverride(ctx context.Context, userId string, override *permissiontemplates.PermissionTemplate) ([]*Grant, error) {
+	return r.grants(ctx, userId, override)
+}
+
+// grants 解析用户授权，override 非空时替换同 ID 的模板
+func (r *Resolver) grants(ctx context.Context, userId string, override *permissiontemplates.PermissionTemplate) ([]*Grant, error) {
+	// 1. 查询用户的角色绑定
+	bindings, err := r.roleBindingModel.FindByUserId(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("查询角色绑定失败: %w", err)
+	}
+
+	// 2. 逐个绑定解析已发布模板
+	grants := make([]*Grant, 0, len(bindings))
+	for _, binding := range bindings {
+		role, template, err := r.resolveTemplate(ctx, binding)
+		if err != nil {
+			return nil, err
+		}
+		if template != nil && override != nil && template.Id == override.Id {
+			template = override
+		}
+		if template == nil || template.Status != permissiontemplates.StatusPublished {
+			continue
+		}
+
+		grant := &Grant{
+			Binding:  binding,
+			Role:     role,
+			Template: template,
+		}
+		policyMatrix, advancedPerms, version, err := r.pinnedPolicy(ctx, role, template)
+		if err != nil {
+			return nil, err
+		}
+		grant.Version = version
+		if version == template.Version && template.ParentId != nil && *template.ParentId != "" {
+			// 继承模板的当前版本按父模板的已发布内容实时合并；旧版本快照保存的是发布时的有效策略矩阵
+			grant.Matrix, err = InheritedMatrix(ctx, r.permissionTemplateModel, template, override)
+			if err != nil {
+				return nil, err
+			}
+		} else if err := json.Unmarshal(policyMatrix, &grant.Matrix); err != nil {
+			return nil, fmt.Errorf("解析策略矩阵失败: template=%s, %w", template.Code, err)
+		}
+		if len(advancedPerms) > 0 {
+			if err := json.Unmarshal(advancedPerms, &grant.AdvancedPerms); err != nil {
+				return nil, fmt.Errorf("解析高级权限点失败: template=%s, %w", template.Code, err)
+			}
+		}
+		grants = append(grants, grant)
+	}
+
+	return grants, nil
+}
+
+// Allowed 判断用户是否拥有指定模块的动作权限
+func (r *Resolver) Allowed(ctx context.Context, userId, module, action string) (bool, error) {
+	grants, err := r.Grants(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+	for _, grant := range grants {
+		if MatrixAllows(grant.Matrix, module, action) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// resolveTemplate 解析角色绑定对应的角色和权限模板，角色或模板不存在时返回 nil
+func (r *Resolver) resolveTemplate(ctx context.Context, binding *rolebindings.RoleBinding) (*roles.Role, *permissiontemplates.PermissionTemplate, error) {
+	var (
+		role *roles.Role
+		err  error
+	)
+	switch {
+	case binding.RoleId != nil && *binding.RoleId != "":
+		role, err = r.roleModel.FindOne(ctx, *binding.RoleId)
+	case binding.PermissionRole != nil && *binding.PermissionRole != "":
+		role, err = r.roleModel.FindOneByCode(ctx, *binding.PermissionRole)
+		if errors.Is(err, roles.ErrRoleNotFound) {
+			// 历史绑定：PermissionRole 直接对应权限模板编码
+			template, err := findTemplate(r.permissionTemplateModel.FindOneByCode(ctx, *binding.PermissionRole))
+			return nil, template, err
+		}
+	default:
+		return nil, nil, nil
+	}
+	if err != nil {
+		if errors.Is(err, roles.ErrRoleNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("查询角色失败: %w", err)
+	}
+
+	template, err := findTemplate(r.permissionTemplateModel.FindOne(ctx, role.TemplateId))
+	return role, template, err
+}
+
+// pinnedPolicy 返回角色固定版本的策略矩阵、高级权限点及生效版本号
+// 角色未固定版本、固定当前版本或快照缺失（快照表上线前发布的版本）时使用模板当前内容
+func (r *Resolver) pinnedPolicy(ctx context.Context, role *roles.Role, template *permissiontemplates.PermissionTemplate) ([]byte, []byte, int, error) {
+	if role == nil || role.TemplateVersion <= 0 || role.TemplateVersion == template.Version || r.permissionTemplateVersionModel == nil {
+		return template.PolicyMatrix, template.AdvancedPerms, template.Version, nil
+	}
+	snapshot, err := r.permissionTemplateVersionModel.FindOne(ctx, template.Id, role.TemplateVersion)
+	if err != nil {
+		if errors.Is(err, permissiontemplateversions.ErrTemplateVersionNotFound) {
+			return template.PolicyMatrix, template.AdvancedPerms, template.Version, nil
+		}
+		return nil, nil, 0, fmt.Errorf("查询权限模板版本快照失败: %w", err)
+	}
+	return snapshot.PolicyMatrix, snapshot.AdvancedPerms, snapshot.Version, nil
+}
+
+// findTemplate 将模板不存在转换为 nil，其他错误原样包装返回
+func findTemplate(template *permissiontemplates.PermissionTemplate, err error) (*permissiontemplates.PermissionTemplate, error) {
+	if err != nil {
+		if errors.Is(err, permissiontemplates.ErrPermissionTemplateNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询权限模板失败: %w", err)
+	}
+	return template, nil
+}
+
+// MatrixAllows 判断策略矩阵是否包含指定模块动作（支持 * 通配）
+func MatrixAllows(matrix map[string]PolicyMatrixEntry, module, action string) bool {
+	_, ok := matchEntry(matrix, module, action)
+	return ok
+}
+
+// matchEntry 返回策略矩阵中允许指定模块动作的条目，模块条目优先于 * 通配条目
+func matchEntry(matrix map[string]PolicyMatrixEntry, module, action string) (PolicyMatrixEntry, bool) {
+	for _, key := range []string{module, Wildcard} {
+		entry, ok := matrix[key]
+		if !ok {
+			continue
+		}
+		for _, a := range entry.Actions {
+			if a == action || a == Wildcard {
+				return entry, true
+			}
+		}
+	}
+	return PolicyMatrixEntry{}, false
+}
@@ -0,0 +1,67 @@
+package authz
+
+// Snapshot 用户授权快照，由角色绑定解析结果预先展开，可序列化后缓存
+type Snapshot struct {
+	Grants []SnapshotGrant `json:"grants"`
+}
+
+// SnapshotGrant 快照中的单个授权来源，策略条目的数据范围已解析完成
+type SnapshotGrant struct {
+	OrgId           string                       `json:"org_id"`
+	RoleId          string                       `json:"role_id,omitempty"`
+	RoleCode        string                       `json:"role_code,omitempty"`
+	TemplateId      string                       `json:"template_id"`
+	TemplateCode    string                       `json:"template_code"`
+	TemplateVersion int                          `json:"template_version"`
+	Matrix          map[string]PolicyMatrixEntry `json:"matrix"`
+}
+
+// Decision 授权判定结果
+type Decision struct {
+	Allowed bool
+	Grant   *SnapshotGrant // 命中的授权来源，拒绝时为 nil
+	Scope   string         // 命中策略条目的数据范围
+}
+
+// NewSnapshot 根据角色绑定解析结果构建授权快照
+func NewSnapshot(grants []*Grant) *Snapshot {
+	snapshot := &Snapshot{Grants: make([]SnapshotGrant, 0, len(grants))}
+	for _, grant := range grants {
+		item := SnapshotGrant{
+			OrgId:           grant.Binding.OrgId,
+			TemplateId:      grant.Template.Id,
+			TemplateCode:    grant.Template.Code,
+			TemplateVersion: grant.Version,
+			Matrix:          make(map[string]PolicyMatrixEntry, len(grant.Matrix)),
+		}
+		if grant.Role != nil {
+			item.RoleId = grant.Role.Id
+			item.RoleCode = grant.Role.Code
+		}
+		for module, entry := range grant.Matrix {
+			item.Matrix[module] = PolicyMatrixEntry{
+				Actions: entry.Actions,
+				Scope:   grantScope(grant, entry),
+			}
+		}
+		snapshot.Grants = append(snapshot.Grants, item)
+	}
+	return snapshot
+}
+
+// Decide 判定快照是否允许指定模块动作，按角色绑定顺序返回第一个命中的授权来源
+func (s *Snapshot) Decide(module, action string) Decision {
+	for i := range s.Grants {
+		grant := &s.Grants[i]
+		entry, ok := matchEntry(grant.Matrix, module, action)
+		if !ok {
+			continue
+		}
+		return Decision{
+			Allowed: true,
+			Grant:   grant,
+			Scope:   entry.Scope,
+		}
+	}
+	return Decision{}
+}
@@ -0,0 +1,93 @@
+// Package clientip 解析请求的真实客户端 IP
+package clientip
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Resolver 客户端 IP 解析器
+// 默认使用直连地址（去掉端口）；仅当直连地址属于受信代理时才采信 X-Forwarded-For，
+// 并从右向左取第一个非受信代理的地址，避免客户端伪造请求头绕过按 IP 的限制
+type Resolver struct {
+	trusted []*net.IPNet
+}
+
+// NewResolver 创建客户端 IP 解析器，proxies 为受信代理的 IP 或 CIDR
+func NewResolver(proxies []string) (*Resolver, error) {
+	r := &Resolver{}
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("受信代理地址格式错误: %s", proxy)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			proxy = fmt.Sprintf("%s/%d", proxy, bits)
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("受信代理地址格式错误: %s", proxy)
+		}
+		r.trusted = append(r.trusted, ipNet)
+	}
+	return r, nil
+}
+
+// FromRequest 返回请求的客户端 IP
+func (r *Resolver) FromRequest(req *http.Request) string {
+	addr := remoteIP(req.RemoteAddr)
+	if !r.isTrusted(addr) {
+		return addr
+	}
+
+	// 直连为受信代理：从右向左跳过受信代理，取第一个非受信地址
+	hops := strings.Split(strings.Join(req.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := net.ParseIP(strings.TrimSpace(hops[i]))
+		if hop == nil {
+			// 无法解析的地址不再向左采信
+			return addr
+		}
+		addr = hop.String()
+		if !r.isTrusted(addr) {
+			return addr
+		}
+	}
+	return addr
+}
+
+// isTrusted 判断地址是否属于受信代理
+func (r *Resolver) isTrusted(addr string) bool {
+	if r == nil {
+		return false
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range r.trusted {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteIP 去掉直连地址中的端口
+func remoteIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
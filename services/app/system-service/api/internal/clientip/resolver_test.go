@@ -0,0 +1,46 @@
+package clientip
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFromRequest 测试直连地址、受信代理与伪造 X-Forwarded-For 的解析结果
+func TestFromRequest(t *testing.T) {
+	resolver, err := NewResolver([]string{"10.0.0.1", "172.16.0.0/12"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		xff        []string
+		expected   string
+	}{
+		{name: "直连去掉端口", remoteAddr: "203.0.113.7:51234", expected: "203.0.113.7"},
+		{name: "非受信直连伪造XFF被忽略", remoteAddr: "203.0.113.7:51234", xff: []string{"198.51.100.9"}, expected: "203.0.113.7"},
+		{name: "受信代理取最右侧非受信地址", remoteAddr: "10.0.0.1:443", xff: []string{"198.51.100.9, 203.0.113.7"}, expected: "203.0.113.7"},
+		{name: "跳过多级受信代理", remoteAddr: "10.0.0.1:443", xff: []string{"203.0.113.7", "172.20.0.5"}, expected: "203.0.113.7"},
+		{name: "XFF无法解析时使用代理地址", remoteAddr: "10.0.0.1:443", xff: []string{"not-an-ip"}, expected: "10.0.0.1"},
+		{name: "受信代理未携带XFF", remoteAddr: "10.0.0.1:443", expected: "10.0.0.1"},
+		{name: "IPv6直连", remoteAddr: "[2001:db8::1]:8080", expected: "2001:db8::1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/api/v1/system/auth/login", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, v := range tt.xff {
+				req.Header.Add("X-Forwarded-For", v)
+			}
+			assert.Equal(t, tt.expected, resolver.FromRequest(req))
+		})
+	}
+}
+
+// TestNewResolver_InvalidProxy 测试受信代理配置格式错误
+func TestNewResolver_InvalidProxy(t *testing.T) {
+	_, err := NewResolver([]string{"10.0.0.300"})
+	assert.Error(t, err)
+}
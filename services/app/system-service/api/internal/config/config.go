@@ -14,6 +14,34 @@ type Config struct {
 		AccessSecret string
 		AccessExpire int64
 	}
+	RefreshToken struct {
+		Expire           int64 `json:",default=86400"`   // 普通登录刷新令牌有效期（秒），默认 1 天
+		RememberMeExpire int64 `json:",default=2592000"` // 记住我刷新令牌有效期（秒），默认 30 天
+	}
+	TrustedProxies []string `json:",optional"` // 受信反向代理 IP 或 CIDR，仅来自这些地址的请求采信 X-Forwarded-For
+	LoginLock      struct {
+		Enabled            bool  `json:",default=true"` // 是否启用登录失败自动锁定
+		MaxAccountFailures int64 `json:",default=5"`    // 统计窗口内单个账号允许的失败次数
+		MaxIPFailures      int64 `json:",default=20"`   // 统计窗口内单个来源 IP 允许的失败次数
+		Window             int64 `json:",default=900"`  // 失败次数统计窗口（秒），默认 15 分钟
+		Cooldown           int64 `json:",default=0"`    // 自动锁定后的自动解锁冷却时间（秒），0 表示需管理员解锁
+	}
+	Jobs struct {
+		Workers      int    `json:",default=2"`         // worker 数量
+		PollInterval int64  `json:",default=2"`         // 待执行任务轮询间隔（秒）
+		LockTTL      int64  `json:",default=60"`        // 任务锁有效期（秒），执行期间定期续期
+		StorageDir   string `json:",default=data/jobs"` // 上传文件与结果文件存储目录
+		FileTTL      int64  `json:",default=604800"`    // 任务文件保留时长（秒），超过后由 worker 池定期清理
+	}
+	Authz struct {
+		CacheTTL int64 `json:",default=300"` // 用户授权快照缓存时长（秒）
+	}
+	PermissionCatalog struct {
+		SeedFile string `json:",default=etc/permission_catalog.yaml"` // 权限目录初始化文件，启动时补登记缺失的模块
+	}
+	PermissionTemplate struct {
+		ImpactAckThreshold int `json:",default=0"` // 发布/停用导致失去权限的用户数超过该值时需提交影响预览确认令牌，0 表示不要求
+	}
 	Telemetry telemetry.Config
 	DB        struct {
 		Default struct {
@@ -5,3 +5,18 @@ type contextKey string
 
 // UserIDKey 用于在 context 中存储当前用户 ID（JWT 解析后由 middleware 注入）
 const UserIDKey contextKey = "user_id"
+
+// TokenIDKey 用于在 context 中存储当前 Token 的 jti（由 middleware 注入，退出登录时用于吊销）
+const TokenIDKey contextKey = "token_id"
+
+// TokenExpiresAtKey 用于在 context 中存储当前 Token 的过期时间（time.Time）
+const TokenExpiresAtKey contextKey = "token_expires_at"
+
+// SessionIDKey 用于在 context 中存储当前 Token 所属的登录会话 ID（sid claim）
+const SessionIDKey contextKey = "session_id"
+
+// ClientIPKey 用于在 context 中存储客户端 IP（由 handler 注入）
+const ClientIPKey contextKey = "client_ip"
+
+// UserAgentKey 用于在 context 中存储客户端 User-Agent（由 handler 注入）
+const UserAgentKey contextKey = "user_agent"
@@ -0,0 +1,243 @@
+package datascope
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/userdept"
+	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 受数据范围约束的模块（与权限模板策略矩阵中的模块编码一致）
+const (
+	ModuleUser         = "user"
+	ModuleOrganization = "organization"
+)
+
+const (
+	// deptKeyPrefix 用户主部门及下级部门集合（user:dept:{userId}）
+	deptKeyPrefix = "user:dept:"
+	// deptCacheTTL 部门集合缓存时长
+	deptCacheTTL = 24 * time.Hour
+)
+
+// Scope 调用方对某一模块的数据范围
+// All 为 true 时不限制；否则只能访问 DeptIds 中的部门及主部门在其中的用户，本人数据始终可见
+type Scope struct {
+	All     bool
+	UserId  string
+	DeptIds []string
+}
+
+// AllowsDept 判断是否可访问部门数据
+func (s *Scope) AllowsDept(deptId string) bool {
+	if s.All {
+		return true
+	}
+	for _, id := range s.DeptIds {
+		if id == deptId {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsUser 判断是否可访问用户数据（本人或主部门在范围内）
+func (s *Scope) AllowsUser(userId, deptId string) bool {
+	return s.All || userId == s.UserId || (deptId != "" && s.AllowsDept(deptId))
+}
+
+// UserFilter 返回用户查询的数据范围过滤条件，不限制时返回 nil
+func (s *Scope) UserFilter() *users.DataScopeFilter {
+	if s.All {
+		return nil
+	}
+	return &users.DataScopeFilter{DeptIds: s.DeptIds, UserId: s.UserId}
+}
+
+// Resolver 数据范围解析器：按调用方有效权限中模块的数据范围计算可访问的部门
+// 多个授权来源的数据范围取并集；本部门及下级部门读取 user:dept:{userId} 集合，未命中时重建
+// 方法对 nil 接收者安全：未配置时不限制数据范围
+type Resolver struct {
+	rdb           *redis.Client
+	permissions   *authz.Resolver
+	userDeptModel userdept.Model
+	orgModel      organization.Model
+}
+
+// NewResolver 创建数据范围解析器
+func NewResolver(rdb *redis.Client, permissions *authz.Resolver, userDeptModel userdept.Model, orgModel organization.Model) *Resolver {
+	return &Resolver{
+		rdb:           rdb,
+		permissions:   permissions,
+		userDeptModel: userDeptModel,
+		orgModel:      orgModel,
+	}
+}
+
+// Resolve 解析当前登录用户对模块的数据范围，context 中没有用户（内部调用）时不限制
+func (r *Resolver) Resolve(ctx context.Context, module string) (*Scope, error) {
+	userId, _ := ctx.Value(contextkeys.UserIDKey).(string)
+	if r == nil || userId == "" {
+		return &Scope{All: true, UserId: userId}, nil
+	}
+	return r.ResolveUser(ctx, userId, module)
+}
+
+// ResolveUser 解析指定用户对模块的数据范围
+// global/domain/project 不约束组织架构数据；organization 为角色绑定组织及其下级；未授权该模块或数据范围未知时仅本人
+func (r *Resolver) ResolveUser(ctx context.Context, userId, module string) (*Scope, error) {
+	effective, err := r.permissions.Effective(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := &Scope{UserId: userId}
+	depts := make(map[string]struct{})
+	for _, key := range []string{module, authz.Wildcard} {
+		perm, ok := effective.Modules[key]
+		if !ok {
+			continue
+		}
+		for _, s := range perm.Scopes {
+			var ids []string
+			switch s {
+			case authz.ScopeGlobal, authz.ScopeDomain, authz.ScopeProject:
+				scope.All = true
+				return scope, nil
+			case authz.ScopeSelf:
+				// 仅本人：本人数据始终可见，无需额外部门
+			case authz.ScopeDepartment:
+				primary, err := r.userDeptModel.FindPrimaryByUserId(ctx, userId)
+				if err != nil {
+					return nil, fmt.Errorf("查询主部门失败: %w", err)
+				}
+				if primary != nil {
+					ids = []string{primary.DeptId}
+				}
+			case authz.ScopeDepartmentTree:
+				if ids, err = r.DeptTree(ctx, userId); err != nil {
+					return nil, err
+				}
+			case authz.ScopeOrganization:
+				for _, orgId := range perm.OrgIds {
+					subtree, err := r.subtree(ctx, orgId)
+					if err != nil {
+						return nil, err
+					}
+					ids = append(ids, subtree...)
+				}
+			default:
+				// 未知数据范围按仅本人处理（失败关闭）
+				logx.WithContext(ctx).Errorf("未知数据范围按仅本人处理: user=%s, module=%s, scope=%s", userId, key, s)
+			}
+			for _, id := range ids {
+				depts[id] = struct{}{}
+			}
+		}
+	}
+
+	scope.DeptIds = make([]string, 0, len(depts))
+	for id := range depts {
+		scope.DeptIds = append(scope.DeptIds, id)
+	}
+	sort.Strings(scope.DeptIds)
+	return scope, nil
+}
+
+// DeptTree 返回用户主部门及全部下级部门ID，优先读取缓存；缓存读取失败时回源数据库
+func (r *Resolver) DeptTree(ctx context.Context, userId string) ([]string, error) {
+	members, err := r.rdb.SMembers(ctx, deptKeyPrefix+userId).Result()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("读取用户部门缓存失败: userId=%s, error=%v", userId, err)
+	}
+	if len(members) > 0 {
+		sort.Strings(members)
+		return members, nil
+	}
+	return r.BuildDeptCache(ctx, userId)
+}
+
+// BuildDeptCache 查询用户主部门及全部下级部门并写入 user:dept:{userId} 集合，用户没有主部门时不写入
+func (r *Resolver) BuildDeptCache(ctx context.Context, userId string) ([]string, error) {
+	// 1. 查询用户主部门
+	primaryDept, err := r.userDeptModel.FindPrimaryByUserId(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("查询主部门失败: %w", err)
+	}
+	if primaryDept == nil {
+		return nil, nil
+	}
+
+	// 2. 查询主部门的所有子部门（包含主部门本身）
+	depts, err := r.subtree(ctx, primaryDept.DeptId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 写入 Redis Set（写入失败不影响本次结果）
+	key := deptKeyPrefix + userId
+	members := make([]interface{}, len(depts))
+	for i, deptId := range depts {
+		members[i] = deptId
+	}
+	pipe := r.rdb.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.SAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, deptCacheTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		logx.WithContext(ctx).Errorf("写入用户部门缓存失败: userId=%s, error=%v", userId, err)
+	}
+	return depts, nil
+}
+
+// Invalidate 失效用户的部门集合缓存（用户主部门变更时调用）
+func (r *Resolver) Invalidate(ctx context.Context, userId string) error {
+	if r == nil {
+		return nil
+	}
+	return r.rdb.Del(ctx, deptKeyPrefix+userId).Err()
+}
+
+// InvalidateAll 失效全部用户的部门集合缓存（新增、移动部门时调用）
+func (r *Resolver) InvalidateAll(ctx context.Context) error {
+	if r == nil {
+		return nil
+	}
+	var keys []string
+	iter := r.rdb.Scan(ctx, 0, deptKeyPrefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return r.rdb.Del(ctx, keys...).Err()
+}
+
+// subtree 返回部门及其全部下级部门ID（升序）
+func (r *Resolver) subtree(ctx context.Context, deptId string) ([]string, error) {
+	orgs, err := r.orgModel.FindSubtree(ctx, deptId)
+	if err != nil {
+		return nil, fmt.Errorf("查询子部门失败: %w", err)
+	}
+	ids := []string{deptId}
+	for _, org := range orgs {
+		if org.Id != deptId {
+			ids = append(ids, org.Id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
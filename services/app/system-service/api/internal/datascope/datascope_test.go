@@ -0,0 +1,143 @@
+package datascope
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/testutil"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/userdept"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupDataScopeTest 创建数据范围测试环境（SQLite + miniredis）
+// 组织：总公司 root -> 研发部 rd -> 后端组 rd-be；市场部 mkt。user-1 主部门为研发部
+func setupDataScopeTest(t *testing.T) (*gorm.DB, *redis.Client, *Resolver) {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&rolebindings.RoleBinding{}, &organization.SysOrganization{}, &userdept.SysUserDept{}))
+
+	testutil.CreatePermissionTables(t, db)
+
+	now := time.Now().Format("2006-01-02 15:04:05")
+	for _, org := range []*organization.SysOrganization{
+		{Id: "root", ParentId: "0", Ancestors: "0", Name: "总公司", Code: "root"},
+		{Id: "rd", ParentId: "root", Ancestors: "0,root", Name: "研发部", Code: "rd"},
+		{Id: "rd-be", ParentId: "rd", Ancestors: "0,root,rd", Name: "后端组", Code: "rd_be"},
+		{Id: "mkt", ParentId: "root", Ancestors: "0,root", Name: "市场部", Code: "mkt"},
+	} {
+		org.CreatedAt, org.UpdatedAt = now, now
+		require.NoError(t, db.Create(org).Error)
+	}
+	require.NoError(t, db.Create(&userdept.SysUserDept{Id: "ud-1", UserId: "user-1", DeptId: "rd", IsPrimary: 1}).Error)
+
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	permissions := authz.NewResolver(rolebindings.NewModel(db), roles.NewModel(db), permissiontemplates.NewModel(db), permissiontemplateversions.NewModel(db))
+	return db, rdb, NewResolver(rdb, permissions, userdept.NewModel(db), organization.NewModel(db))
+}
+
+// grantUserScope 为 user-1 绑定 user 模块指定数据范围的已发布模板
+func grantUserScope(t *testing.T, db *gorm.DB, scope string) {
+	now := time.Now()
+	require.NoError(t, db.Create(&permissiontemplates.PermissionTemplate{
+		Id: "tpl-" + scope, Name: scope, Code: scope, Status: permissiontemplates.StatusPublished,
+		PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read"],"scope":"` + scope + `"}}`),
+		Version:      1, CreatedBy: "system", CreatedAt: now, UpdatedAt: now,
+	}).Error)
+	permissionRole := scope
+	require.NoError(t, db.Create(&rolebindings.RoleBinding{UserId: "user-1", OrgId: "rd", PermissionRole: &permissionRole}).Error)
+}
+
+func TestResolveUser_DepartmentTreeUsesDeptCache(t *testing.T) {
+	db, rdb, resolver := setupDataScopeTest(t)
+	grantUserScope(t, db, authz.ScopeDepartmentTree)
+	ctx := context.Background()
+
+	scope, err := resolver.ResolveUser(ctx, "user-1", ModuleUser)
+
+	require.NoError(t, err)
+	assert.False(t, scope.All)
+	assert.Equal(t, []string{"rd", "rd-be"}, scope.DeptIds)
+	members, err := rdb.SMembers(ctx, "user:dept:user-1").Result()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"rd", "rd-be"}, members)
+
+	// 缓存命中时不再回源
+	require.NoError(t, rdb.SAdd(ctx, "user:dept:user-1", "cached").Err())
+	scope, err = resolver.ResolveUser(ctx, "user-1", ModuleUser)
+	require.NoError(t, err)
+	assert.Contains(t, scope.DeptIds, "cached")
+
+	// 部门结构变更后失效缓存
+	require.NoError(t, resolver.InvalidateAll(ctx))
+	exists, err := rdb.Exists(ctx, "user:dept:user-1").Result()
+	require.NoError(t, err)
+	assert.Zero(t, exists)
+}
+
+func TestResolveUser_ScopeKinds(t *testing.T) {
+	tests := []struct {
+		name    string
+		scope   string // 空表示未授权 user 模块
+		all     bool
+		deptIds []string
+	}{
+		{name: "global", scope: authz.ScopeGlobal, all: true},
+		{name: "department", scope: authz.ScopeDepartment, deptIds: []string{"rd"}},
+		{name: "organization", scope: authz.ScopeOrganization, deptIds: []string{"rd", "rd-be"}},
+		{name: "self", scope: authz.ScopeSelf, deptIds: []string{}},
+		{name: "unknown", scope: "everything", deptIds: []string{}},
+		{name: "ungranted", deptIds: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, _, resolver := setupDataScopeTest(t)
+			if tt.scope != "" {
+				grantUserScope(t, db, tt.scope)
+			}
+
+			scope, err := resolver.ResolveUser(context.Background(), "user-1", ModuleUser)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.all, scope.All)
+			if !tt.all {
+				assert.Equal(t, tt.deptIds, scope.DeptIds)
+				assert.True(t, scope.AllowsUser("user-1", ""), "本人数据始终可见")
+				assert.False(t, scope.AllowsUser("user-2", "mkt"))
+			}
+		})
+	}
+}
+
+func TestResolve_WithoutUserOrResolverIsUnrestricted(t *testing.T) {
+	var resolver *Resolver
+	ctx := context.WithValue(context.Background(), contextkeys.UserIDKey, "user-1")
+
+	scope, err := resolver.Resolve(ctx, ModuleUser)
+	require.NoError(t, err)
+	assert.True(t, scope.All)
+	assert.Nil(t, scope.UserFilter())
+
+	_, _, resolver = setupDataScopeTest(t)
+	scope, err = resolver.Resolve(context.Background(), ModuleUser)
+	require.NoError(t, err)
+	assert.True(t, scope.All)
+}
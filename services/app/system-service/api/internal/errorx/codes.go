@@ -30,6 +30,21 @@ const (
 
 	// 30105: 未授权访问
 	ErrUnauthorized = 30105
+
+	// 30106: 刷新令牌无效或已过期
+	ErrRefreshTokenInvalid = 30106
+
+	// 30107: 刷新令牌被重复使用
+	ErrRefreshTokenReused = 30107
+
+	// 30108: 会话不存在
+	ErrSessionNotFound = 30108
+
+	// 30109: 账号已锁定
+	ErrAccountLocked = 30109
+
+	// 30110: 登录失败次数过多
+	ErrTooManyLoginAttempts = 30110
 )
 
 // 用户管理错误码范围: 30200-30299
@@ -67,6 +82,12 @@ const (
 
 	// 30210: 角色绑定不存在
 	ErrUserManagementRoleBindingNotFound = 30210
+
+	// 30211: 导入文件无效
+	ErrUserManagementImportInvalidFile = 30211
+
+	// 30212: 导入行数超过上限
+	ErrUserManagementImportTooManyRows = 30212
 )
 
 // 组织架构错误码范围: 200100-200129
@@ -155,9 +176,24 @@ const (
 
 	// 200145: 菜单已删除
 	ErrMenuDeleted = 200145
+
+	// 200146: 菜单配置文件无效
+	ErrMenuImportInvalidFile = 200146
+
+	// 200147: 菜单配置校验失败
+	ErrMenuImportInvalid = 200147
+
+	// 200148: 审计记录无法用于恢复菜单
+	ErrMenuRevertInvalid = 200148
+
+	// 200149: 父菜单不存在或已删除，无法恢复
+	ErrMenuRevertParentMissing = 200149
+
+	// 200150: 批量操作菜单数量超出限制
+	ErrMenuBatchTooLarge = 200150
 )
 
-// 权限模板错误码范围: 200151-200175
+// 权限模板错误码范围: 200151-200179
 
 const (
 	// 200151: 模板名称或编码为空
@@ -234,4 +270,125 @@ const (
 
 	// 200175: 模板重新启用失败
 	ErrPermissionTemplateEnableFailed = 200175
+
+	// 200176: 模板版本不存在
+	ErrPermissionTemplateVersionNotFound = 200176
+
+	// 200177: 模板版本快照已存在
+	ErrPermissionTemplateVersionExists = 200177
+
+	// 200178: 模板没有待发布的工作草稿
+	ErrPermissionTemplateDraftNotFound = 200178
+
+	// 200179: 影响较大的变更缺少影响预览确认令牌
+	ErrPermissionTemplateImpactAckRequired = 200179
+)
+
+// 角色错误码范围: 200180-200199
+
+const (
+	// 200180: 角色不存在
+	ErrRoleNotFound = 200180
+
+	// 200181: 角色编码已存在
+	ErrRoleCodeExists = 200181
+
+	// 200182: 模板版本号无效
+	ErrRoleInvalidTemplateVersion = 200182
+
+	// 200183: 组织范围角色未指定组织
+	ErrRoleOrgRequired = 200183
+
+	// 200184: 角色已被绑定，禁止删除
+	ErrRoleInUse = 200184
+)
+
+// 权限目录错误码范围: 200200-200219
+
+const (
+	// 200200: 权限目录模块不存在
+	ErrCatalogModuleNotFound = 200200
+
+	// 200201: 权限目录模块编码已存在
+	ErrCatalogModuleCodeExists = 200201
+
+	// 200202: 权限目录模块被已发布模板引用，禁止删除
+	ErrCatalogModuleInUse = 200202
+
+	// 200203: 权限目录模块定义无效
+	ErrCatalogModuleInvalid = 200203
+)
+
+// 权限点错误码范围: 200220-200239
+
+const (
+	// 200220: 权限点不存在
+	ErrPermissionPointNotFound = 200220
+
+	// 200221: 权限标识已存在
+	ErrPermissionPointKeyExists = 200221
+
+	// 200222: 权限点被菜单引用，禁止删除
+	ErrPermissionPointInUse = 200222
+
+	// 200223: 所属模块未在权限目录中登记
+	ErrPermissionPointModuleInvalid = 200223
+)
+
+// 权限模板继承错误码范围: 200240-200259
+
+const (
+	// 200240: 父模板不存在
+	ErrPermissionTemplateParentNotFound = 200240
+
+	// 200241: 模板继承关系存在循环
+	ErrPermissionTemplateInheritanceCycle = 200241
+
+	// 200242: 模板被子模板继承，无法删除
+	ErrPermissionTemplateHasChildren = 200242
+
+	// 200243: 父模板未发布，子模板无法发布
+	ErrPermissionTemplateParentNotPublished = 200243
+)
+
+// 菜单分组错误码范围: 200260-200279
+
+const (
+	// 200260: 菜单分组不存在
+	ErrMenuGroupNotFound = 200260
+
+	// 200261: 菜单分组编码已存在
+	ErrMenuGroupCodeExists = 200261
+
+	// 200262: 菜单改挂的目标分组无效
+	ErrMenuGroupReassignInvalid = 200262
+)
+
+// 权限校验错误码范围: 30300-30399
+
+const (
+	// 30300: 无访问权限
+	ErrForbidden = 30300
+
+	// 30301: 路由未声明所需权限
+	ErrPermissionRuleNotDeclared = 30301
+
+	// 30302: 授权判定请求无效
+	ErrAuthzInvalidCheckRequest = 30302
+)
+
+// 异步任务错误码范围: 30400-30499
+
+const (
+	// 30400: 任务不存在
+	ErrJobNotFound = 30400
+
+	// 30401: 任务状态不允许当前操作
+	ErrJobInvalidStatus = 30401
+
+	// 30402: 任务结果文件不可下载
+	ErrJobResultNotReady = 30402
+
+	// 30403: 任务提交失败
+	ErrJobSubmitFailed = 30403
 )
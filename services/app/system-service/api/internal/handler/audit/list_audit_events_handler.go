@@ -0,0 +1,32 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package audit
+
+import (
+	"net/http"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/audit"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 查询审计事件
+func ListAuditEventsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ListAuditEventsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := audit.NewListAuditEventsLogic(r.Context(), svcCtx)
+		resp, err := l.ListAuditEvents(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
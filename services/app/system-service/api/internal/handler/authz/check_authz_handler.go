@@ -0,0 +1,32 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package authz
+
+import (
+	"net/http"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 批量授权判定（策略决策点）
+func CheckAuthzHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CheckAuthzReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := authz.NewCheckAuthzLogic(r.Context(), svcCtx)
+		resp, err := l.CheckAuthz(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
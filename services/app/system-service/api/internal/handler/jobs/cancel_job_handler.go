@@ -0,0 +1,32 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package jobs
+
+import (
+	"net/http"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/jobs"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 取消任务
+func CancelJobHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.JobIdReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := jobs.NewCancelJobLogic(r.Context(), svcCtx)
+		resp, err := l.CancelJob(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
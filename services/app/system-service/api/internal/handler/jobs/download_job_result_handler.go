@@ -0,0 +1,43 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package jobs
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/jobs"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 下载任务结果文件
+func DownloadJobResultHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.JobIdReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := jobs.NewDownloadJobResultLogic(r.Context(), svcCtx)
+		file, err := l.DownloadJobResult(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+		defer file.Content.Close()
+
+		// 以附件形式输出，写出过程中出错时响应已开始，只能记录日志
+		w.Header().Set("Content-Type", file.ContentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Filename))
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.Copy(w, file.Content); err != nil {
+			logx.WithContext(r.Context()).Errorf("下载任务结果文件失败: %v", err)
+		}
+	}
+}
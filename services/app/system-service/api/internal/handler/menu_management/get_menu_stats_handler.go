@@ -8,13 +8,20 @@ import (
 
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/menu_management"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	"github.com/zeromicro/go-zero/rest/httpx"
 )
 
 func GetMenuStatsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GetMenuStatsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
 		l := menu_management.NewGetMenuStatsLogic(r.Context(), svcCtx)
-		resp, err := l.GetMenuStats()
+		resp, err := l.GetMenuStats(&req)
 		if err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
 		} else {
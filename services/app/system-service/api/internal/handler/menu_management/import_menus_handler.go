@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/menu_management"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// maxMenuImportFileSize 菜单配置文件大小上限（5MB）
+const maxMenuImportFileSize = 5 << 20
+
+func ImportMenusHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, maxMenuImportFileSize+1<<20)
+
+		var req types.ImportMenusReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 读取上传文件（multipart 字段名 file）
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, menus.ErrMenuImportInvalidFile)
+			return
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(io.LimitReader(file, maxMenuImportFileSize+1))
+		if err != nil || len(content) > maxMenuImportFileSize {
+			httpx.ErrorCtx(r.Context(), w, menus.ErrMenuImportInvalidFile)
+			return
+		}
+
+		l := menu_management.NewImportMenusLogic(r.Context(), svcCtx)
+		resp, err := l.ImportMenus(&req, header.Filename, content)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
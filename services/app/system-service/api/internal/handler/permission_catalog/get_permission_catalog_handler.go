@@ -0,0 +1,24 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_catalog
+
+import (
+	"net/http"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/permission_catalog"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func GetPermissionCatalogHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := permission_catalog.NewGetPermissionCatalogLogic(r.Context(), svcCtx)
+		resp, err := l.GetPermissionCatalog()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
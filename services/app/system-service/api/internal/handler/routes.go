@@ -6,9 +6,15 @@ package handler
 import (
 	"net/http"
 
+	audit "github.com/DataSemanticHub/services/app/system-service/api/internal/handler/audit"
+	authz "github.com/DataSemanticHub/services/app/system-service/api/internal/handler/authz"
+	jobs "github.com/DataSemanticHub/services/app/system-service/api/internal/handler/jobs"
 	menu_management "github.com/DataSemanticHub/services/app/system-service/api/internal/handler/menu_management"
 	organization "github.com/DataSemanticHub/services/app/system-service/api/internal/handler/organization"
+	permission_catalog "github.com/DataSemanticHub/services/app/system-service/api/internal/handler/permission_catalog"
+	permission_point "github.com/DataSemanticHub/services/app/system-service/api/internal/handler/permission_point"
 	permission_template "github.com/DataSemanticHub/services/app/system-service/api/internal/handler/permission_template"
+	role "github.com/DataSemanticHub/services/app/system-service/api/internal/handler/role"
 	user "github.com/DataSemanticHub/services/app/system-service/api/internal/handler/user"
 	user_management "github.com/DataSemanticHub/services/app/system-service/api/internal/handler/user_management"
 	user_public "github.com/DataSemanticHub/services/app/system-service/api/internal/handler/user_public"
@@ -29,6 +35,71 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/api/v1"),
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Authority},
+			[]rest.Route{
+				{
+					// 查询审计事件
+					Method:  http.MethodGet,
+					Path:    "/audit-events",
+					Handler: audit.ListAuditEventsHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/system"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.TokenRevocation},
+			[]rest.Route{
+				{
+					// 查询任务状态
+					Method:  http.MethodGet,
+					Path:    "/jobs/:id",
+					Handler: jobs.GetJobHandler(serverCtx),
+				},
+				{
+					// 取消任务
+					Method:  http.MethodPost,
+					Path:    "/jobs/:id/cancel",
+					Handler: jobs.CancelJobHandler(serverCtx),
+				},
+				{
+					// 下载任务结果文件
+					Method:  http.MethodGet,
+					Path:    "/jobs/:id/download",
+					Handler: jobs.DownloadJobResultHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/api/v1/system"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.TokenRevocation, serverCtx.Authority},
+			[]rest.Route{
+				{
+					// 提交用户导出任务
+					Method:  http.MethodPost,
+					Path:    "/jobs/user-export",
+					Handler: jobs.SubmitUserExportJobHandler(serverCtx),
+				},
+				{
+					// 提交用户批量导入任务
+					Method:  http.MethodPost,
+					Path:    "/jobs/user-import",
+					Handler: jobs.SubmitUserImportJobHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/api/v1/system"),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -100,6 +171,110 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/api/v1/system"),
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.TokenRevocation, serverCtx.Authority},
+			[]rest.Route{
+				{
+					// 菜单分组列表
+					Method:  http.MethodGet,
+					Path:    "/menu-groups",
+					Handler: menu_management.ListMenuGroupsHandler(serverCtx),
+				},
+				{
+					// 创建菜单分组
+					Method:  http.MethodPost,
+					Path:    "/menu-groups",
+					Handler: menu_management.CreateMenuGroupHandler(serverCtx),
+				},
+				{
+					// 菜单分组详情
+					Method:  http.MethodGet,
+					Path:    "/menu-groups/:id",
+					Handler: menu_management.GetMenuGroupHandler(serverCtx),
+				},
+				{
+					// 更新菜单分组
+					Method:  http.MethodPut,
+					Path:    "/menu-groups/:id",
+					Handler: menu_management.UpdateMenuGroupHandler(serverCtx),
+				},
+				{
+					// 删除菜单分组（分组下菜单改挂到目标分组）
+					Method:  http.MethodDelete,
+					Path:    "/menu-groups/:id",
+					Handler: menu_management.DeleteMenuGroupHandler(serverCtx),
+				},
+				{
+					// 按审计记录恢复菜单
+					Method:  http.MethodPost,
+					Path:    "/menus/:id/revert",
+					Handler: menu_management.RevertMenuHandler(serverCtx),
+				},
+				{
+					// 批量绑定权限
+					Method:  http.MethodPost,
+					Path:    "/menus/batch/bind-permission",
+					Handler: menu_management.BatchBindPermissionHandler(serverCtx),
+				},
+				{
+					// 批量删除
+					Method:  http.MethodPost,
+					Path:    "/menus/batch/delete",
+					Handler: menu_management.BatchDeleteMenusHandler(serverCtx),
+				},
+				{
+					// 批量启用/禁用
+					Method:  http.MethodPost,
+					Path:    "/menus/batch/enabled",
+					Handler: menu_management.BatchToggleMenusEnabledHandler(serverCtx),
+				},
+				{
+					// 批量移动
+					Method:  http.MethodPost,
+					Path:    "/menus/batch/move",
+					Handler: menu_management.BatchMoveMenusHandler(serverCtx),
+				},
+				{
+					// 批量显示/隐藏
+					Method:  http.MethodPost,
+					Path:    "/menus/batch/visible",
+					Handler: menu_management.BatchToggleMenusVisibleHandler(serverCtx),
+				},
+				{
+					// 导出菜单配置
+					Method:  http.MethodGet,
+					Path:    "/menus/export",
+					Handler: menu_management.ExportMenusHandler(serverCtx),
+				},
+				{
+					// 导入菜单配置
+					Method:  http.MethodPost,
+					Path:    "/menus/import",
+					Handler: menu_management.ImportMenusHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/api/v1/system"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.TokenRevocation},
+			[]rest.Route{
+				{
+					// 获取当前用户的导航菜单
+					Method:  http.MethodGet,
+					Path:    "/menus/my",
+					Handler: menu_management.GetMyMenusHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/api/v1/system"),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.Authority},
@@ -128,12 +303,24 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/organization/:id",
 					Handler: organization.DeleteOrgHandler(serverCtx),
 				},
+				{
+					// 查询部门审计日志
+					Method:  http.MethodGet,
+					Path:    "/organization/:id/audits",
+					Handler: organization.GetOrgAuditsHandler(serverCtx),
+				},
 				{
 					// 获取部门用户
 					Method:  http.MethodGet,
 					Path:    "/organization/:id/users",
 					Handler: organization.GetOrgUsersHandler(serverCtx),
 				},
+				{
+					// 查询组织架构审计日志
+					Method:  http.MethodGet,
+					Path:    "/organization/audits",
+					Handler: organization.ListOrgAuditsHandler(serverCtx),
+				},
 				{
 					// 移动组织
 					Method:  http.MethodPost,
@@ -203,6 +390,11 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/permission-templates/:id/clone",
 					Handler: permission_template.ClonePermissionTemplateHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/permission-templates/:id/discard-draft",
+					Handler: permission_template.DiscardPermissionTemplateDraftHandler(serverCtx),
+				},
 				{
 					Method:  http.MethodPost,
 					Path:    "/permission-templates/:id/disable",
@@ -213,104 +405,312 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/permission-templates/:id/enable",
 					Handler: permission_template.EnablePermissionTemplateHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/permission-templates/:id/history",
+					Handler: permission_template.GetPermissionTemplateHistoryHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/permission-templates/:id/impact",
+					Handler: permission_template.PreviewPermissionTemplateImpactHandler(serverCtx),
+				},
 				{
 					Method:  http.MethodPost,
 					Path:    "/permission-templates/:id/publish",
 					Handler: permission_template.PublishPermissionTemplateHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/permission-templates/:id/version-diff",
+					Handler: permission_template.DiffPermissionTemplateVersionsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/permission-templates/:id/versions",
+					Handler: permission_template.ListPermissionTemplateVersionsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/permission-templates/:id/versions/:version",
+					Handler: permission_template.GetPermissionTemplateVersionHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/permission-templates/:id/versions/:version/restore",
+					Handler: permission_template.RestorePermissionTemplateVersionHandler(serverCtx),
+				},
 			}...,
 		),
 		rest.WithPrefix("/api/v1/system"),
 	)
 
 	server.AddRoutes(
-		[]rest.Route{
-			{
-				// 获取当前用户信息
-				Method:  http.MethodGet,
-				Path:    "/user/info",
-				Handler: user.GetUserInfoHandler(serverCtx),
-			},
-			{
-				// 退出登录
-				Method:  http.MethodPost,
-				Path:    "/user/logout",
-				Handler: user.LogoutHandler(serverCtx),
-			},
-		},
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Authority},
+			[]rest.Route{
+				{
+					// 创建角色
+					Method:  http.MethodPost,
+					Path:    "/roles",
+					Handler: role.CreateRoleHandler(serverCtx),
+				},
+				{
+					// 查询角色列表
+					Method:  http.MethodGet,
+					Path:    "/roles",
+					Handler: role.ListRolesHandler(serverCtx),
+				},
+				{
+					// 获取角色详情
+					Method:  http.MethodGet,
+					Path:    "/roles/:id",
+					Handler: role.GetRoleHandler(serverCtx),
+				},
+				{
+					// 更新角色
+					Method:  http.MethodPut,
+					Path:    "/roles/:id",
+					Handler: role.UpdateRoleHandler(serverCtx),
+				},
+				{
+					// 删除角色
+					Method:  http.MethodDelete,
+					Path:    "/roles/:id",
+					Handler: role.DeleteRoleHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/system"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Authority},
+			[]rest.Route{
+				{
+					// 批量授权判定（策略决策点）
+					Method:  http.MethodPost,
+					Path:    "/authz/check",
+					Handler: authz.CheckAuthzHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/system"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Authority},
+			[]rest.Route{
+				{
+					// 查询权限目录
+					Method:  http.MethodGet,
+					Path:    "/permission-catalog",
+					Handler: permission_catalog.GetPermissionCatalogHandler(serverCtx),
+				},
+				{
+					// 登记权限目录模块
+					Method:  http.MethodPost,
+					Path:    "/permission-catalog/modules",
+					Handler: permission_catalog.CreateCatalogModuleHandler(serverCtx),
+				},
+				{
+					// 更新权限目录模块
+					Method:  http.MethodPut,
+					Path:    "/permission-catalog/modules/:id",
+					Handler: permission_catalog.UpdateCatalogModuleHandler(serverCtx),
+				},
+				{
+					// 删除权限目录模块
+					Method:  http.MethodDelete,
+					Path:    "/permission-catalog/modules/:id",
+					Handler: permission_catalog.DeleteCatalogModuleHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/system"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Authority},
+			[]rest.Route{
+				{
+					// 查询权限点列表
+					Method:  http.MethodGet,
+					Path:    "/permission-points",
+					Handler: permission_point.ListPermissionPointsHandler(serverCtx),
+				},
+				{
+					// 创建权限点
+					Method:  http.MethodPost,
+					Path:    "/permission-points",
+					Handler: permission_point.CreatePermissionPointHandler(serverCtx),
+				},
+				{
+					// 获取权限点详情
+					Method:  http.MethodGet,
+					Path:    "/permission-points/:id",
+					Handler: permission_point.GetPermissionPointHandler(serverCtx),
+				},
+				{
+					// 更新权限点
+					Method:  http.MethodPut,
+					Path:    "/permission-points/:id",
+					Handler: permission_point.UpdatePermissionPointHandler(serverCtx),
+				},
+				{
+					// 删除权限点
+					Method:  http.MethodDelete,
+					Path:    "/permission-points/:id",
+					Handler: permission_point.DeletePermissionPointHandler(serverCtx),
+				},
+				{
+					// 查询引用权限点的菜单与权限模板
+					Method:  http.MethodGet,
+					Path:    "/permission-points/:id/references",
+					Handler: permission_point.GetPermissionPointReferencesHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/system"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.TokenRevocation},
+			[]rest.Route{
+				{
+					// 获取当前用户信息
+					Method:  http.MethodGet,
+					Path:    "/user/info",
+					Handler: user.GetUserInfoHandler(serverCtx),
+				},
+				{
+					// 获取当前用户的有效权限
+					Method:  http.MethodGet,
+					Path:    "/user/permissions",
+					Handler: user.GetMyPermissionsHandler(serverCtx),
+				},
+				{
+					// 退出登录
+					Method:  http.MethodPost,
+					Path:    "/user/logout",
+					Handler: user.LogoutHandler(serverCtx),
+				},
+				{
+					// 查询当前用户的登录会话
+					Method:  http.MethodGet,
+					Path:    "/user/sessions",
+					Handler: user.ListSessionsHandler(serverCtx),
+				},
+				{
+					// 吊销登录会话
+					Method:  http.MethodDelete,
+					Path:    "/user/sessions/:id",
+					Handler: user.RevokeSessionHandler(serverCtx),
+				},
+			}...,
+		),
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
 		rest.WithPrefix("/api/v1"),
 	)
 
 	server.AddRoutes(
-		[]rest.Route{
-			{
-				// 获取统计数据
-				Method:  http.MethodGet,
-				Path:    "/statistics",
-				Handler: user_management.GetStatisticsHandler(serverCtx),
-			},
-			{
-				// 用户列表查询
-				Method:  http.MethodGet,
-				Path:    "/users",
-				Handler: user_management.ListUsersHandler(serverCtx),
-			},
-			{
-				// 创建用户
-				Method:  http.MethodPost,
-				Path:    "/users",
-				Handler: user_management.CreateUserHandler(serverCtx),
-			},
-			{
-				// 用户详情查询
-				Method:  http.MethodGet,
-				Path:    "/users/:id",
-				Handler: user_management.GetUserHandler(serverCtx),
-			},
-			{
-				// 更新用户
-				Method:  http.MethodPut,
-				Path:    "/users/:id",
-				Handler: user_management.UpdateUserHandler(serverCtx),
-			},
-			{
-				// 删除用户
-				Method:  http.MethodDelete,
-				Path:    "/users/:id",
-				Handler: user_management.DeleteUserHandler(serverCtx),
-			},
-			{
-				// 重置用户密码
-				Method:  http.MethodPost,
-				Path:    "/users/:id/reset-password",
-				Handler: user_management.ResetPasswordHandler(serverCtx),
-			},
-			{
-				// 解锁用户
-				Method:  http.MethodPost,
-				Path:    "/users/:id/unlock",
-				Handler: user_management.UnlockUserHandler(serverCtx),
-			},
-			{
-				// 批量导入用户
-				Method:  http.MethodPost,
-				Path:    "/users/batch-import",
-				Handler: user_management.BatchImportHandler(serverCtx),
-			},
-			{
-				// 批量更新用户状态
-				Method:  http.MethodPost,
-				Path:    "/users/batch-status",
-				Handler: user_management.BatchUpdateStatusHandler(serverCtx),
-			},
-			{
-				// 导出用户数据
-				Method:  http.MethodGet,
-				Path:    "/users/export",
-				Handler: user_management.ExportUsersHandler(serverCtx),
-			},
-		},
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.TokenRevocation},
+			[]rest.Route{
+				{
+					// 获取统计数据
+					Method:  http.MethodGet,
+					Path:    "/statistics",
+					Handler: user_management.GetStatisticsHandler(serverCtx),
+				},
+				{
+					// 用户列表查询
+					Method:  http.MethodGet,
+					Path:    "/users",
+					Handler: user_management.ListUsersHandler(serverCtx),
+				},
+				{
+					// 创建用户
+					Method:  http.MethodPost,
+					Path:    "/users",
+					Handler: user_management.CreateUserHandler(serverCtx),
+				},
+				{
+					// 用户详情查询
+					Method:  http.MethodGet,
+					Path:    "/users/:id",
+					Handler: user_management.GetUserHandler(serverCtx),
+				},
+				{
+					// 更新用户
+					Method:  http.MethodPut,
+					Path:    "/users/:id",
+					Handler: user_management.UpdateUserHandler(serverCtx),
+				},
+				{
+					// 删除用户
+					Method:  http.MethodDelete,
+					Path:    "/users/:id",
+					Handler: user_management.DeleteUserHandler(serverCtx),
+				},
+				{
+					// 重置用户密码
+					Method:  http.MethodPost,
+					Path:    "/users/:id/reset-password",
+					Handler: user_management.ResetPasswordHandler(serverCtx),
+				},
+				{
+					// 解锁用户
+					Method:  http.MethodPost,
+					Path:    "/users/:id/unlock",
+					Handler: user_management.UnlockUserHandler(serverCtx),
+				},
+				{
+					// 批量更新用户状态
+					Method:  http.MethodPost,
+					Path:    "/users/batch-status",
+					Handler: user_management.BatchUpdateStatusHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/api/v1/user_management"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.TokenRevocation, serverCtx.Authority},
+			[]rest.Route{
+				{
+					// 查询用户有效权限
+					Method:  http.MethodGet,
+					Path:    "/users/:id/effective-permissions",
+					Handler: user_management.GetEffectivePermissionsHandler(serverCtx),
+				},
+				{
+					// 批量导入用户
+					Method:  http.MethodPost,
+					Path:    "/users/batch-import",
+					Handler: user_management.BatchImportHandler(serverCtx),
+				},
+				{
+					// 导出用户数据
+					Method:  http.MethodGet,
+					Path:    "/users/export",
+					Handler: user_management.ExportUsersHandler(serverCtx),
+				},
+				{
+					// 强制用户下线
+					Method:  http.MethodPost,
+					Path:    "/users/:id/force-logout",
+					Handler: user_management.ForceLogoutHandler(serverCtx),
+				},
+			}...,
+		),
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
 		rest.WithPrefix("/api/v1/user_management"),
 	)
@@ -323,6 +723,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/user/login",
 				Handler: user_public.LoginHandler(serverCtx),
 			},
+			{
+				// 刷新访问令牌
+				Method:  http.MethodPost,
+				Path:    "/user/token/refresh",
+				Handler: user_public.RefreshTokenHandler(serverCtx),
+			},
 			{
 				// 用户注册
 				Method:  http.MethodPost,
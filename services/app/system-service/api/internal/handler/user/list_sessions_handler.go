@@ -0,0 +1,25 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/user"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 查询当前用户的登录会话
+func ListSessionsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := user.NewListSessionsLogic(r.Context(), svcCtx)
+		resp, err := l.ListSessions()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -4,25 +4,51 @@
 package user_management
 
 import (
+	"io"
 	"net/http"
 
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/user_management"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
 	"github.com/zeromicro/go-zero/rest/httpx"
 )
 
+// maxImportFileSize 导入文件大小上限（10MB）
+const maxImportFileSize = 10 << 20
+
 // 批量导入用户
 func BatchImportHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, maxImportFileSize+1<<20)
+
 		var req types.BatchImportReq
 		if err := httpx.Parse(r, &req); err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
 			return
 		}
 
+		// 读取上传文件（multipart 字段名 file）
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, baseErrorx.New(20001, "导入文件不能为空"))
+			return
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(io.LimitReader(file, maxImportFileSize+1))
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, baseErrorx.New(errorx.ErrUserManagementImportInvalidFile, "读取导入文件失败"))
+			return
+		}
+		if len(content) > maxImportFileSize {
+			httpx.ErrorCtx(r.Context(), w, baseErrorx.New(errorx.ErrUserManagementImportInvalidFile, "导入文件不能超过 10MB"))
+			return
+		}
+
 		l := user_management.NewBatchImportLogic(r.Context(), svcCtx)
-		resp, err := l.BatchImport(&req)
+		resp, err := l.BatchImport(&req, header.Filename, content)
 		if err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
 		} else {
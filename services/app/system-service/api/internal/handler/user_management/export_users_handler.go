@@ -4,29 +4,38 @@
 package user_management
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/user_management"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/rest/httpx"
 )
 
 // 导出用户数据
 func ExportUsersHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var req types.ListUsersReq
+		var req types.ExportUsersReq
 		if err := httpx.Parse(r, &req); err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
 			return
 		}
 
 		l := user_management.NewExportUsersLogic(r.Context(), svcCtx)
-		resp, err := l.ExportUsers(&req)
+		export, err := l.ExportUsers(&req)
 		if err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
-		} else {
-			httpx.OkJsonCtx(r.Context(), w, resp)
+			return
+		}
+
+		// 以附件形式流式输出，写出过程中出错时响应已开始，只能记录日志
+		w.Header().Set("Content-Type", export.ContentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.Filename))
+		w.WriteHeader(http.StatusOK)
+		if err := export.Write(w); err != nil {
+			logx.WithContext(r.Context()).Errorf("导出用户数据失败: %v", err)
 		}
 	}
 }
@@ -4,8 +4,10 @@
 package user_public
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/user_public"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
@@ -21,7 +23,11 @@ func LoginHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 			return
 		}
 
-		l := user_public.NewLoginLogic(r.Context(), svcCtx)
+		// 注入客户端信息，用于登录失败计数和记录登录会话（仅受信代理转发时采信 X-Forwarded-For）
+		ctx := context.WithValue(r.Context(), contextkeys.ClientIPKey, svcCtx.ClientIP.FromRequest(r))
+		ctx = context.WithValue(ctx, contextkeys.UserAgentKey, r.UserAgent())
+
+		l := user_public.NewLoginLogic(ctx, svcCtx)
 		resp, err := l.Login(&req)
 		if err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
@@ -0,0 +1,88 @@
+package jobqueue
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// lockKeyPrefix 任务锁 Redis key 前缀
+const lockKeyPrefix = "jobs:lock:"
+
+// ErrLockHeld 任务锁已被其他 worker 持有
+var ErrLockHeld = errors.New("任务锁已被占用")
+
+// releaseScript 仅当锁仍由自己持有时删除
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// refreshScript 仅当锁仍由自己持有时续期
+var refreshScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// Locker 基于 Redis 的任务锁
+type Locker struct {
+	rdb *redis.Client
+}
+
+// Lock 已获取的任务锁
+type Lock struct {
+	rdb   *redis.Client
+	key   string
+	token string
+}
+
+// NewLocker 创建任务锁管理器
+func NewLocker(rdb *redis.Client) *Locker {
+	return &Locker{rdb: rdb}
+}
+
+// Acquire 获取任务锁，已被占用时返回 ErrLockHeld
+func (l *Locker) Acquire(ctx context.Context, jobId string, ttl time.Duration) (*Lock, error) {
+	lock := &Lock{rdb: l.rdb, key: lockKeyPrefix + jobId, token: uuid.NewString()}
+	ok, err := l.rdb.SetNX(ctx, lock.key, lock.token, ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrLockHeld
+	}
+	return lock, nil
+}
+
+// IsLocked 判断任务锁是否存在
+func (l *Locker) IsLocked(ctx context.Context, jobId string) (bool, error) {
+	n, err := l.rdb.Exists(ctx, lockKeyPrefix+jobId).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Refresh 续期任务锁
+func (lk *Lock) Refresh(ctx context.Context, ttl time.Duration) error {
+	n, err := refreshScript.Run(ctx, lk.rdb, []string{lk.key}, lk.token, ttl.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrLockHeld
+	}
+	return nil
+}
+
+// Release 释放任务锁
+func (lk *Lock) Release(ctx context.Context) error {
+	return releaseScript.Run(ctx, lk.rdb, []string{lk.key}, lk.token).Err()
+}
@@ -0,0 +1,342 @@
+package jobqueue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/model/system/jobs"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ErrCanceled 任务被取消
+var ErrCanceled = errors.New("任务已取消")
+
+// ErrLockLost 执行期间任务锁续期失败，任务可能已被其他 worker 接管
+var ErrLockLost = errors.New("任务锁已丢失")
+
+// Outcome 任务执行结果
+type Outcome struct {
+	Result     interface{} // 结果数据（JSON 序列化后保存）
+	ResultPath string      // 结果文件存储位置（可选）
+	ResultName string      // 结果文件下载名称（可选）
+}
+
+// ReportFunc 上报任务进度（0-100）
+type ReportFunc func(progress int)
+
+// RunFunc 任务执行函数，ctx 在任务被取消或服务停止时取消
+type RunFunc func(ctx context.Context, job *jobs.Job, report ReportFunc) (*Outcome, error)
+
+// fileCleanupInterval 过期任务文件清理间隔
+const fileCleanupInterval = time.Hour
+
+// Options worker 池配置
+type Options struct {
+	Workers      int           // worker 数量
+	PollInterval time.Duration // 待执行任务轮询间隔
+	LockTTL      time.Duration // 任务锁有效期
+	Storage      *Storage      // 任务文件存储（可选）
+	FileTTL      time.Duration // 任务文件保留时长，不大于 0 时不清理
+}
+
+// Pool 异步任务 worker 池
+// 轮询 jobs 表中的待执行任务，通过 Redis 锁保证多实例部署时同一任务只被一个 worker 执行
+type Pool struct {
+	model   jobs.Model
+	locker  *Locker
+	opts    Options
+	runners map[string]RunFunc
+
+	queue       chan *jobs.Job
+	ctx         context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+	lastCleanup time.Time
+}
+
+// NewPool 创建 worker 池
+func NewPool(model jobs.Model, rdb *redis.Client, opts Options) *Pool {
+	if opts.Workers <= 0 {
+		opts.Workers = 1
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 2 * time.Second
+	}
+	if opts.LockTTL <= 0 {
+		opts.LockTTL = time.Minute
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Pool{
+		model:   model,
+		locker:  NewLocker(rdb),
+		opts:    opts,
+		runners: make(map[string]RunFunc),
+		queue:   make(chan *jobs.Job),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Register 注册任务类型的执行函数（需在 Start 之前调用）
+func (p *Pool) Register(jobType string, run RunFunc) {
+	p.runners[jobType] = run
+}
+
+// Start 启动调度协程和 worker
+func (p *Pool) Start() {
+	p.wg.Add(1)
+	go p.dispatch()
+
+	for i := 0; i < p.opts.Workers; i++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+}
+
+// Stop 停止 worker 池，运行中的任务会收到取消信号
+func (p *Pool) Stop() {
+	p.cancel()
+	p.wg.Wait()
+}
+
+// dispatch 定期轮询待执行任务并分发给 worker
+func (p *Pool) dispatch() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		p.recoverStale()
+		p.cleanupFiles()
+
+		pending, err := p.model.FindPending(p.ctx, p.opts.Workers)
+		if err != nil && p.ctx.Err() == nil {
+			logx.Errorf("查询待执行任务失败: %v", err)
+		}
+		for _, job := range pending {
+			select {
+			case p.queue <- job:
+			case <-p.ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// recoverStale 将心跳过期且锁已释放的运行中任务标记为失败（执行实例异常退出）
+func (p *Pool) recoverStale() {
+	stale, err := p.model.FindStaleRunning(p.ctx, time.Now().Add(-3*p.opts.LockTTL), p.opts.Workers)
+	if err != nil {
+		if p.ctx.Err() == nil {
+			logx.Errorf("查询中断任务失败: %v", err)
+		}
+		return
+	}
+	for _, job := range stale {
+		locked, err := p.locker.IsLocked(p.ctx, job.Id)
+		if err != nil || locked {
+			continue
+		}
+		if err := p.model.Finish(p.ctx, job.Id, &jobs.FinishData{
+			Status:       jobs.StatusFailed,
+			ErrorMessage: "任务执行中断",
+		}); err != nil && !errors.Is(err, jobs.ErrJobNotFound) {
+			logx.Errorf("标记中断任务失败: jobId=%s, error=%v", job.Id, err)
+		}
+	}
+}
+
+// cleanupFiles 定期删除超过保留时长的任务文件（上传文件、结果文件）
+func (p *Pool) cleanupFiles() {
+	if p.opts.Storage == nil || p.opts.FileTTL <= 0 || time.Since(p.lastCleanup) < fileCleanupInterval {
+		return
+	}
+	p.lastCleanup = time.Now()
+
+	removed, err := p.opts.Storage.RemoveExpired(time.Now().Add(-p.opts.FileTTL))
+	if err != nil {
+		logx.Errorf("清理过期任务文件失败: %v", err)
+	}
+	if removed > 0 {
+		logx.Infof("已清理过期任务文件: %d 个任务", removed)
+	}
+}
+
+// work 从队列中领取任务并执行
+func (p *Pool) work() {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case job := <-p.queue:
+			p.execute(job)
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// execute 抢占并执行单个任务
+func (p *Pool) execute(job *jobs.Job) {
+	logger := logx.WithContext(p.ctx).WithFields(logx.Field("jobId", job.Id), logx.Field("jobType", job.Type))
+
+	// 1. 获取分布式锁，保证只有一个 worker 执行
+	lock, err := p.locker.Acquire(p.ctx, job.Id, p.opts.LockTTL)
+	if err != nil {
+		if !errors.Is(err, ErrLockHeld) {
+			logger.Errorf("获取任务锁失败: %v", err)
+		}
+		return
+	}
+	defer lock.Release(context.Background())
+
+	// 2. 条件更新为执行中（锁过期等极端情况下的二次保护）
+	ok, err := p.model.MarkRunning(p.ctx, job.Id)
+	if err != nil || !ok {
+		if err != nil {
+			logger.Errorf("标记任务执行中失败: %v", err)
+		}
+		return
+	}
+
+	// 3. 查找执行函数
+	run, ok := p.runners[job.Type]
+	if !ok {
+		p.finish(logger, job.Id, nil, fmt.Errorf("不支持的任务类型: %s", job.Type))
+		return
+	}
+
+	// 4. 执行期间定期续期锁、检查取消标记，取消或锁丢失时中止执行
+	runCtx, cancelRun := context.WithCancel(p.ctx)
+	defer cancelRun()
+	var stopErr error
+	var mu sync.Mutex
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		p.heartbeat(runCtx, lock, job.Id, func(reason error) {
+			mu.Lock()
+			stopErr = reason
+			mu.Unlock()
+			cancelRun()
+		})
+	}()
+
+	report := func(progress int) {
+		if err := p.model.UpdateProgress(runCtx, job.Id, progress); err != nil && runCtx.Err() == nil {
+			logger.Errorf("更新任务进度失败: %v", err)
+		}
+	}
+	outcome, runErr := p.safeRun(run, runCtx, job, report)
+
+	cancelRun()
+	<-heartbeatDone
+
+	mu.Lock()
+	if stopErr != nil {
+		runErr = stopErr
+	}
+	mu.Unlock()
+	if errors.Is(runErr, ErrLockLost) {
+		// 锁已不属于当前 worker，不写入结果，避免覆盖接管者的执行状态
+		logger.Errorf("任务锁已丢失，放弃写入执行结果")
+		return
+	}
+	if runErr == nil && p.ctx.Err() != nil {
+		runErr = errors.New("服务停止，任务中断")
+	}
+
+	// 5. 写入执行结果
+	p.finish(logger, job.Id, outcome, runErr)
+}
+
+// safeRun 执行任务并捕获 panic
+func (p *Pool) safeRun(run RunFunc, ctx context.Context, job *jobs.Job, report ReportFunc) (outcome *Outcome, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("任务执行异常: %v", r)
+		}
+	}()
+	return run(ctx, job, report)
+}
+
+// heartbeat 续期任务锁并检查取消标记，直到 ctx 结束
+// 续期失败（锁已过期或被他人持有）时以 ErrLockLost 通知停止，收到取消标记时以 ErrCanceled 通知停止
+func (p *Pool) heartbeat(ctx context.Context, lock *Lock, jobId string, onStop func(reason error)) {
+	ticker := time.NewTicker(p.opts.LockTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := lock.Refresh(ctx, p.opts.LockTTL); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logx.Errorf("任务锁续期失败: jobId=%s, error=%v", jobId, err)
+			onStop(ErrLockLost)
+			return
+		}
+
+		job, err := p.model.FindOne(ctx, jobId)
+		if err != nil {
+			continue
+		}
+		if job.CancelRequested {
+			onStop(ErrCanceled)
+			return
+		}
+	}
+}
+
+// finish 写入任务结束状态
+func (p *Pool) finish(logger logx.Logger, jobId string, outcome *Outcome, runErr error) {
+	data := &jobs.FinishData{Status: jobs.StatusSucceeded}
+	switch {
+	case errors.Is(runErr, ErrCanceled):
+		data.Status = jobs.StatusCanceled
+	case runErr != nil:
+		data.Status = jobs.StatusFailed
+		data.ErrorMessage = runErr.Error()
+	}
+
+	if outcome != nil {
+		if outcome.Result != nil {
+			result, err := json.Marshal(outcome.Result)
+			if err != nil {
+				logger.Errorf("序列化任务结果失败: %v", err)
+			} else {
+				data.Result = result
+			}
+		}
+		if runErr == nil {
+			data.ResultPath = outcome.ResultPath
+			data.ResultName = outcome.ResultName
+		}
+	}
+
+	// 使用独立 ctx，保证服务停止时也能写入最终状态
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := p.model.Finish(ctx, jobId, data); err != nil {
+		logger.Errorf("写入任务结果失败: %v", err)
+	}
+}
@@ -0,0 +1,251 @@
+package jobqueue
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/model/system/jobs"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestPool 创建基于 SQLite 和 miniredis 的 worker 池
+func setupTestPool(t *testing.T) (*Pool, jobs.Model, *redis.Client) {
+	db, err := gorm.Open(sqlite.Open("file:test_"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS jobs (
+			id TEXT PRIMARY KEY,
+			type TEXT NOT NULL,
+			status TEXT NOT NULL DEFAULT 'pending',
+			progress INTEGER NOT NULL DEFAULT 0,
+			params TEXT,
+			result TEXT,
+			result_path TEXT,
+			result_name TEXT,
+			error_message TEXT,
+			cancel_requested INTEGER NOT NULL DEFAULT 0,
+			created_by TEXT NOT NULL,
+			started_at DATETIME,
+			finished_at DATETIME,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`).Error
+	require.NoError(t, err)
+
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+
+	model := jobs.NewModel(db)
+	pool := NewPool(model, rdb, Options{
+		Workers:      2,
+		PollInterval: 20 * time.Millisecond,
+		LockTTL:      300 * time.Millisecond,
+	})
+	return pool, model, rdb
+}
+
+// waitFinished 等待任务结束
+func waitFinished(t *testing.T, model jobs.Model, id string) *jobs.Job {
+	var job *jobs.Job
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = model.FindOne(context.Background(), id)
+		return err == nil && jobs.IsFinished(job.Status)
+	}, 5*time.Second, 20*time.Millisecond)
+	return job
+}
+
+// TestPool_RunsJobAndWritesResult 测试任务执行成功后写入结果和结果文件
+func TestPool_RunsJobAndWritesResult(t *testing.T) {
+	pool, model, _ := setupTestPool(t)
+	pool.Register("echo", func(ctx context.Context, job *jobs.Job, report ReportFunc) (*Outcome, error) {
+		report(50)
+		return &Outcome{
+			Result:     map[string]interface{}{"job": job.Id},
+			ResultPath: "data/jobs/" + job.Id + "/result.csv",
+			ResultName: "result.csv",
+		}, nil
+	})
+	pool.Start()
+	defer pool.Stop()
+
+	job, err := model.Insert(context.Background(), &jobs.Job{Type: "echo", CreatedBy: "user-1"})
+	require.NoError(t, err)
+
+	finished := waitFinished(t, model, job.Id)
+	assert.Equal(t, jobs.StatusSucceeded, finished.Status)
+	assert.Equal(t, 100, finished.Progress)
+	assert.JSONEq(t, `{"job":"`+job.Id+`"}`, string(finished.Result))
+	require.NotNil(t, finished.ResultName)
+	assert.Equal(t, "result.csv", *finished.ResultName)
+}
+
+// TestPool_FailsJob 测试执行失败、panic 和未注册类型都会记录失败原因
+func TestPool_FailsJob(t *testing.T) {
+	pool, model, _ := setupTestPool(t)
+	pool.Register("fail", func(ctx context.Context, job *jobs.Job, report ReportFunc) (*Outcome, error) {
+		return nil, errors.New("导入文件格式错误")
+	})
+	pool.Register("panic", func(ctx context.Context, job *jobs.Job, report ReportFunc) (*Outcome, error) {
+		panic("boom")
+	})
+	pool.Start()
+	defer pool.Stop()
+
+	expected := map[string]string{
+		"fail":    "导入文件格式错误",
+		"panic":   "任务执行异常: boom",
+		"unknown": "不支持的任务类型: unknown",
+	}
+	for jobType, message := range expected {
+		job, err := model.Insert(context.Background(), &jobs.Job{Type: jobType, CreatedBy: "user-1"})
+		require.NoError(t, err)
+
+		finished := waitFinished(t, model, job.Id)
+		assert.Equal(t, jobs.StatusFailed, finished.Status, jobType)
+		require.NotNil(t, finished.ErrorMessage, jobType)
+		assert.Equal(t, message, *finished.ErrorMessage, jobType)
+	}
+}
+
+// TestPool_CancelRunningJob 测试执行中的任务在设置取消标记后被中止
+func TestPool_CancelRunningJob(t *testing.T) {
+	pool, model, _ := setupTestPool(t)
+	started := make(chan struct{})
+	pool.Register("slow", func(ctx context.Context, job *jobs.Job, report ReportFunc) (*Outcome, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	pool.Start()
+	defer pool.Stop()
+
+	job, err := model.Insert(context.Background(), &jobs.Job{Type: "slow", CreatedBy: "user-1"})
+	require.NoError(t, err)
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("任务未开始执行")
+	}
+	ok, err := model.RequestCancel(context.Background(), job.Id)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	finished := waitFinished(t, model, job.Id)
+	assert.Equal(t, jobs.StatusCanceled, finished.Status)
+}
+
+// TestPool_SkipsLockedJob 测试任务锁被其他 worker 持有时不会重复执行
+func TestPool_SkipsLockedJob(t *testing.T) {
+	pool, model, rdb := setupTestPool(t)
+	executed := make(chan struct{}, 1)
+	pool.Register("echo", func(ctx context.Context, job *jobs.Job, report ReportFunc) (*Outcome, error) {
+		executed <- struct{}{}
+		return nil, nil
+	})
+
+	job, err := model.Insert(context.Background(), &jobs.Job{Type: "echo", CreatedBy: "user-1"})
+	require.NoError(t, err)
+
+	// 模拟其他实例持有锁
+	lock, err := NewLocker(rdb).Acquire(context.Background(), job.Id, time.Minute)
+	require.NoError(t, err)
+
+	pool.Start()
+	defer pool.Stop()
+
+	select {
+	case <-executed:
+		t.Fatal("锁被占用时任务不应执行")
+	case <-time.After(200 * time.Millisecond):
+	}
+	found, err := model.FindOne(context.Background(), job.Id)
+	require.NoError(t, err)
+	assert.Equal(t, jobs.StatusPending, found.Status)
+
+	// 锁释放后任务被领取执行
+	require.NoError(t, lock.Release(context.Background()))
+	finished := waitFinished(t, model, job.Id)
+	assert.Equal(t, jobs.StatusSucceeded, finished.Status)
+}
+
+// TestPool_StopsWhenLockLost 测试执行期间锁被他人持有时中止执行且不写入结果
+func TestPool_StopsWhenLockLost(t *testing.T) {
+	pool, model, rdb := setupTestPool(t)
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+	pool.Register("slow", func(ctx context.Context, job *jobs.Job, report ReportFunc) (*Outcome, error) {
+		close(started)
+		<-ctx.Done()
+		close(stopped)
+		return &Outcome{Result: map[string]interface{}{"done": true}}, nil
+	})
+	pool.Start()
+	defer pool.Stop()
+
+	job, err := model.Insert(context.Background(), &jobs.Job{Type: "slow", CreatedBy: "user-1"})
+	require.NoError(t, err)
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("任务未开始执行")
+	}
+
+	// 模拟锁过期后被其他实例抢占
+	require.NoError(t, rdb.Set(context.Background(), lockKeyPrefix+job.Id, "other-worker", time.Minute).Err())
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("锁丢失后任务未中止")
+	}
+
+	// 不写入执行结果，任务状态保持执行中，由接管者或中断恢复处理
+	time.Sleep(100 * time.Millisecond)
+	found, err := model.FindOne(context.Background(), job.Id)
+	require.NoError(t, err)
+	assert.Equal(t, jobs.StatusRunning, found.Status)
+	assert.Empty(t, found.Result)
+}
+
+// TestPool_CleansUpExpiredFiles 测试定期删除超过保留时长的任务文件
+func TestPool_CleansUpExpiredFiles(t *testing.T) {
+	pool, _, _ := setupTestPool(t)
+	storage := NewStorage(t.TempDir())
+	pool.opts.Storage = storage
+	pool.opts.FileTTL = time.Hour
+
+	oldPath, err := storage.Save("job-old", "result.csv", []byte("old"))
+	require.NoError(t, err)
+	expired := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Dir(oldPath), expired, expired))
+	newPath, err := storage.Save("job-new", "upload.csv", []byte("new"))
+	require.NoError(t, err)
+
+	pool.cleanupFiles()
+
+	_, err = os.Stat(filepath.Dir(oldPath))
+	assert.True(t, os.IsNotExist(err))
+	_, err = storage.Read(newPath)
+	require.NoError(t, err)
+
+	// 删除单个文件时一并删除空的任务目录
+	require.NoError(t, storage.Remove(newPath))
+	_, err = os.Stat(filepath.Dir(newPath))
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, storage.Remove(newPath))
+}
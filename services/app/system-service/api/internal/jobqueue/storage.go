@@ -0,0 +1,113 @@
+package jobqueue
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Storage 任务文件存储（上传文件、结果文件）
+type Storage struct {
+	dir string
+}
+
+// NewStorage 创建任务文件存储
+func NewStorage(dir string) *Storage {
+	return &Storage{dir: dir}
+}
+
+// Path 返回任务文件的存储位置（文件名不允许包含路径）
+func (s *Storage) Path(jobId, name string) string {
+	return filepath.Join(s.dir, jobId, filepath.Base(name))
+}
+
+// Save 保存任务文件，返回存储位置
+func (s *Storage) Save(jobId, name string, content []byte) (string, error) {
+	path := s.Path(jobId, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, content, 0o640); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// Create 创建任务结果文件，由调用方写入并关闭
+func (s *Storage) Create(jobId, name string) (*os.File, error) {
+	path := s.Path(jobId, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+	return os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+}
+
+// Open 打开存储位置的文件（仅允许访问存储目录内的文件）
+func (s *Storage) Open(path string) (io.ReadCloser, error) {
+	if !s.contains(path) {
+		return nil, fmt.Errorf("文件不在任务存储目录内: %s", path)
+	}
+	return os.Open(path)
+}
+
+// Read 读取存储位置的文件内容
+func (s *Storage) Read(path string) ([]byte, error) {
+	if !s.contains(path) {
+		return nil, fmt.Errorf("文件不在任务存储目录内: %s", path)
+	}
+	return os.ReadFile(path)
+}
+
+// Remove 删除存储位置的文件，任务目录为空时一并删除；文件不存在时忽略
+func (s *Storage) Remove(path string) error {
+	if !s.contains(path) {
+		return fmt.Errorf("文件不在任务存储目录内: %s", path)
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	// 目录非空时删除失败，忽略
+	_ = os.Remove(filepath.Dir(path))
+	return nil
+}
+
+// RemoveExpired 删除最后修改时间早于 before 的任务目录，返回删除数量
+func (s *Storage) RemoveExpired(before time.Time) (int, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || !info.ModTime().Before(before) {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(s.dir, entry.Name())); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// contains 判断路径是否位于存储目录内
+func (s *Storage) contains(path string) bool {
+	rel, err := filepath.Rel(s.dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
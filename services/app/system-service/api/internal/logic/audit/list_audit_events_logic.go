@@ -0,0 +1,238 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	auditevents "github.com/DataSemanticHub/services/app/system-service/model/system/audit_events"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/datatypes"
+)
+
+// auditTimeLayouts 时间筛选参数支持的格式
+var auditTimeLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"}
+
+type ListAuditEventsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// cursorPayload 分页游标内容（base64 编码后返回给调用方）
+type cursorPayload struct {
+	CreatedAt time.Time `json:"t"`
+	Id        string    `json:"id"`
+}
+
+// 查询审计事件
+func NewListAuditEventsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAuditEventsLogic {
+	return &ListAuditEventsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListAuditEventsLogic) ListAuditEvents(req *types.ListAuditEventsReq) (resp *types.ListAuditEventsResp, err error) {
+	// 1. 校验筛选条件
+	if req.ResourceType != "" && !isResourceType(req.ResourceType) {
+		return nil, baseErrorx.New(20002, fmt.Sprintf("资源类型必须是 %s 之一", strings.Join(auditevents.ResourceTypes, "/")))
+	}
+	findReq := &auditevents.FindListReq{
+		ResourceType: req.ResourceType,
+		ResourceId:   req.ResourceId,
+		OperatorId:   req.OperatorId,
+		Operation:    req.Operation,
+		Limit:        req.Limit,
+	}
+	findReq.StartTime, findReq.EndTime, err = ParseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 解析游标
+	if req.Cursor != "" {
+		cursor, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return nil, baseErrorx.New(20002, "分页游标无效")
+		}
+		findReq.Cursor = cursor
+	}
+
+	// 3. 查询审计事件
+	events, hasMore, err := l.svcCtx.AuditEventModel.FindList(l.ctx, findReq)
+	if err != nil {
+		l.Errorf("查询审计事件失败: %v", err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+
+	// 4. 转换为统一事件结构
+	operatorNames := make(map[string]string)
+	resp = &types.ListAuditEventsResp{
+		Events:  make([]types.AuditEvent, 0, len(events)),
+		HasMore: hasMore,
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, l.toAuditEvent(event, operatorNames))
+	}
+	if hasMore && len(events) > 0 {
+		last := events[len(events)-1]
+		resp.NextCursor = encodeCursor(&auditevents.Cursor{CreatedAt: last.CreatedAt, Id: last.Id})
+	}
+
+	return resp, nil
+}
+
+// toAuditEvent 转换审计事件；未记录字段变更的日志由新旧值计算差异
+func (l *ListAuditEventsLogic) toAuditEvent(event *auditevents.AuditEvent, operatorNames map[string]string) types.AuditEvent {
+	item := types.AuditEvent{
+		Id:           event.Id,
+		ResourceType: event.ResourceType,
+		ResourceId:   event.ResourceId,
+		Operation:    event.Operation,
+		Changes:      jsonObject(event.Changes),
+		OldValue:     jsonObject(event.OldValue),
+		NewValue:     jsonObject(event.NewValue),
+		CreatedAt:    event.CreatedAt.Format(time.RFC3339),
+	}
+	if event.OperatorId != nil {
+		item.OperatorId = *event.OperatorId
+	}
+	if event.OperatorName != nil && *event.OperatorName != "" {
+		item.OperatorName = *event.OperatorName
+	} else if item.OperatorId != "" {
+		item.OperatorName = l.operatorName(item.OperatorId, operatorNames)
+	}
+	if item.Changes == nil && (item.OldValue != nil || item.NewValue != nil) {
+		item.Changes = DiffValues(item.OldValue, item.NewValue)
+	}
+	return item
+}
+
+// operatorName 查询操作人名称（查询过程中缓存）；用户不存在时返回空
+func (l *ListAuditEventsLogic) operatorName(operatorId string, cache map[string]string) string {
+	if operatorId == errorx.SystemOperatorID {
+		return errorx.SystemOperatorName
+	}
+	if name, ok := cache[operatorId]; ok {
+		return name
+	}
+	name := ""
+	if user, err := l.svcCtx.UserModel.FindOne(l.ctx, operatorId); err == nil && user != nil {
+		name = user.Name
+	}
+	cache[operatorId] = name
+	return name
+}
+
+// DiffValues 比较新旧值的顶层字段，返回字段名 -> {old, new}
+func DiffValues(oldValue, newValue map[string]interface{}) map[string]interface{} {
+	changes := make(map[string]interface{})
+	for key, newField := range newValue {
+		oldField, ok := oldValue[key]
+		if !ok || !reflect.DeepEqual(oldField, newField) {
+			changes[key] = map[string]interface{}{"old": oldField, "new": newField}
+		}
+	}
+	for key, oldField := range oldValue {
+		if _, ok := newValue[key]; !ok {
+			changes[key] = map[string]interface{}{"old": oldField, "new": nil}
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return changes
+}
+
+// jsonObject 解析 JSON 对象，非对象或解析失败时返回 nil
+func jsonObject(data datatypes.JSON) map[string]interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	var value map[string]interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+	return value
+}
+
+// ParseTimeRange 解析时间范围筛选参数（为空表示不限），仅指定日期的结束时间包含当天全部事件
+func ParseTimeRange(start, end string) (startTime, endTime *time.Time, err error) {
+	if start != "" {
+		t, _, err := parseAuditTime(start)
+		if err != nil {
+			return nil, nil, baseErrorx.New(20002, "开始时间格式错误")
+		}
+		startTime = &t
+	}
+	if end != "" {
+		t, dateOnly, err := parseAuditTime(end)
+		if err != nil {
+			return nil, nil, baseErrorx.New(20002, "结束时间格式错误")
+		}
+		if dateOnly {
+			t = t.Add(24*time.Hour - time.Nanosecond)
+		}
+		endTime = &t
+	}
+	if startTime != nil && endTime != nil && startTime.After(*endTime) {
+		return nil, nil, baseErrorx.New(20002, "开始时间不能晚于结束时间")
+	}
+	return startTime, endTime, nil
+}
+
+// parseAuditTime 解析时间筛选参数，返回是否仅包含日期
+func parseAuditTime(value string) (time.Time, bool, error) {
+	for _, layout := range auditTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, layout == "2006-01-02", nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("无法解析时间: %s", value)
+}
+
+// encodeCursor 编码分页游标
+func encodeCursor(cursor *auditevents.Cursor) string {
+	data, _ := json.Marshal(cursorPayload{CreatedAt: cursor.CreatedAt, Id: cursor.Id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor 解码分页游标
+func decodeCursor(value string) (*auditevents.Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	var payload cursorPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Id == "" || payload.CreatedAt.IsZero() {
+		return nil, fmt.Errorf("游标内容不完整")
+	}
+	return &auditevents.Cursor{CreatedAt: payload.CreatedAt, Id: payload.Id}, nil
+}
+
+// isResourceType 判断是否为支持的资源类型
+func isResourceType(resourceType string) bool {
+	for _, t := range auditevents.ResourceTypes {
+		if t == resourceType {
+			return true
+		}
+	}
+	return false
+}
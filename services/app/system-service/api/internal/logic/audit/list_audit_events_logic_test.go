@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	auditevents "github.com/DataSemanticHub/services/app/system-service/model/system/audit_events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCursor_RoundTrip 测试分页游标编码后可还原
+func TestCursor_RoundTrip(t *testing.T) {
+	cursor := &auditevents.Cursor{CreatedAt: time.Date(2026, 1, 1, 10, 0, 0, 123000000, time.UTC), Id: "menu:abc"}
+
+	decoded, err := decodeCursor(encodeCursor(cursor))
+	require.NoError(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, cursor.Id, decoded.Id)
+
+	_, err = decodeCursor("not-a-cursor")
+	assert.Error(t, err)
+}
+
+// TestDiffValues_TopLevelFields 测试按顶层字段计算新旧值差异
+func TestDiffValues_TopLevelFields(t *testing.T) {
+	changes := DiffValues(
+		map[string]interface{}{"name": "研发部", "parent_id": "a", "code": "RD"},
+		map[string]interface{}{"name": "研发中心", "parent_id": "a", "sort_order": float64(2)},
+	)
+
+	assert.Equal(t, map[string]interface{}{
+		"name":       map[string]interface{}{"old": "研发部", "new": "研发中心"},
+		"code":       map[string]interface{}{"old": "RD", "new": nil},
+		"sort_order": map[string]interface{}{"old": nil, "new": float64(2)},
+	}, changes)
+
+	assert.Nil(t, DiffValues(map[string]interface{}{"a": 1}, map[string]interface{}{"a": 1}))
+}
+
+// TestParseAuditTime_Formats 测试时间筛选参数格式
+func TestParseAuditTime_Formats(t *testing.T) {
+	_, dateOnly, err := parseAuditTime("2026-01-02")
+	require.NoError(t, err)
+	assert.True(t, dateOnly)
+
+	_, dateOnly, err = parseAuditTime("2026-01-02 10:00:00")
+	require.NoError(t, err)
+	assert.False(t, dateOnly)
+
+	_, _, err = parseAuditTime("2026-01-02T10:00:00+08:00")
+	require.NoError(t, err)
+
+	_, _, err = parseAuditTime("yesterday")
+	assert.Error(t, err)
+}
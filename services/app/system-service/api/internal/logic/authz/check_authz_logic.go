@@ -0,0 +1,100 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package authz
+
+import (
+	"context"
+	"fmt"
+
+	pdp "github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxCheckItems 单次批量判定的最大条数
+const maxCheckItems = 100
+
+type CheckAuthzLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 批量授权判定（策略决策点）
+func NewCheckAuthzLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckAuthzLogic {
+	return &CheckAuthzLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CheckAuthzLogic) CheckAuthz(req *types.CheckAuthzReq) (resp *types.CheckAuthzResp, err error) {
+	// 1. 校验请求
+	if len(req.Items) == 0 || len(req.Items) > maxCheckItems {
+		return nil, baseErrorx.New(errorx.ErrAuthzInvalidCheckRequest, fmt.Sprintf("判定条目数量须在 1-%d 之间", maxCheckItems))
+	}
+	for i, item := range req.Items {
+		if item.Subject == "" || item.ResourceType == "" || item.Action == "" {
+			return nil, baseErrorx.New(errorx.ErrAuthzInvalidCheckRequest, fmt.Sprintf("第 %d 条判定缺少 subject/resource_type/action", i+1))
+		}
+	}
+
+	// 2. 按主体获取授权快照（同一主体只解析一次）
+	snapshots := make(map[string]*pdp.Snapshot)
+	for _, item := range req.Items {
+		if _, ok := snapshots[item.Subject]; ok {
+			continue
+		}
+		snapshot, err := l.svcCtx.DecisionPoint.Snapshot(l.ctx, item.Subject)
+		if err != nil {
+			l.Errorf("获取授权快照失败: subject=%s, error=%v", item.Subject, err)
+			return nil, baseErrorx.New(50000, "系统错误")
+		}
+		snapshots[item.Subject] = snapshot
+	}
+
+	// 3. 逐条判定
+	results := make([]types.AuthzDecision, 0, len(req.Items))
+	for _, item := range req.Items {
+		decision := snapshots[item.Subject].Decide(item.ResourceType, item.Action)
+		results = append(results, toAuthzDecision(item, decision))
+	}
+
+	return &types.CheckAuthzResp{Results: results}, nil
+}
+
+// toAuthzDecision 将判定结果转换为 API 类型，命中的角色/模板/版本作为判定原因
+func toAuthzDecision(item types.AuthzCheckItem, decision pdp.Decision) types.AuthzDecision {
+	result := types.AuthzDecision{
+		Subject:      item.Subject,
+		ResourceType: item.ResourceType,
+		ResourceId:   item.ResourceId,
+		Action:       item.Action,
+		Allowed:      decision.Allowed,
+	}
+	if !decision.Allowed {
+		result.Reason = fmt.Sprintf("无已发布权限模板授予 %s:%s", item.ResourceType, item.Action)
+		return result
+	}
+
+	grant := decision.Grant
+	result.RoleId = grant.RoleId
+	result.RoleCode = grant.RoleCode
+	result.TemplateId = grant.TemplateId
+	result.TemplateCode = grant.TemplateCode
+	result.TemplateVersion = grant.TemplateVersion
+	result.Scope = decision.Scope
+	result.OrgId = grant.OrgId
+	if grant.RoleCode != "" {
+		result.Reason = fmt.Sprintf("角色 %s 经权限模板 %s（v%d）授权", grant.RoleCode, grant.TemplateCode, grant.TemplateVersion)
+	} else {
+		result.Reason = fmt.Sprintf("权限模板 %s（v%d）授权", grant.TemplateCode, grant.TemplateVersion)
+	}
+	return result
+}
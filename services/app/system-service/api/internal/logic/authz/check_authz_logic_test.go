@@ -0,0 +1,100 @@
+package authz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pdp "github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/testutil"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupCheckAuthzTest 创建测试用的 CheckAuthzLogic（SQLite，不启用缓存）
+func setupCheckAuthzTest(t *testing.T) (*CheckAuthzLogic, *gorm.DB) {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&rolebindings.RoleBinding{}))
+
+	testutil.CreatePermissionTables(t, db)
+
+	resolver := pdp.NewResolver(rolebindings.NewModel(db), roles.NewModel(db), permissiontemplates.NewModel(db), permissiontemplateversions.NewModel(db))
+	svcCtx := &svc.ServiceContext{
+		DB:            db,
+		DecisionPoint: pdp.NewDecisionPoint(resolver, nil),
+	}
+	return NewCheckAuthzLogic(context.Background(), svcCtx), db
+}
+
+// TestCheckAuthz_BatchDecisions 测试批量判定返回命中的角色/模板/版本
+func TestCheckAuthz_BatchDecisions(t *testing.T) {
+	logic, db := setupCheckAuthzTest(t)
+	now := time.Now()
+	require.NoError(t, db.Create(&permissiontemplates.PermissionTemplate{
+		Id: "tpl-meta", Code: "metadata_editor", Name: "元数据编辑", Status: permissiontemplates.StatusPublished, Version: 3,
+		PolicyMatrix: datatypes.JSON(`{"metadata":{"actions":["read","update"],"scope":"domain"}}`),
+		CreatedBy:    "system", CreatedAt: now, UpdatedAt: now,
+	}).Error)
+	require.NoError(t, db.Create(&roles.Role{
+		Id: "role-meta", Code: "meta_editor", Name: "元数据编辑员", Scope: roles.ScopeDomain,
+		TemplateId: "tpl-meta", TemplateVersion: 3, TemplateAppliedAt: now, CreatedBy: "system", CreatedAt: now, UpdatedAt: now,
+	}).Error)
+	roleId := "role-meta"
+	require.NoError(t, db.Create(&rolebindings.RoleBinding{UserId: "user-1", OrgId: "org-1", RoleId: &roleId}).Error)
+
+	resp, err := logic.CheckAuthz(&types.CheckAuthzReq{Items: []types.AuthzCheckItem{
+		{Subject: "user-1", ResourceType: "metadata", ResourceId: "table-42", Action: "update"},
+		{Subject: "user-1", ResourceType: "metadata", ResourceId: "table-42", Action: "delete"},
+		{Subject: "user-2", ResourceType: "metadata", Action: "read"},
+	}})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+
+	allowed := resp.Results[0]
+	assert.True(t, allowed.Allowed)
+	assert.Equal(t, "table-42", allowed.ResourceId)
+	assert.Equal(t, "role-meta", allowed.RoleId)
+	assert.Equal(t, "meta_editor", allowed.RoleCode)
+	assert.Equal(t, "metadata_editor", allowed.TemplateCode)
+	assert.Equal(t, 3, allowed.TemplateVersion)
+	assert.Equal(t, "domain", allowed.Scope)
+	assert.Equal(t, "org-1", allowed.OrgId)
+	assert.Contains(t, allowed.Reason, "meta_editor")
+
+	assert.False(t, resp.Results[1].Allowed)
+	assert.Empty(t, resp.Results[1].TemplateId)
+	assert.False(t, resp.Results[2].Allowed)
+}
+
+// TestCheckAuthz_InvalidItem 测试判定条目缺少必填字段
+func TestCheckAuthz_InvalidItem(t *testing.T) {
+	logic, _ := setupCheckAuthzTest(t)
+
+	resp, err := logic.CheckAuthz(&types.CheckAuthzReq{Items: []types.AuthzCheckItem{
+		{Subject: "user-1", ResourceType: "metadata"},
+	}})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	codeErr, ok := err.(*baseErrorx.CodeError)
+	require.True(t, ok)
+	assert.Equal(t, errorx.ErrAuthzInvalidCheckRequest, codeErr.Code)
+}
@@ -0,0 +1,65 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package jobs
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	jobsmodel "github.com/DataSemanticHub/services/app/system-service/model/system/jobs"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelJobLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 取消任务
+func NewCancelJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelJobLogic {
+	return &CancelJobLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CancelJob 等待中的任务直接取消；执行中的任务设置取消标记，由 worker 在下次心跳时中止
+func (l *CancelJobLogic) CancelJob(req *types.JobIdReq) (resp *types.EmptyResp, err error) {
+	// 1. 查询任务并校验归属
+	job, err := findOwnedJob(l.ctx, l.svcCtx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if jobsmodel.IsFinished(job.Status) {
+		return nil, baseErrorx.New(errorx.ErrJobInvalidStatus, "任务已结束，无法取消")
+	}
+
+	// 2. 取消等待中的任务
+	canceled, err := l.svcCtx.JobModel.CancelPending(l.ctx, job.Id)
+	if err != nil {
+		l.Errorf("取消任务失败: jobId=%s, error=%v", job.Id, err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+	if canceled {
+		return &types.EmptyResp{}, nil
+	}
+
+	// 3. 任务已被 worker 领取，设置取消标记
+	requested, err := l.svcCtx.JobModel.RequestCancel(l.ctx, job.Id)
+	if err != nil {
+		l.Errorf("设置任务取消标记失败: jobId=%s, error=%v", job.Id, err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+	if !requested {
+		return nil, baseErrorx.New(errorx.ErrJobInvalidStatus, "任务已结束，无法取消")
+	}
+
+	return &types.EmptyResp{}, nil
+}
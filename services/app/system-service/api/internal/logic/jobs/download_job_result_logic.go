@@ -0,0 +1,82 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package jobs
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	jobsmodel "github.com/DataSemanticHub/services/app/system-service/model/system/jobs"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// resultContentTypes 结果文件扩展名 -> Content-Type
+var resultContentTypes = map[string]string{
+	".csv":  "text/csv; charset=utf-8",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+type DownloadJobResultLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// JobResultFile 任务结果文件，由 handler 写出后关闭
+type JobResultFile struct {
+	Filename    string
+	ContentType string
+	Content     io.ReadCloser
+}
+
+// 下载任务结果文件
+func NewDownloadJobResultLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DownloadJobResultLogic {
+	return &DownloadJobResultLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DownloadJobResultLogic) DownloadJobResult(req *types.JobIdReq) (*JobResultFile, error) {
+	// 1. 查询任务并校验归属
+	job, err := findOwnedJob(l.ctx, l.svcCtx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 仅执行成功且产生结果文件的任务可下载
+	if job.Status != jobsmodel.StatusSucceeded || job.ResultPath == nil || *job.ResultPath == "" {
+		return nil, baseErrorx.New(errorx.ErrJobResultNotReady, "任务没有可下载的结果文件")
+	}
+
+	// 3. 打开结果文件
+	content, err := l.svcCtx.JobStorage.Open(*job.ResultPath)
+	if err != nil {
+		l.Errorf("打开任务结果文件失败: jobId=%s, error=%v", job.Id, err)
+		return nil, baseErrorx.New(errorx.ErrJobResultNotReady, "结果文件不存在或已被清理")
+	}
+
+	filename := filepath.Base(*job.ResultPath)
+	if job.ResultName != nil && *job.ResultName != "" {
+		filename = *job.ResultName
+	}
+	contentType, ok := resultContentTypes[strings.ToLower(filepath.Ext(filename))]
+	if !ok {
+		contentType = "application/octet-stream"
+	}
+
+	return &JobResultFile{
+		Filename:    filename,
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	jobsmodel "github.com/DataSemanticHub/services/app/system-service/model/system/jobs"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// currentUserID 从 JWT Token 中提取当前用户 ID
+func currentUserID(ctx context.Context) (string, error) {
+	userID, ok := ctx.Value(contextkeys.UserIDKey).(string)
+	if !ok || userID == "" {
+		return "", baseErrorx.New(errorx.ErrTokenInvalid, "Token 无效或已过期")
+	}
+	return userID, nil
+}
+
+// findOwnedJob 查询当前用户提交的任务（他人的任务按不存在处理）
+func findOwnedJob(ctx context.Context, svcCtx *svc.ServiceContext, jobId string) (*jobsmodel.Job, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := svcCtx.JobModel.FindOne(ctx, jobId)
+	if err != nil {
+		if errors.Is(err, jobsmodel.ErrJobNotFound) {
+			return nil, baseErrorx.New(errorx.ErrJobNotFound, "任务不存在")
+		}
+		logx.WithContext(ctx).Errorf("查询任务失败: jobId=%s, error=%v", jobId, err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+	if job.CreatedBy != userID {
+		return nil, baseErrorx.New(errorx.ErrJobNotFound, "任务不存在")
+	}
+	return job, nil
+}
+
+// toJobInfo 转换为任务响应
+func toJobInfo(job *jobsmodel.Job) types.JobInfo {
+	info := types.JobInfo{
+		Id:              job.Id,
+		Type:            job.Type,
+		Status:          job.Status,
+		Progress:        job.Progress,
+		Downloadable:    job.Status == jobsmodel.StatusSucceeded && job.ResultPath != nil && *job.ResultPath != "",
+		CancelRequested: job.CancelRequested,
+		CreatedAt:       job.CreatedAt.Format(time.RFC3339),
+	}
+	if len(job.Result) > 0 {
+		var result map[string]interface{}
+		if err := json.Unmarshal(job.Result, &result); err == nil {
+			info.Result = result
+		}
+	}
+	if job.ResultName != nil {
+		info.ResultName = *job.ResultName
+	}
+	if job.ErrorMessage != nil {
+		info.ErrorMessage = *job.ErrorMessage
+	}
+	if job.StartedAt != nil {
+		info.StartedAt = job.StartedAt.Format(time.RFC3339)
+	}
+	if job.FinishedAt != nil {
+		info.FinishedAt = job.FinishedAt.Format(time.RFC3339)
+	}
+	return info
+}
@@ -0,0 +1,140 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/jobqueue"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/user_management"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	jobsmodel "github.com/DataSemanticHub/services/app/system-service/model/system/jobs"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 任务类型
+const (
+	JobTypeUserImport = "user_import"
+	JobTypeUserExport = "user_export"
+)
+
+// initialPasswordsFilename 导入任务初始密码结果文件名
+const initialPasswordsFilename = "initial_passwords.csv"
+
+// userImportParams 用户导入任务参数
+type userImportParams struct {
+	Filename   string `json:"filename"`    // 上传文件名（用于识别格式）
+	UploadPath string `json:"upload_path"` // 上传文件存储位置
+	DryRun     bool   `json:"dry_run"`
+}
+
+// RegisterRunners 注册各任务类型的执行函数
+func RegisterRunners(svcCtx *svc.ServiceContext) {
+	svcCtx.JobQueue.Register(JobTypeUserImport, newUserImportRunner(svcCtx))
+	svcCtx.JobQueue.Register(JobTypeUserExport, newUserExportRunner(svcCtx))
+}
+
+// newUserImportRunner 用户批量导入任务
+func newUserImportRunner(svcCtx *svc.ServiceContext) jobqueue.RunFunc {
+	return func(ctx context.Context, job *jobsmodel.Job, report jobqueue.ReportFunc) (*jobqueue.Outcome, error) {
+		var params userImportParams
+		if err := json.Unmarshal(job.Params, &params); err != nil {
+			return nil, fmt.Errorf("任务参数格式错误: %w", err)
+		}
+
+		// 导入结束后删除上传文件（任务不会重试，结果已写入任务记录）
+		defer func() {
+			if err := svcCtx.JobStorage.Remove(params.UploadPath); err != nil {
+				logx.WithContext(ctx).Errorf("删除导入上传文件失败: jobId=%s, error=%v", job.Id, err)
+			}
+		}()
+
+		content, err := svcCtx.JobStorage.Read(params.UploadPath)
+		if err != nil {
+			return nil, fmt.Errorf("读取导入文件失败: %w", err)
+		}
+
+		// 以提交人身份执行，审计日志记录真实操作人
+		ctx = context.WithValue(ctx, contextkeys.UserIDKey, job.CreatedBy)
+		resp, err := user_management.NewBatchImportLogic(ctx, svcCtx).
+			WithProgress(report).
+			BatchImport(&types.BatchImportReq{DryRun: params.DryRun}, params.Filename, content)
+		if err != nil {
+			return nil, err
+		}
+
+		// 初始密码不写入任务记录，仅写入结果文件供提交人下载（随任务文件过期清理）
+		passwords := resp.InitialPasswords
+		resp.InitialPasswords = nil
+		outcome := &jobqueue.Outcome{Result: resp}
+		if len(passwords) == 0 {
+			return outcome, nil
+		}
+		path, err := saveInitialPasswords(svcCtx.JobStorage, job.Id, passwords)
+		if err != nil {
+			return outcome, fmt.Errorf("用户已导入，但初始密码文件写入失败: %w", err)
+		}
+		outcome.ResultPath, outcome.ResultName = path, initialPasswordsFilename
+		return outcome, nil
+	}
+}
+
+// saveInitialPasswords 将系统生成的初始密码写入 CSV 结果文件（带 UTF-8 BOM）
+func saveInitialPasswords(storage *jobqueue.Storage, jobId string, passwords []types.ImportInitialPassword) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteString("\xef\xbb\xbf")
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write([]string{"行号", "用户ID", "邮箱", "初始密码"}); err != nil {
+		return "", err
+	}
+	for _, p := range passwords {
+		if err := writer.Write([]string{strconv.Itoa(p.Row), p.UserId, p.Email, p.InitialPassword}); err != nil {
+			return "", err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", err
+	}
+	return storage.Save(jobId, initialPasswordsFilename, buf.Bytes())
+}
+
+// newUserExportRunner 用户导出任务，结果写入任务存储供下载
+func newUserExportRunner(svcCtx *svc.ServiceContext) jobqueue.RunFunc {
+	return func(ctx context.Context, job *jobsmodel.Job, report jobqueue.ReportFunc) (*jobqueue.Outcome, error) {
+		var req types.ExportUsersReq
+		if err := json.Unmarshal(job.Params, &req); err != nil {
+			return nil, fmt.Errorf("任务参数格式错误: %w", err)
+		}
+
+		ctx = context.WithValue(ctx, contextkeys.UserIDKey, job.CreatedBy)
+		export, err := user_management.NewExportUsersLogic(ctx, svcCtx).ExportUsers(&req)
+		if err != nil {
+			return nil, err
+		}
+		export.OnProgress = report
+
+		file, err := svcCtx.JobStorage.Create(job.Id, export.Filename)
+		if err != nil {
+			return nil, fmt.Errorf("创建导出文件失败: %w", err)
+		}
+		if err := export.Write(file); err != nil {
+			file.Close()
+			os.Remove(file.Name())
+			return nil, err
+		}
+		if err := file.Close(); err != nil {
+			os.Remove(file.Name())
+			return nil, err
+		}
+
+		return &jobqueue.Outcome{ResultPath: file.Name(), ResultName: export.Filename}, nil
+	}
+}
@@ -0,0 +1,61 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/user_management"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	jobsmodel "github.com/DataSemanticHub/services/app/system-service/model/system/jobs"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SubmitUserExportJobLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 提交用户导出任务
+func NewSubmitUserExportJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitUserExportJobLogic {
+	return &SubmitUserExportJobLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// SubmitUserExportJob 校验导出参数并创建导出任务，由 worker 异步生成文件
+func (l *SubmitUserExportJobLogic) SubmitUserExportJob(req *types.ExportUsersReq) (resp *types.SubmitJobResp, err error) {
+	// 1. 获取当前用户
+	userID, err := currentUserID(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 提前校验导出格式和导出列，避免提交注定失败的任务
+	if _, err := user_management.NewExportUsersLogic(l.ctx, l.svcCtx).ExportUsers(req); err != nil {
+		return nil, err
+	}
+
+	// 3. 创建任务
+	params, _ := json.Marshal(req)
+	job, err := l.svcCtx.JobModel.Insert(l.ctx, &jobsmodel.Job{
+		Type:      JobTypeUserExport,
+		Params:    params,
+		CreatedBy: userID,
+	})
+	if err != nil {
+		l.Errorf("创建导出任务失败: %v", err)
+		return nil, baseErrorx.New(errorx.ErrJobSubmitFailed, "任务提交失败")
+	}
+
+	return &types.SubmitJobResp{JobId: job.Id}, nil
+}
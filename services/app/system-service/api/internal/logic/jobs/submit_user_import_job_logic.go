@@ -0,0 +1,82 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	jobsmodel "github.com/DataSemanticHub/services/app/system-service/model/system/jobs"
+
+	"github.com/google/uuid"
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SubmitUserImportJobLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 提交用户批量导入任务
+func NewSubmitUserImportJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitUserImportJobLogic {
+	return &SubmitUserImportJobLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// SubmitUserImportJob 保存上传文件并创建导入任务，由 worker 异步执行
+func (l *SubmitUserImportJobLogic) SubmitUserImportJob(req *types.SubmitUserImportJobReq, filename string, content []byte) (resp *types.SubmitJobResp, err error) {
+	// 1. 获取当前用户
+	userID, err := currentUserID(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 校验文件格式（内容在执行时解析）
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv", ".xlsx":
+	default:
+		return nil, baseErrorx.New(errorx.ErrUserManagementImportInvalidFile, "仅支持 CSV 或 XLSX 文件")
+	}
+
+	// 3. 先生成任务 ID 并保存上传文件，保证 worker 领取任务时文件已就绪
+	jobId, err := uuid.NewV7()
+	if err != nil {
+		l.Errorf("生成任务ID失败: %v", err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+	uploadPath, err := l.svcCtx.JobStorage.Save(jobId.String(), "upload"+strings.ToLower(filepath.Ext(filename)), content)
+	if err != nil {
+		l.Errorf("保存导入文件失败: jobId=%s, error=%v", jobId, err)
+		return nil, baseErrorx.New(errorx.ErrJobSubmitFailed, "任务提交失败")
+	}
+
+	// 4. 创建任务
+	params, _ := json.Marshal(userImportParams{
+		Filename:   filepath.Base(filename),
+		UploadPath: uploadPath,
+		DryRun:     req.DryRun,
+	})
+	job, err := l.svcCtx.JobModel.Insert(l.ctx, &jobsmodel.Job{
+		Id:        jobId.String(),
+		Type:      JobTypeUserImport,
+		Params:    params,
+		CreatedBy: userID,
+	})
+	if err != nil {
+		l.Errorf("创建导入任务失败: %v", err)
+		return nil, baseErrorx.New(errorx.ErrJobSubmitFailed, "任务提交失败")
+	}
+
+	return &types.SubmitJobResp{JobId: job.Id}, nil
+}
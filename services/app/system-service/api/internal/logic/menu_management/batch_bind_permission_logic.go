@@ -0,0 +1,63 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchBindPermissionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBatchBindPermissionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchBindPermissionLogic {
+	return &BatchBindPermissionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BatchBindPermissionLogic) BatchBindPermission(req *types.BatchBindPermissionReq) (resp *types.BatchMenuOperationResp, err error) {
+	// 1. 校验权限标识已登记为权限点
+	if err := ensurePermissionPoint(l.ctx, l.svcCtx, req.PermissionKey); err != nil {
+		logx.Errorf("校验权限标识失败: key=%s, error=%v", req.PermissionKey, err)
+		return nil, err
+	}
+
+	// 2. 逐个菜单绑定权限
+	return runMenuBatch(l.ctx, l.svcCtx, req.Ids, req.Mode, func(ctx context.Context, model menus.Model, id string) ([]menuBatchAudit, error) {
+		menu, err := model.FindOne(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// 已绑定相同权限时不写入
+		oldPermissionKey := stringValue(menu.PermissionKey)
+		if oldPermissionKey == req.PermissionKey {
+			return nil, nil
+		}
+
+		permissionKey := req.PermissionKey
+		menu.PermissionKey = &permissionKey
+		if err := model.Update(ctx, menu); err != nil {
+			return nil, err
+		}
+
+		return []menuBatchAudit{{
+			menuId:        id,
+			operationType: "bind_permission",
+			changedFields: []string{"permission_key"},
+			oldValue:      map[string]interface{}{"permission_key": oldPermissionKey},
+			newValue:      map[string]interface{}{"permission_key": permissionKey},
+		}}, nil
+	})
+}
@@ -0,0 +1,102 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeleteMenusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBatchDeleteMenusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchDeleteMenusLogic {
+	return &BatchDeleteMenusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BatchDeleteMenusLogic) BatchDeleteMenus(req *types.BatchDeleteMenusReq) (resp *types.BatchMenuOperationResp, err error) {
+	deleteLogic := NewDeleteMenuLogic(l.ctx, l.svcCtx)
+	// 已随祖先菜单级联删除的菜单ID（同一批次中再次出现时视为成功）
+	deleted := make(map[string]bool)
+
+	return runMenuBatch(l.ctx, l.svcCtx, req.Ids, req.Mode, func(ctx context.Context, model menus.Model, id string) ([]menuBatchAudit, error) {
+		if deleted[id] {
+			return nil, nil
+		}
+
+		// 1. 查询现有菜单
+		menu, err := model.FindOne(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. 查询子孙菜单（有子菜单且未允许级联则拒绝）
+		descendants, err := findMenuDescendants(ctx, model, id)
+		if err != nil {
+			return nil, err
+		}
+		if len(descendants) > 0 && !req.Cascade {
+			return nil, menus.ErrMenuHasChildren
+		}
+
+		// 3. 级联删除子孙菜单（深层菜单在前）
+		audits := make([]menuBatchAudit, 0, len(descendants)+1)
+		for i := len(descendants) - 1; i >= 0; i-- {
+			child := descendants[i]
+			if err := model.Delete(ctx, child.Id); err != nil {
+				return nil, err
+			}
+			audits = append(audits, menuBatchAudit{
+				menuId:        child.Id,
+				operationType: "delete",
+				oldValue:      deleteLogic.menuToMap(child),
+				remark:        menuBatchRemark + "（级联删除）",
+			})
+		}
+
+		// 4. 软删除菜单
+		if err := model.Delete(ctx, id); err != nil {
+			return nil, err
+		}
+		audits = append(audits, menuBatchAudit{
+			menuId:        id,
+			operationType: "delete",
+			oldValue:      deleteLogic.menuToMap(menu),
+		})
+
+		for _, child := range descendants {
+			deleted[child.Id] = true
+		}
+		return audits, nil
+	})
+}
+
+// findMenuDescendants 按层级顺序查询菜单的全部子孙菜单
+func findMenuDescendants(ctx context.Context, model menus.Model, id string) ([]*menus.Menu, error) {
+	var descendants []*menus.Menu
+	queue := []string{id}
+	for len(queue) > 0 {
+		children, err := model.FindChildren(ctx, queue[0])
+		if err != nil {
+			return nil, err
+		}
+		queue = queue[1:]
+		for _, child := range children {
+			descendants = append(descendants, child)
+			queue = append(queue, child.Id)
+		}
+	}
+	return descendants, nil
+}
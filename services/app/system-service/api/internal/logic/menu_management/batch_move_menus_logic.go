@@ -0,0 +1,111 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchMoveMenusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBatchMoveMenusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchMoveMenusLogic {
+	return &BatchMoveMenusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BatchMoveMenusLogic) BatchMoveMenus(req *types.BatchMoveMenusReq) (resp *types.BatchMenuOperationResp, err error) {
+	// 1. 查询新父级菜单（空表示移到根节点）
+	var parent *menus.Menu
+	if req.NewParentId != "" {
+		parent, err = l.svcCtx.MenuModel.FindOne(l.ctx, req.NewParentId)
+		if err != nil {
+			logx.Errorf("查询新父级菜单失败: %v", err)
+			return nil, err
+		}
+	}
+
+	// 2. 逐个菜单移动到新父级末尾
+	return runMenuBatch(l.ctx, l.svcCtx, req.Ids, req.Mode, func(ctx context.Context, model menus.Model, id string) ([]menuBatchAudit, error) {
+		menu, err := model.FindOne(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2.1 已在新父级下时不移动
+		oldParentId := stringValue(menu.ParentId)
+		if oldParentId == req.NewParentId {
+			return nil, nil
+		}
+
+		// 2.2 循环检测与分组约束检查
+		var newParentId *string
+		if parent != nil {
+			hasCycle, err := model.CheckCycle(ctx, id, parent.Id)
+			if err != nil {
+				return nil, err
+			}
+			if hasCycle {
+				return nil, menus.ErrMenuCycleDetected
+			}
+			if menu.GroupId != nil && (parent.GroupId == nil || *parent.GroupId != *menu.GroupId) {
+				return nil, menus.ErrMenuGroupConstraint
+			}
+			newParentId = &parent.Id
+		}
+
+		// 2.3 追加到新父级的子菜单末尾
+		newOrder, err := nextMenuOrder(ctx, model, newParentId)
+		if err != nil {
+			return nil, err
+		}
+		if err := model.Move(ctx, id, newParentId, newOrder); err != nil {
+			return nil, err
+		}
+
+		return []menuBatchAudit{{
+			menuId:        id,
+			operationType: "move",
+			changedFields: []string{"parent_id", "order"},
+			oldValue:      map[string]interface{}{"parent_id": menu.ParentId, "order": menu.Order},
+			newValue:      map[string]interface{}{"parent_id": newParentId, "order": newOrder},
+		}}, nil
+	})
+}
+
+// nextMenuOrder 计算父级下新增子菜单的排序号（现有最大值 + 1，parentId 为 nil 时为根节点）
+func nextMenuOrder(ctx context.Context, model menus.Model, parentId *string) (int, error) {
+	var siblings []*menus.Menu
+	var err error
+	if parentId != nil {
+		siblings, err = model.FindChildren(ctx, *parentId)
+	} else {
+		siblings, err = model.FindTree(ctx, &menus.FindTreeReq{})
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	nextOrder := 0
+	for _, sibling := range siblings {
+		if parentId == nil && sibling.ParentId != nil {
+			continue
+		}
+		if sibling.Order >= nextOrder {
+			nextOrder = sibling.Order + 1
+		}
+	}
+	return nextOrder, nil
+}
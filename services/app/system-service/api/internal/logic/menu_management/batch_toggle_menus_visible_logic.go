@@ -0,0 +1,60 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchToggleMenusVisibleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBatchToggleMenusVisibleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchToggleMenusVisibleLogic {
+	return &BatchToggleMenusVisibleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BatchToggleMenusVisibleLogic) BatchToggleMenusVisible(req *types.BatchToggleMenusVisibleReq) (resp *types.BatchMenuOperationResp, err error) {
+	operationType := "show"
+	if !req.Visible {
+		operationType = "hide"
+	}
+
+	return runMenuBatch(l.ctx, l.svcCtx, req.Ids, req.Mode, func(ctx context.Context, model menus.Model, id string) ([]menuBatchAudit, error) {
+		// 1. 查询现有菜单
+		menu, err := model.FindOne(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. 状态没有变化时不写入
+		if menu.Visible == req.Visible {
+			return nil, nil
+		}
+
+		// 3. 更新可见状态
+		if err := model.UpdateVisible(ctx, id, req.Visible); err != nil {
+			return nil, err
+		}
+
+		return []menuBatchAudit{{
+			menuId:        id,
+			operationType: operationType,
+			changedFields: []string{"visible"},
+			oldValue:      map[string]interface{}{"visible": menu.Visible},
+			newValue:      map[string]interface{}{"visible": req.Visible},
+		}}, nil
+	})
+}
@@ -53,16 +53,22 @@ func (l *BindPermissionLogic) BindPermission(req *types.BindPermissionReq) (resp
 	// 3. 确定新的权限标识
 	var newPermissionKey string
 	if req.CreatePermission {
-		// 创建新权限并绑定（需与权限服务联动）
+		// 创建新权限点并绑定
 		if req.PermissionName == "" {
 			return nil, fmt.Errorf("创建新权限时，权限名称不能为空")
 		}
-		// TODO: 调用权限服务创建权限
-		// 这里暂时使用菜单 code 生成权限标识
-		newPermissionKey = fmt.Sprintf("menu:%s", existingMenu.Code)
+		newPermissionKey, err = createMenuPermission(l.ctx, l.svcCtx, existingMenu, req.PermissionKey, req.PermissionName, req.PermissionModule)
+		if err != nil {
+			logx.Errorf("创建权限点失败: %v", err)
+			return nil, err
+		}
 		logx.Infof("创建新权限: %s (名称: %s)", newPermissionKey, req.PermissionName)
 	} else if req.PermissionKey != "" {
-		// 绑定已有权限
+		// 绑定已有权限（须已登记为权限点）
+		if err := ensurePermissionPoint(l.ctx, l.svcCtx, req.PermissionKey); err != nil {
+			logx.Errorf("校验权限标识失败: key=%s, error=%v", req.PermissionKey, err)
+			return nil, err
+		}
 		newPermissionKey = req.PermissionKey
 	} else {
 		return nil, fmt.Errorf("必须提供已有权限标识或创建新权限")
@@ -0,0 +1,147 @@
+package menu_management
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_audit_logs"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	catalogmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_catalog"
+	pointmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_points"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// MockMenuAuditLogModel 记录写入的菜单审计日志
+type MockMenuAuditLogModel struct {
+	Logs []*menu_audit_logs.MenuAuditLog
+}
+
+func (m *MockMenuAuditLogModel) Insert(ctx context.Context, data *menu_audit_logs.MenuAuditLog) (*menu_audit_logs.MenuAuditLog, error) {
+	m.Logs = append(m.Logs, data)
+	return data, nil
+}
+
+func (m *MockMenuAuditLogModel) FindOne(ctx context.Context, id string) (*menu_audit_logs.MenuAuditLog, error) {
+	for _, log := range m.Logs {
+		if log.Id == id {
+			return log, nil
+		}
+	}
+	return nil, menu_audit_logs.ErrMenuAuditLogNotFound
+}
+
+func (m *MockMenuAuditLogModel) FindList(ctx context.Context, req *menu_audit_logs.FindListReq) ([]*menu_audit_logs.MenuAuditLog, int64, error) {
+	return m.Logs, int64(len(m.Logs)), nil
+}
+
+func (m *MockMenuAuditLogModel) FindLatestByMenuId(ctx context.Context, menuId string) (*menu_audit_logs.MenuAuditLog, error) {
+	for i := len(m.Logs) - 1; i >= 0; i-- {
+		if m.Logs[i].MenuId == menuId {
+			return m.Logs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockMenuAuditLogModel) WithTx(tx interface{}) menu_audit_logs.Model {
+	return m
+}
+
+// setupBindPermissionTest 创建测试用的 ServiceContext（菜单使用 mock，权限点使用 SQLite）
+func setupBindPermissionTest(t *testing.T, menuModel *MockMenuModel) *svc.ServiceContext {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	// permission_points、permission_catalog_modules 使用 MySQL 专有默认值，SQLite 下手动建表
+	for _, ddl := range []string{
+		`CREATE TABLE IF NOT EXISTS permission_points (
+			id TEXT PRIMARY KEY, permission_key TEXT NOT NULL, name TEXT NOT NULL, type TEXT NOT NULL,
+			module TEXT NOT NULL, description TEXT, created_by TEXT NOT NULL,
+			created_at DATETIME, updated_by TEXT, updated_at DATETIME, deleted_at DATETIME
+		)`,
+		`CREATE TABLE IF NOT EXISTS permission_catalog_modules (
+			id TEXT PRIMARY KEY, code TEXT NOT NULL, name TEXT NOT NULL, description TEXT,
+			actions TEXT NOT NULL, scopes TEXT NOT NULL, sort_order INTEGER NOT NULL DEFAULT 0, created_by TEXT NOT NULL,
+			created_at DATETIME, updated_by TEXT, updated_at DATETIME, deleted_at DATETIME
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	return &svc.ServiceContext{
+		DB:                     db,
+		MenuModel:              menuModel,
+		MenuAuditLogModel:      &MockMenuAuditLogModel{},
+		PermissionPointModel:   pointmodel.NewModel(db),
+		PermissionCatalogModel: catalogmodel.NewModel(db),
+	}
+}
+
+func TestBindPermission_ExistingKeyMustBeRegistered(t *testing.T) {
+	menu := &menus.Menu{Id: "menu-1", Name: "用户列表", Code: "user_list", Type: "page"}
+	mockModel := new(MockMenuModel)
+	mockModel.On("FindOne", mock.Anything, "menu-1").Return(menu, nil)
+	svcCtx := setupBindPermissionTest(t, mockModel)
+
+	resp, err := NewBindPermissionLogic(context.Background(), svcCtx).BindPermission(&types.BindPermissionReq{
+		Id:            "menu-1",
+		PermissionKey: "user:list",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	codeErr, ok := err.(*baseErrorx.CodeError)
+	require.True(t, ok)
+	assert.Equal(t, errorx.ErrPermissionPointNotFound, codeErr.Code)
+	mockModel.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestBindPermission_CreatePermissionRegistersPoint(t *testing.T) {
+	menu := &menus.Menu{Id: "menu-2", Name: "导出", Code: "user_export", Type: "button"}
+	mockModel := new(MockMenuModel)
+	mockModel.On("FindOne", mock.Anything, "menu-2").Return(menu, nil)
+	mockModel.On("Update", mock.Anything, mock.MatchedBy(func(m *menus.Menu) bool {
+		return m.PermissionKey != nil && *m.PermissionKey == "menu:user_export"
+	})).Return(nil)
+	mockModel.On("FindChildrenCount", mock.Anything, "menu-2").Return(int64(0), nil)
+	svcCtx := setupBindPermissionTest(t, mockModel)
+	ctx := context.Background()
+
+	resp, err := NewBindPermissionLogic(ctx, svcCtx).BindPermission(&types.BindPermissionReq{
+		Id:               "menu-2",
+		CreatePermission: true,
+		PermissionName:   "导出用户",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "menu:user_export", resp.Menu.PermissionKey)
+	point, err := svcCtx.PermissionPointModel.FindOneByKey(ctx, "menu:user_export")
+	require.NoError(t, err)
+	assert.Equal(t, "导出用户", point.Name)
+	assert.Equal(t, pointmodel.TypeButton, point.Type)
+	assert.Equal(t, "menu", point.Module)
+	mockModel.AssertExpectations(t)
+
+	// 重复创建同一权限标识被拒绝
+	_, err = NewBindPermissionLogic(ctx, svcCtx).BindPermission(&types.BindPermissionReq{
+		Id:               "menu-2",
+		CreatePermission: true,
+		PermissionName:   "导出用户",
+	})
+	codeErr, ok := err.(*baseErrorx.CodeError)
+	require.True(t, ok)
+	assert.Equal(t, errorx.ErrPermissionPointKeyExists, codeErr.Code)
+}
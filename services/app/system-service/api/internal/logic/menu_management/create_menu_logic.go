@@ -59,6 +59,13 @@ func (l *CreateMenuLogic) CreateMenu(req *types.CreateMenuReq) (resp *types.Crea
 		}
 	}
 
+	// 3.1 group_id 存在性检查（如提供）
+	if req.GroupId != "" {
+		if err := ensureMenuGroup(l.ctx, l.svcCtx, req.GroupId); err != nil {
+			return nil, err
+		}
+	}
+
 	// 4. parent_id 循环检查（如提供）
 	if req.ParentId != "" {
 		// 生成新菜单ID用于循环检查
@@ -152,13 +159,19 @@ func (l *CreateMenuLogic) CreateMenu(req *types.CreateMenuReq) (resp *types.Crea
 		menu.Icon = &req.Icon
 	}
 
-	// 9. 权限创建联动（如 create_permission=true）
-	// 注意：这里需要与权限服务联动，暂时跳过，后续可以扩展
+	// 9. 权限创建联动（create_permission=true 时创建权限点，否则校验已有权限标识已登记）
 	if req.CreatePermission && req.PermissionName != "" {
-		// TODO: 调用权限服务创建权限
-		// 这里暂时使用 permission_name 作为 permission_key
-		permissionKey := fmt.Sprintf("menu:%s", req.Code)
+		permissionKey, err := createMenuPermission(l.ctx, l.svcCtx, menu, req.PermissionKey, req.PermissionName, "")
+		if err != nil {
+			logx.Errorf("创建权限点失败: %v", err)
+			return nil, err
+		}
 		menu.PermissionKey = &permissionKey
+	} else if req.PermissionKey != "" {
+		if err := ensurePermissionPoint(l.ctx, l.svcCtx, req.PermissionKey); err != nil {
+			logx.Errorf("校验权限标识失败: key=%s, error=%v", req.PermissionKey, err)
+			return nil, err
+		}
 	}
 
 	// 10. 插入菜单
@@ -0,0 +1,115 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_audit_logs"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_groups"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type DeleteMenuGroupLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDeleteMenuGroupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteMenuGroupLogic {
+	return &DeleteMenuGroupLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteMenuGroupLogic) DeleteMenuGroup(req *types.DeleteMenuGroupReq) (resp *types.DeleteMenuGroupResp, err error) {
+	// 1. 查询分组
+	group, err := l.svcCtx.MenuGroupModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 校验改挂目标分组（为空时清空菜单分组）
+	var targetGroupId *string
+	if req.ReassignTo != "" {
+		if req.ReassignTo == group.Id {
+			return nil, menu_groups.ErrMenuGroupReassignInvalid
+		}
+		if err := ensureMenuGroup(l.ctx, l.svcCtx, req.ReassignTo); err != nil {
+			return nil, err
+		}
+		targetGroupId = &req.ReassignTo
+	}
+
+	// 3. 查询分组下的菜单（用于审计日志）
+	groupMenus, err := l.svcCtx.MenuModel.FindTree(l.ctx, &menus.FindTreeReq{GroupId: group.Id})
+	if err != nil {
+		logx.Errorf("查询分组菜单失败: %v", err)
+		return nil, fmt.Errorf("查询分组菜单失败: %w", err)
+	}
+
+	// 4. 事务内改挂菜单并删除分组
+	var reassigned int64
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		affected, err := l.svcCtx.MenuModel.WithTx(tx).ReassignGroup(l.ctx, group.Id, targetGroupId)
+		if err != nil {
+			return err
+		}
+		reassigned = affected
+		return l.svcCtx.MenuGroupModel.WithTx(tx).Delete(l.ctx, group.Id)
+	})
+	if err != nil {
+		logx.Errorf("删除菜单分组失败: %v", err)
+		return nil, fmt.Errorf("删除菜单分组失败: %w", err)
+	}
+
+	// 5. 记录菜单分组变更审计日志（失败不影响主流程）
+	for _, menu := range groupMenus {
+		if err := l.recordReassignAuditLog(menu.Id, group.Id, req.ReassignTo); err != nil {
+			logx.Errorf("记录审计日志失败: %v", err)
+		}
+	}
+
+	return &types.DeleteMenuGroupResp{
+		Success:         true,
+		ReassignedMenus: reassigned,
+	}, nil
+}
+
+// recordReassignAuditLog 记录菜单因分组删除而改挂的审计日志
+func (l *DeleteMenuGroupLogic) recordReassignAuditLog(menuId, fromGroupId, toGroupId string) error {
+	auditLogId, _ := uuid.NewV7()
+	auditLog := &menu_audit_logs.MenuAuditLog{
+		Id:            auditLogId.String(),
+		MenuId:        menuId,
+		OperationType: "update",
+		Remark:        stringPtr("分组删除改挂"),
+	}
+
+	// 获取操作人信息（从 context 中）
+	if userID, ok := l.ctx.Value(contextkeys.UserIDKey).(string); ok && userID != "" {
+		auditLog.OperatorId = &userID
+	}
+
+	changedFieldsJSON, _ := json.Marshal([]string{"group_id"})
+	oldValueJSON, _ := json.Marshal(map[string]interface{}{"group_id": fromGroupId})
+	newValueJSON, _ := json.Marshal(map[string]interface{}{"group_id": toGroupId})
+	auditLog.ChangedFields = datatypes.JSON(changedFieldsJSON)
+	auditLog.OldValue = datatypes.JSON(oldValueJSON)
+	auditLog.NewValue = datatypes.JSON(newValueJSON)
+
+	_, err := l.svcCtx.MenuAuditLogModel.Insert(l.ctx, auditLog)
+	return err
+}
@@ -0,0 +1,142 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportMenusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// MenuExport 菜单配置导出结果，由 handler 设置响应头后写出
+type MenuExport struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+func NewExportMenusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportMenusLogic {
+	return &ExportMenusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ExportMenus 导出全部菜单或指定子树的配置
+func (l *ExportMenusLogic) ExportMenus(req *types.ExportMenusReq) (*MenuExport, error) {
+	// 1. 校验导出格式
+	format, err := menuConfigFormat(req.Format, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 查询全部菜单
+	allMenus, err := l.svcCtx.MenuModel.FindTree(l.ctx, &menus.FindTreeReq{})
+	if err != nil {
+		logx.Errorf("查询菜单失败: %v", err)
+		return nil, fmt.Errorf("查询菜单失败: %w", err)
+	}
+
+	// 3. 按父子关系排列（父菜单在前），指定 root_code 时只导出该子树
+	rootId := ""
+	if req.RootCode != "" {
+		root, err := l.svcCtx.MenuModel.FindOneByCode(l.ctx, req.RootCode)
+		if err != nil {
+			return nil, err
+		}
+		rootId = root.Id
+	}
+	ordered := orderMenusByTree(allMenus, rootId)
+
+	// 4. 转换为配置项并序列化（分组以编码导出）
+	groupCodeById, _, err := loadMenuGroupCodes(l.ctx, l.svcCtx)
+	if err != nil {
+		logx.Errorf("查询菜单分组失败: %v", err)
+		return nil, fmt.Errorf("查询菜单分组失败: %w", err)
+	}
+	codeById := make(map[string]string, len(allMenus))
+	for _, menu := range allMenus {
+		codeById[menu.Id] = menu.Code
+	}
+	config := &menuConfig{
+		Version:  menuConfigVersion,
+		RootCode: req.RootCode,
+		Menus:    make([]menuConfigItem, 0, len(ordered)),
+	}
+	for _, menu := range ordered {
+		config.Menus = append(config.Menus, toMenuConfigItem(menu, codeById, groupCodeById))
+	}
+	content, err := marshalMenuConfig(format, config)
+	if err != nil {
+		logx.Errorf("序列化菜单配置失败: %v", err)
+		return nil, fmt.Errorf("序列化菜单配置失败: %w", err)
+	}
+
+	filename := "menus"
+	if req.RootCode != "" {
+		filename += "-" + req.RootCode
+	}
+	contentType := "application/json; charset=utf-8"
+	if format == MenuConfigFormatYAML {
+		contentType = "application/yaml; charset=utf-8"
+	}
+	return &MenuExport{
+		Filename:    filename + "." + format,
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
+
+// orderMenusByTree 深度优先排列菜单（父菜单在前，同级保持原顺序）
+// rootId 为空时从全部根节点开始（父菜单不存在的菜单视为根节点），否则只返回该子树
+func orderMenusByTree(allMenus []*menus.Menu, rootId string) []*menus.Menu {
+	menuMap := make(map[string]*menus.Menu, len(allMenus))
+	for _, menu := range allMenus {
+		menuMap[menu.Id] = menu
+	}
+	childrenMap := make(map[string][]*menus.Menu)
+	var roots []*menus.Menu
+	for _, menu := range allMenus {
+		if menu.ParentId == nil || menuMap[*menu.ParentId] == nil {
+			roots = append(roots, menu)
+			continue
+		}
+		childrenMap[*menu.ParentId] = append(childrenMap[*menu.ParentId], menu)
+	}
+	if rootId != "" {
+		roots = nil
+		if root, ok := menuMap[rootId]; ok {
+			roots = []*menus.Menu{root}
+		}
+	}
+
+	ordered := make([]*menus.Menu, 0, len(allMenus))
+	visited := make(map[string]struct{}, len(allMenus))
+	var walk func(menu *menus.Menu)
+	walk = func(menu *menus.Menu) {
+		if _, ok := visited[menu.Id]; ok {
+			return
+		}
+		visited[menu.Id] = struct{}{}
+		ordered = append(ordered, menu)
+		for _, child := range childrenMap[menu.Id] {
+			walk(child)
+		}
+	}
+	for _, root := range roots {
+		walk(root)
+	}
+	return ordered
+}
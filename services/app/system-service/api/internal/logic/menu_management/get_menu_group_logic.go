@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetMenuGroupLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetMenuGroupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetMenuGroupLogic {
+	return &GetMenuGroupLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetMenuGroupLogic) GetMenuGroup(req *types.GetMenuGroupReq) (resp *types.GetMenuGroupResp, err error) {
+	// 1. 查询分组
+	group, err := l.svcCtx.MenuGroupModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 统计分组菜单数量
+	stats, err := l.svcCtx.MenuModel.GetStatistics(l.ctx, group.Id)
+	if err != nil {
+		logx.Errorf("统计分组菜单数量失败: %v", err)
+		return nil, fmt.Errorf("统计分组菜单数量失败: %w", err)
+	}
+
+	return &types.GetMenuGroupResp{
+		Data: convertToMenuGroupType(group, stats.Total),
+	}, nil
+}
@@ -26,15 +26,22 @@ func NewGetMenuStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetM
 	}
 }
 
-func (l *GetMenuStatsLogic) GetMenuStats() (resp *types.GetMenuStatsResp, err error) {
-	// 1. 查询统计信息
-	stats, err := l.svcCtx.MenuModel.GetStatistics(l.ctx)
+func (l *GetMenuStatsLogic) GetMenuStats(req *types.GetMenuStatsReq) (resp *types.GetMenuStatsResp, err error) {
+	// 1. 校验分组存在（如提供）
+	if req.GroupId != "" {
+		if err := ensureMenuGroup(l.ctx, l.svcCtx, req.GroupId); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. 查询统计信息
+	stats, err := l.svcCtx.MenuModel.GetStatistics(l.ctx, req.GroupId)
 	if err != nil {
 		logx.Errorf("查询菜单统计信息失败: %v", err)
 		return nil, fmt.Errorf("查询菜单统计信息失败: %w", err)
 	}
 
-	// 2. 转换为响应类型
+	// 3. 转换为响应类型
 	resp = &types.GetMenuStatsResp{
 		Total:             stats.Total,
 		Enabled:           stats.Enabled,
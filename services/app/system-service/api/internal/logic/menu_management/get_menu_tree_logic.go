@@ -25,6 +25,13 @@ func NewGetMenuTreeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetMe
 }
 
 func (l *GetMenuTreeLogic) GetMenuTree(req *types.GetMenuTreeReq) (resp *types.GetMenuTreeResp, err error) {
+	// 按分组查询时校验分组存在
+	if req.GroupId != "" {
+		if err := ensureMenuGroup(l.ctx, l.svcCtx, req.GroupId); err != nil {
+			return nil, err
+		}
+	}
+
 	// 构建查询请求
 	findReq := &menus.FindTreeReq{
 		Keyword:        req.Keyword,
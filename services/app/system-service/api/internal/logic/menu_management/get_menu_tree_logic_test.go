@@ -37,6 +37,14 @@ func (m *MockMenuModel) FindOne(ctx context.Context, id string) (*menus.Menu, er
 	return args.Get(0).(*menus.Menu), args.Error(1)
 }
 
+func (m *MockMenuModel) FindOneIncludingDeleted(ctx context.Context, id string) (*menus.Menu, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*menus.Menu), args.Error(1)
+}
+
 func (m *MockMenuModel) FindOneByCode(ctx context.Context, code string) (*menus.Menu, error) {
 	args := m.Called(ctx, code)
 	if args.Get(0) == nil {
@@ -104,14 +112,40 @@ func (m *MockMenuModel) FindByPath(ctx context.Context, path string) ([]*menus.M
 	return args.Get(0).([]*menus.Menu), args.Error(1)
 }
 
-func (m *MockMenuModel) GetStatistics(ctx context.Context) (*menus.Statistics, error) {
+func (m *MockMenuModel) FindByPermissionKey(ctx context.Context, permissionKey string) ([]*menus.Menu, error) {
+	args := m.Called(ctx, permissionKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*menus.Menu), args.Error(1)
+}
+
+func (m *MockMenuModel) ReassignGroup(ctx context.Context, fromGroupId string, toGroupId *string) (int64, error) {
+	args := m.Called(ctx, fromGroupId, toGroupId)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockMenuModel) CountByGroup(ctx context.Context) (map[string]int64, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+func (m *MockMenuModel) GetStatistics(ctx context.Context, groupId string) (*menus.Statistics, error) {
+	args := m.Called(ctx, groupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
 	return args.Get(0).(*menus.Statistics), args.Error(1)
 }
 
+func (m *MockMenuModel) Restore(ctx context.Context, data *menus.Menu) error {
+	args := m.Called(ctx, data)
+	return args.Error(0)
+}
+
 func (m *MockMenuModel) UpdateEnabled(ctx context.Context, id string, enabled bool) error {
 	args := m.Called(ctx, id, enabled)
 	return args.Error(0)
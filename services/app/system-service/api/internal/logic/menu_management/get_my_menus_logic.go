@@ -0,0 +1,147 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetMyMenusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取当前用户的导航菜单
+func NewGetMyMenusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetMyMenusLogic {
+	return &GetMyMenusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetMyMenusLogic) GetMyMenus() (resp *types.GetMyMenusResp, err error) {
+	// 1. 从 JWT Token 中提取用户 ID
+	userId, ok := l.ctx.Value(contextkeys.UserIDKey).(string)
+	if !ok || userId == "" {
+		return nil, baseErrorx.New(errorx.ErrTokenInvalid, "Token 无效或已过期")
+	}
+
+	// 2. 解析用户有效权限
+	effective, err := l.svcCtx.PermissionResolver.Effective(l.ctx, userId)
+	if err != nil {
+		l.Errorf("解析用户有效权限失败: userId=%s, error=%v", userId, err)
+		return nil, fmt.Errorf("解析用户有效权限失败: %w", err)
+	}
+
+	// 3. 查询全部启用的菜单（已按 order 排序）
+	enabled := true
+	menuList, err := l.svcCtx.MenuModel.FindTree(l.ctx, &menus.FindTreeReq{Enabled: &enabled})
+	if err != nil {
+		l.Errorf("查询菜单失败: %v", err)
+		return nil, fmt.Errorf("查询菜单失败: %w", err)
+	}
+
+	// 4. 按父级分组导航菜单，收集有权使用的按钮编码
+	childrenMap := make(map[string][]*menus.Menu)
+	buttonCodes := make([]string, 0)
+	for _, menu := range menuList {
+		if menu.Type == "button" {
+			if menuPermitted(menu, effective) {
+				buttonCodes = append(buttonCodes, menu.Code)
+			}
+			continue
+		}
+		if !menu.Visible || !menu.ShowInNav {
+			continue
+		}
+		parentId := ""
+		if menu.ParentId != nil {
+			parentId = *menu.ParentId
+		}
+		childrenMap[parentId] = append(childrenMap[parentId], menu)
+	}
+
+	// 5. 从根节点开始裁剪导航树（上级隐藏或无权限时整棵子树不返回）
+	return &types.GetMyMenusResp{
+		Menus:       buildNavTree(childrenMap, "", effective),
+		ButtonCodes: buttonCodes,
+	}, nil
+}
+
+// buildNavTree 构建用户可见的导航树
+// 无权限的菜单连同子菜单一起移除；目录在没有可见子菜单时移除
+func buildNavTree(childrenMap map[string][]*menus.Menu, parentId string, effective *authz.EffectivePermissions) []types.NavMenu {
+	nodes := make([]types.NavMenu, 0)
+	for _, menu := range childrenMap[parentId] {
+		if !menuPermitted(menu, effective) {
+			continue
+		}
+		node := convertToNavMenu(menu)
+		node.Children = buildNavTree(childrenMap, menu.Id, effective)
+		if menu.Type == "directory" && len(node.Children) == 0 {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// menuPermitted 判断用户是否有权访问菜单
+// 已绑定权限标识的菜单需拥有该权限；未绑定的目录、页面、按钮不限制，外部链接必须绑定且拥有权限
+func menuPermitted(menu *menus.Menu, effective *authz.EffectivePermissions) bool {
+	if menu.PermissionKey == nil || *menu.PermissionKey == "" {
+		return menu.Type != "external"
+	}
+	return effective.AllowsKey(*menu.PermissionKey)
+}
+
+// convertToNavMenu 将 Model 层的 Menu 转换为导航菜单
+func convertToNavMenu(menu *menus.Menu) types.NavMenu {
+	node := types.NavMenu{
+		Id:        menu.Id,
+		Name:      menu.Name,
+		Code:      menu.Code,
+		Type:      menu.Type,
+		Order:     menu.Order,
+		Cacheable: menu.Cacheable,
+	}
+	if menu.ParentId != nil {
+		node.ParentId = *menu.ParentId
+	}
+	if menu.Path != nil {
+		node.Path = *menu.Path
+	}
+	if menu.RouteName != nil {
+		node.RouteName = *menu.RouteName
+	}
+	if menu.ComponentKey != nil {
+		node.ComponentKey = *menu.ComponentKey
+	}
+	if menu.ExternalUrl != nil {
+		node.ExternalUrl = *menu.ExternalUrl
+	}
+	if menu.OpenMode != nil {
+		node.OpenMode = *menu.OpenMode
+	}
+	if menu.PermissionKey != nil {
+		node.PermissionKey = *menu.PermissionKey
+	}
+	if menu.Icon != nil {
+		node.Icon = *menu.Icon
+	}
+	return node
+}
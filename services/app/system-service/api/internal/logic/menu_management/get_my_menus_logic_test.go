@@ -0,0 +1,130 @@
+package menu_management
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/testutil"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupMyMenusTest 创建测试用的 ServiceContext（菜单使用 mock，权限解析使用 SQLite）
+// user-1 拥有 user 模块 read 权限
+func setupMyMenusTest(t *testing.T, menuModel *MockMenuModel) *svc.ServiceContext {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&rolebindings.RoleBinding{}))
+
+	testutil.CreatePermissionTables(t, db)
+
+	now := time.Now()
+	require.NoError(t, db.Create(&permissiontemplates.PermissionTemplate{
+		Id: "tpl-viewer", Name: "viewer", Code: "viewer", Status: permissiontemplates.StatusPublished,
+		PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read"],"scope":"global"}}`),
+		Version:      1, CreatedBy: "system", CreatedAt: now, UpdatedAt: now,
+	}).Error)
+	permissionRole := "viewer"
+	require.NoError(t, db.Create(&rolebindings.RoleBinding{UserId: "user-1", OrgId: "dept-1", PermissionRole: &permissionRole}).Error)
+
+	return &svc.ServiceContext{
+		DB:                 db,
+		MenuModel:          menuModel,
+		PermissionResolver: authz.NewResolver(rolebindings.NewModel(db), roles.NewModel(db), permissiontemplates.NewModel(db), permissiontemplateversions.NewModel(db)),
+	}
+}
+
+// newNavTestMenu 创建启用、可见且在导航中显示的测试菜单
+func newNavTestMenu(code, menuType, parentId, permissionKey string, order int) *menus.Menu {
+	menu := &menus.Menu{
+		Id:        "menu-" + code,
+		Name:      code,
+		Code:      code,
+		Type:      menuType,
+		Visible:   true,
+		Enabled:   true,
+		ShowInNav: true,
+		Order:     order,
+	}
+	if parentId != "" {
+		menu.ParentId = testStringPtr("menu-" + parentId)
+	}
+	if permissionKey != "" {
+		menu.PermissionKey = testStringPtr(permissionKey)
+	}
+	return menu
+}
+
+func TestGetMyMenus_PrunesTreeByPermissions(t *testing.T) {
+	hiddenPage := newNavTestMenu("user_hidden", "page", "system", "user:read", 2)
+	hiddenPage.ShowInNav = false
+	menuList := []*menus.Menu{
+		newNavTestMenu("system", "directory", "", "", 0),
+		newNavTestMenu("user_list", "page", "system", "user:read", 0),
+		newNavTestMenu("role_list", "page", "system", "role:read", 1),
+		hiddenPage,
+		newNavTestMenu("user_detail", "page", "user_list", "", 0),
+		newNavTestMenu("user_export", "button", "user_list", "user:read", 0),
+		newNavTestMenu("user_delete", "button", "user_list", "user:delete", 1),
+		// 子菜单全部无权限的目录不返回
+		newNavTestMenu("audit", "directory", "", "", 1),
+		newNavTestMenu("audit_log", "page", "audit", "audit:read", 0),
+		// 外部链接必须绑定且拥有权限
+		newNavTestMenu("docs", "external", "", "", 2),
+		newNavTestMenu("wiki", "external", "", "user:read", 3),
+	}
+	mockModel := new(MockMenuModel)
+	mockModel.On("FindTree", mock.Anything, mock.MatchedBy(func(req *menus.FindTreeReq) bool {
+		return req.Enabled != nil && *req.Enabled
+	})).Return(menuList, nil)
+	svcCtx := setupMyMenusTest(t, mockModel)
+	ctx := context.WithValue(context.Background(), contextkeys.UserIDKey, "user-1")
+
+	resp, err := NewGetMyMenusLogic(ctx, svcCtx).GetMyMenus()
+
+	require.NoError(t, err)
+	require.Len(t, resp.Menus, 2)
+	assert.Equal(t, "system", resp.Menus[0].Code)
+	require.Len(t, resp.Menus[0].Children, 1)
+	userList := resp.Menus[0].Children[0]
+	assert.Equal(t, "user_list", userList.Code)
+	require.Len(t, userList.Children, 1)
+	assert.Equal(t, "user_detail", userList.Children[0].Code)
+	assert.Equal(t, "wiki", resp.Menus[1].Code)
+	assert.Equal(t, []string{"user_export"}, resp.ButtonCodes)
+	mockModel.AssertExpectations(t)
+}
+
+func TestGetMyMenus_RequiresAuthenticatedUser(t *testing.T) {
+	mockModel := new(MockMenuModel)
+	svcCtx := setupMyMenusTest(t, mockModel)
+
+	resp, err := NewGetMyMenusLogic(context.Background(), svcCtx).GetMyMenus()
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	codeErr, ok := err.(*baseErrorx.CodeError)
+	require.True(t, ok)
+	assert.Equal(t, errorx.ErrTokenInvalid, codeErr.Code)
+	mockModel.AssertNotCalled(t, "FindTree", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,458 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_audit_logs"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_groups"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	"github.com/google/uuid"
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/datatypes"
+)
+
+// 菜单导入变更类型
+const (
+	MenuImportActionCreate = "create"
+	MenuImportActionUpdate = "update"
+	MenuImportActionMove   = "move"
+	MenuImportActionDelete = "delete"
+)
+
+// menuImportRemark 导入变更的审计日志备注
+const menuImportRemark = "配置导入"
+
+type ImportMenusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// menuImportOp 单个菜单的导入变更
+type menuImportOp struct {
+	change types.MenuImportChange
+	item   *menuConfigItem        // 配置项（delete 时为 nil）
+	menu   *menus.Menu            // 已有菜单（create 时在写入后设置）
+	before map[string]interface{} // 变更前的字段值（create 时为 nil）
+}
+
+// menuImportPlan 校验和差异计算结果
+type menuImportPlan struct {
+	byCode        map[string]*menus.Menu     // 现有菜单：编码 -> 菜单
+	codeById      map[string]string          // 现有菜单：ID -> 编码
+	groupCodeById map[string]string          // 菜单分组：ID -> 编码
+	groupIdByCode map[string]string          // 菜单分组：编码 -> ID
+	items         map[string]*menuConfigItem // 配置项：编码 -> 配置项
+	deleted       map[string]*menus.Menu     // 将被删除的现有菜单：编码 -> 菜单
+	ops           []*menuImportOp
+	errors        []types.MenuImportError
+}
+
+func NewImportMenusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportMenusLogic {
+	return &ImportMenusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ImportMenus 按菜单编码导入配置：存在则更新（保留原ID），不存在则创建；prune=true 时删除导入范围内配置中不存在的菜单
+// 任一配置项校验失败时不写入任何变更；dry_run=true 时只返回差异
+func (l *ImportMenusLogic) ImportMenus(req *types.ImportMenusReq, filename string, content []byte) (*types.ImportMenusResp, error) {
+	// 1. 解析配置文件
+	format, err := menuConfigFormat(req.Format, filename)
+	if err != nil {
+		return nil, baseErrorx.New(errorx.ErrMenuImportInvalidFile, err.Error())
+	}
+	config, err := unmarshalMenuConfig(format, content)
+	if err != nil {
+		return nil, baseErrorx.New(errorx.ErrMenuImportInvalidFile, fmt.Sprintf("解析菜单配置失败: %v", err))
+	}
+
+	// 2. 查询现有菜单
+	allMenus, err := l.svcCtx.MenuModel.FindTree(l.ctx, &menus.FindTreeReq{})
+	if err != nil {
+		logx.Errorf("查询菜单失败: %v", err)
+		return nil, fmt.Errorf("查询菜单失败: %w", err)
+	}
+
+	groupCodeById, groupIdByCode, err := loadMenuGroupCodes(l.ctx, l.svcCtx)
+	if err != nil {
+		logx.Errorf("查询菜单分组失败: %v", err)
+		return nil, fmt.Errorf("查询菜单分组失败: %w", err)
+	}
+
+	// 3. 校验配置并计算差异
+	plan := l.buildPlan(config, allMenus, groupCodeById, groupIdByCode, req.Prune)
+	resp := &types.ImportMenusResp{
+		DryRun:  req.DryRun,
+		Changes: make([]types.MenuImportChange, 0, len(plan.ops)),
+		Errors:  plan.errors,
+	}
+	resp.Unchanged = len(plan.items)
+	for _, op := range plan.ops {
+		switch op.change.Action {
+		case MenuImportActionCreate:
+			resp.Created++
+		case MenuImportActionUpdate:
+			resp.Updated++
+		case MenuImportActionMove:
+			resp.Moved++
+		case MenuImportActionDelete:
+			resp.Deleted++
+		}
+		if op.item != nil {
+			resp.Unchanged--
+		}
+	}
+	if len(plan.errors) > 0 || req.DryRun {
+		for _, op := range plan.ops {
+			resp.Changes = append(resp.Changes, op.change)
+		}
+		return resp, nil
+	}
+
+	// 4. 在事务中应用变更（父菜单先于子菜单写入，删除时子菜单在前）
+	err = l.svcCtx.MenuModel.Trans(l.ctx, func(ctx context.Context, model menus.Model) error {
+		return applyMenuImport(ctx, model, plan)
+	})
+	if err != nil {
+		logx.Errorf("导入菜单配置失败: %v", err)
+		return nil, err
+	}
+	resp.Applied = true
+
+	// 5. 记录审计日志（失败不影响主流程）
+	for _, op := range plan.ops {
+		if err := l.recordImportAuditLog(op); err != nil {
+			logx.Errorf("记录菜单导入审计日志失败: code=%s, error=%v", op.change.Code, err)
+		}
+		resp.Changes = append(resp.Changes, op.change)
+	}
+	return resp, nil
+}
+
+// buildPlan 校验配置项并计算与现有菜单的差异
+func (l *ImportMenusLogic) buildPlan(config *menuConfig, allMenus []*menus.Menu, groupCodeById, groupIdByCode map[string]string, prune bool) *menuImportPlan {
+	plan := &menuImportPlan{
+		byCode:        make(map[string]*menus.Menu, len(allMenus)),
+		codeById:      make(map[string]string, len(allMenus)),
+		groupCodeById: groupCodeById,
+		groupIdByCode: groupIdByCode,
+		items:         make(map[string]*menuConfigItem, len(config.Menus)),
+		deleted:       make(map[string]*menus.Menu),
+	}
+	for _, menu := range allMenus {
+		plan.byCode[menu.Code] = menu
+		plan.codeById[menu.Id] = menu.Code
+	}
+	addError := func(code, reason string) {
+		plan.errors = append(plan.errors, types.MenuImportError{Code: code, Reason: reason})
+	}
+
+	// 1. 配置项字段校验（与创建菜单规则一致）
+	createLogic := NewCreateMenuLogic(l.ctx, l.svcCtx)
+	for i := range config.Menus {
+		item := &config.Menus[i]
+		if item.Code == "" {
+			addError("", fmt.Sprintf("第 %d 个菜单编码为空", i+1))
+			continue
+		}
+		if _, ok := plan.items[item.Code]; ok {
+			addError(item.Code, "菜单编码重复")
+			continue
+		}
+		plan.items[item.Code] = item
+		if err := createLogic.validateTypeFields(item.createReq()); err != nil {
+			addError(item.Code, err.Error())
+		}
+	}
+
+	// 2. 确定导入范围（指定 root_code 时为该子树），prune=true 时范围内未出现在配置中的菜单将被删除
+	rootId := ""
+	if config.RootCode != "" {
+		if _, ok := plan.items[config.RootCode]; !ok {
+			addError(config.RootCode, "配置中缺少根菜单")
+		}
+		if root, ok := plan.byCode[config.RootCode]; ok {
+			rootId = root.Id
+		}
+	}
+	var scope []*menus.Menu
+	if config.RootCode == "" || rootId != "" {
+		scope = orderMenusByTree(allMenus, rootId)
+	}
+	if prune {
+		for _, menu := range scope {
+			if _, ok := plan.items[menu.Code]; !ok {
+				plan.deleted[menu.Code] = menu
+			}
+		}
+	}
+
+	// 3. 父菜单、分组、循环、路由冲突、权限标识校验
+	parentCodes := make(map[string]string, len(allMenus)+len(plan.items))
+	groupCodes := make(map[string]string, len(allMenus)+len(plan.items))
+	pathOwners := make(map[string]string)
+	for _, menu := range allMenus {
+		if _, ok := plan.deleted[menu.Code]; ok {
+			continue
+		}
+		if _, ok := plan.items[menu.Code]; ok {
+			continue
+		}
+		if menu.ParentId != nil {
+			parentCodes[menu.Code] = plan.codeById[*menu.ParentId]
+		}
+		if menu.GroupId != nil {
+			groupCodes[menu.Code] = plan.groupCodeById[*menu.GroupId]
+		}
+		if menu.Path != nil && *menu.Path != "" {
+			pathOwners[*menu.Path] = menu.Code
+		}
+	}
+	for code, item := range plan.items {
+		parentCodes[code] = item.ParentCode
+		groupCodes[code] = item.GroupCode
+	}
+	checkedKeys := make(map[string]error)
+	for i := range config.Menus {
+		item := &config.Menus[i]
+		if item.Code == "" || plan.items[item.Code] != item {
+			continue
+		}
+		if item.ParentCode != "" {
+			_, inConfig := plan.items[item.ParentCode]
+			_, exists := plan.byCode[item.ParentCode]
+			_, deleted := plan.deleted[item.ParentCode]
+			if !inConfig && (!exists || deleted) {
+				addError(item.Code, fmt.Sprintf("父菜单 %s 不存在", item.ParentCode))
+			}
+		}
+		if item.GroupCode != "" {
+			if _, ok := plan.groupIdByCode[item.GroupCode]; !ok {
+				addError(item.Code, fmt.Sprintf("%s: %s", menu_groups.ErrMenuGroupNotFound.Error(), item.GroupCode))
+			} else if parentGroup := groupCodes[item.ParentCode]; item.ParentCode != "" && parentGroup != "" && parentGroup != item.GroupCode {
+				addError(item.Code, menus.ErrMenuGroupConstraint.Error())
+			}
+		}
+		if hasMenuCodeCycle(parentCodes, item.Code) {
+			addError(item.Code, menus.ErrMenuCycleDetected.Error())
+		}
+		if item.Path != "" {
+			if owner, ok := pathOwners[item.Path]; ok && owner != item.Code {
+				addError(item.Code, fmt.Sprintf("%s: 路径 %s 已被菜单 %s 使用", menus.ErrMenuRouteConflict.Error(), item.Path, owner))
+			} else {
+				pathOwners[item.Path] = item.Code
+			}
+		}
+		existing := plan.byCode[item.Code]
+		if item.PermissionKey != "" && (existing == nil || stringValue(existing.PermissionKey) != item.PermissionKey) {
+			err, ok := checkedKeys[item.PermissionKey]
+			if !ok {
+				err = ensurePermissionPoint(l.ctx, l.svcCtx, item.PermissionKey)
+				checkedKeys[item.PermissionKey] = err
+			}
+			if err != nil {
+				addError(item.Code, err.Error())
+			}
+		}
+	}
+
+	// 4. 计算差异：配置项按父子关系排序（父菜单在前）
+	for _, item := range orderConfigItems(config.Menus, plan.items) {
+		op := &menuImportOp{item: item, change: types.MenuImportChange{Code: item.Code}}
+		existing, ok := plan.byCode[item.Code]
+		if !ok {
+			op.change.Action = MenuImportActionCreate
+			op.change.NewParentCode = item.ParentCode
+			plan.ops = append(plan.ops, op)
+			continue
+		}
+
+		current := toMenuConfigItem(existing, plan.codeById, plan.groupCodeById)
+		before, after := current.values(), item.values()
+		changed := make([]string, 0)
+		for field, value := range after {
+			if before[field] != value {
+				changed = append(changed, field)
+			}
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		sort.Strings(changed)
+		op.menu = existing
+		op.before = before
+		op.change.MenuId = existing.Id
+		op.change.ChangedFields = changed
+		op.change.Action = MenuImportActionUpdate
+		if current.ParentCode != item.ParentCode {
+			op.change.Action = MenuImportActionMove
+			op.change.OldParentCode = current.ParentCode
+			op.change.NewParentCode = item.ParentCode
+		}
+		plan.ops = append(plan.ops, op)
+	}
+	for i := len(scope) - 1; i >= 0; i-- {
+		menu := scope[i]
+		if _, ok := plan.deleted[menu.Code]; !ok {
+			continue
+		}
+		current := toMenuConfigItem(menu, plan.codeById, plan.groupCodeById)
+		plan.ops = append(plan.ops, &menuImportOp{
+			menu:   menu,
+			before: current.values(),
+			change: types.MenuImportChange{
+				Action:        MenuImportActionDelete,
+				Code:          menu.Code,
+				MenuId:        menu.Id,
+				OldParentCode: current.ParentCode,
+			},
+		})
+	}
+	return plan
+}
+
+// applyMenuImport 按差异写入菜单（已有菜单保留原ID）
+func applyMenuImport(ctx context.Context, model menus.Model, plan *menuImportPlan) error {
+	idByCode := make(map[string]string, len(plan.byCode))
+	for code, menu := range plan.byCode {
+		idByCode[code] = menu.Id
+	}
+
+	for _, op := range plan.ops {
+		switch op.change.Action {
+		case MenuImportActionCreate:
+			menuId, _ := uuid.NewV7()
+			menu := &menus.Menu{Id: menuId.String()}
+			op.item.applyTo(menu)
+			menu.GroupId = optionalString(plan.groupIdByCode[op.item.GroupCode])
+			if op.item.ParentCode != "" {
+				menu.ParentId = optionalString(idByCode[op.item.ParentCode])
+			}
+			created, err := model.Insert(ctx, menu)
+			if err != nil {
+				return err
+			}
+			idByCode[created.Code] = created.Id
+			op.menu = created
+			op.change.MenuId = created.Id
+		case MenuImportActionUpdate, MenuImportActionMove:
+			op.item.applyTo(op.menu)
+			op.menu.GroupId = optionalString(plan.groupIdByCode[op.item.GroupCode])
+			op.menu.ParentId = nil
+			if op.item.ParentCode != "" {
+				op.menu.ParentId = optionalString(idByCode[op.item.ParentCode])
+			}
+			if err := model.Update(ctx, op.menu); err != nil {
+				return err
+			}
+		case MenuImportActionDelete:
+			if err := model.Delete(ctx, op.menu.Id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// recordImportAuditLog 记录单个导入变更的审计日志
+func (l *ImportMenusLogic) recordImportAuditLog(op *menuImportOp) error {
+	auditLogId, _ := uuid.NewV7()
+	auditLog := &menu_audit_logs.MenuAuditLog{
+		Id:            auditLogId.String(),
+		MenuId:        op.change.MenuId,
+		OperationType: op.change.Action,
+		Remark:        stringPtr(menuImportRemark),
+	}
+
+	// 获取操作人信息（从 context 中）
+	if userID, ok := l.ctx.Value(contextkeys.UserIDKey).(string); ok && userID != "" {
+		auditLog.OperatorId = &userID
+	}
+
+	switch op.change.Action {
+	case MenuImportActionCreate:
+		newValue := op.item.values()
+		newValue["id"] = op.change.MenuId
+		newValue["code"] = op.item.Code
+		newValueJSON, err := json.Marshal(newValue)
+		if err != nil {
+			return fmt.Errorf("序列化新值失败: %w", err)
+		}
+		auditLog.NewValue = datatypes.JSON(newValueJSON)
+	case MenuImportActionDelete:
+		oldValueJSON, err := json.Marshal(op.before)
+		if err != nil {
+			return fmt.Errorf("序列化旧值失败: %w", err)
+		}
+		auditLog.OldValue = datatypes.JSON(oldValueJSON)
+	default:
+		after := op.item.values()
+		oldValue := make(map[string]interface{}, len(op.change.ChangedFields))
+		newValue := make(map[string]interface{}, len(op.change.ChangedFields))
+		for _, field := range op.change.ChangedFields {
+			oldValue[field] = op.before[field]
+			newValue[field] = after[field]
+		}
+		oldValueJSON, _ := json.Marshal(oldValue)
+		newValueJSON, _ := json.Marshal(newValue)
+		changedFieldsJSON, _ := json.Marshal(op.change.ChangedFields)
+		auditLog.ChangedFields = datatypes.JSON(changedFieldsJSON)
+		auditLog.OldValue = datatypes.JSON(oldValueJSON)
+		auditLog.NewValue = datatypes.JSON(newValueJSON)
+	}
+
+	_, err := l.svcCtx.MenuAuditLogModel.Insert(l.ctx, auditLog)
+	return err
+}
+
+// hasMenuCodeCycle 判断从 code 沿父菜单向上是否回到自身
+func hasMenuCodeCycle(parentCodes map[string]string, code string) bool {
+	visited := map[string]struct{}{}
+	for current := parentCodes[code]; current != ""; current = parentCodes[current] {
+		if current == code {
+			return true
+		}
+		if _, ok := visited[current]; ok {
+			return false
+		}
+		visited[current] = struct{}{}
+	}
+	return false
+}
+
+// orderConfigItems 按父子关系排列配置项（配置中的父菜单在前，其余保持原顺序）
+func orderConfigItems(list []menuConfigItem, items map[string]*menuConfigItem) []*menuConfigItem {
+	ordered := make([]*menuConfigItem, 0, len(items))
+	visited := make(map[string]struct{}, len(items))
+	var visit func(item *menuConfigItem)
+	visit = func(item *menuConfigItem) {
+		if _, ok := visited[item.Code]; ok {
+			return
+		}
+		visited[item.Code] = struct{}{}
+		if parent, ok := items[item.ParentCode]; ok {
+			visit(parent)
+		}
+		ordered = append(ordered, item)
+	}
+	for i := range list {
+		if item, ok := items[list[i].Code]; ok && item == &list[i] {
+			visit(item)
+		}
+	}
+	return ordered
+}
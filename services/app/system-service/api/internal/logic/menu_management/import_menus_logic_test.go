@@ -0,0 +1,281 @@
+package menu_management
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_groups"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	pointmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_points"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupMenuImportTest 创建菜单导入导出测试环境（SQLite）
+// 现有菜单：system(目录) -> user_list、legacy；audit(目录，根节点)；已登记权限点 user:read
+func setupMenuImportTest(t *testing.T) (*svc.ServiceContext, *gorm.DB, *MockMenuAuditLogModel) {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	// menus、menu_groups、permission_points 使用 MySQL 专有默认值，SQLite 下手动建表
+	for _, ddl := range []string{
+		`CREATE TABLE IF NOT EXISTS menus (
+			id TEXT PRIMARY KEY, name TEXT NOT NULL, code TEXT NOT NULL, type TEXT NOT NULL,
+			group_id TEXT, parent_id TEXT, path TEXT, route_name TEXT, component_key TEXT,
+			external_url TEXT, open_mode TEXT, permission_key TEXT, icon TEXT,
+			visible INTEGER NOT NULL DEFAULT 1, enabled INTEGER NOT NULL DEFAULT 1,
+			"order" INTEGER NOT NULL DEFAULT 0, show_in_nav INTEGER NOT NULL DEFAULT 1,
+			cacheable INTEGER NOT NULL DEFAULT 0, created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			created_by TEXT, updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, updated_by TEXT,
+			deleted_at DATETIME
+		)`,
+		`CREATE TABLE IF NOT EXISTS menu_groups (
+			id TEXT PRIMARY KEY, code TEXT NOT NULL, name TEXT NOT NULL, "order" INTEGER NOT NULL DEFAULT 0,
+			icon TEXT, description TEXT, created_by TEXT NOT NULL,
+			created_at DATETIME, updated_by TEXT, updated_at DATETIME, deleted_at DATETIME
+		)`,
+		`CREATE TABLE IF NOT EXISTS permission_points (
+			id TEXT PRIMARY KEY, permission_key TEXT NOT NULL, name TEXT NOT NULL, type TEXT NOT NULL,
+			module TEXT NOT NULL, description TEXT, created_by TEXT NOT NULL,
+			created_at DATETIME, updated_by TEXT, updated_at DATETIME, deleted_at DATETIME
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	require.NoError(t, db.Create(&pointmodel.PermissionPoint{
+		Id: "point-1", PermissionKey: "user:read", Name: "查看用户", Type: pointmodel.TypeMenu, Module: "user", CreatedBy: "system",
+	}).Error)
+	for _, menu := range []*menus.Menu{
+		newNavTestMenu("system", "directory", "", "", 0),
+		newNavTestMenu("user_list", "page", "system", "user:read", 0),
+		newNavTestMenu("legacy", "page", "system", "", 1),
+		newNavTestMenu("audit", "directory", "", "", 1),
+	} {
+		if menu.Type == "page" {
+			menu.Path = testStringPtr("/" + menu.Code)
+		}
+		require.NoError(t, db.Create(menu).Error)
+	}
+
+	auditLogModel := &MockMenuAuditLogModel{}
+	return &svc.ServiceContext{
+		DB:                   db,
+		MenuModel:            menus.NewModel(db),
+		MenuGroupModel:       menu_groups.NewModel(db),
+		MenuAuditLogModel:    auditLogModel,
+		PermissionPointModel: pointmodel.NewModel(db),
+	}, db, auditLogModel
+}
+
+// exportTestMenuConfig 导出全部菜单配置
+func exportTestMenuConfig(t *testing.T, svcCtx *svc.ServiceContext) *menuConfig {
+	export, err := NewExportMenusLogic(context.Background(), svcCtx).ExportMenus(&types.ExportMenusReq{Format: MenuConfigFormatYAML})
+	require.NoError(t, err)
+	assert.Equal(t, "menus.yaml", export.Filename)
+	config, err := unmarshalMenuConfig(MenuConfigFormatYAML, export.Content)
+	require.NoError(t, err)
+	return config
+}
+
+// importTestMenuConfig 以 JSON 文件导入菜单配置
+func importTestMenuConfig(t *testing.T, svcCtx *svc.ServiceContext, config *menuConfig, dryRun bool) *types.ImportMenusResp {
+	content, err := marshalMenuConfig(MenuConfigFormatJSON, config)
+	require.NoError(t, err)
+	resp, err := NewImportMenusLogic(context.Background(), svcCtx).ImportMenus(&types.ImportMenusReq{DryRun: dryRun, Prune: true}, "menus.json", content)
+	require.NoError(t, err)
+	return resp
+}
+
+// changedTestMenuConfig 在导出配置基础上：新建 role_list、修改 user_list 名称、将 audit 移到 system 下、移除 legacy
+func changedTestMenuConfig(t *testing.T, svcCtx *svc.ServiceContext) *menuConfig {
+	config := exportTestMenuConfig(t, svcCtx)
+	items := make([]menuConfigItem, 0, len(config.Menus))
+	for _, item := range config.Menus {
+		switch item.Code {
+		case "legacy":
+			continue
+		case "user_list":
+			item.Name = "用户列表"
+		case "audit":
+			item.ParentCode = "system"
+		}
+		items = append(items, item)
+	}
+	config.Menus = append(items, menuConfigItem{
+		Code: "role_list", ParentCode: "system", Name: "角色列表", Type: "page", Path: "/roles",
+		Visible: true, Enabled: true, ShowInNav: true, Order: 2,
+	})
+	return config
+}
+
+func TestExportMenus_SubtreeKeyedByCode(t *testing.T) {
+	svcCtx, _, _ := setupMenuImportTest(t)
+
+	export, err := NewExportMenusLogic(context.Background(), svcCtx).ExportMenus(&types.ExportMenusReq{RootCode: "system", Format: MenuConfigFormatJSON})
+
+	require.NoError(t, err)
+	assert.Equal(t, "menus-system.json", export.Filename)
+	config, err := unmarshalMenuConfig(MenuConfigFormatJSON, export.Content)
+	require.NoError(t, err)
+	assert.Equal(t, "system", config.RootCode)
+	require.Len(t, config.Menus, 3)
+	assert.Equal(t, "system", config.Menus[0].Code)
+	assert.Equal(t, "user_list", config.Menus[1].Code)
+	assert.Equal(t, "system", config.Menus[1].ParentCode)
+	assert.Equal(t, "user:read", config.Menus[1].PermissionKey)
+	assert.Equal(t, "legacy", config.Menus[2].Code)
+}
+
+func TestImportMenus_DryRunReportsDiffWithoutWriting(t *testing.T) {
+	svcCtx, _, auditLogModel := setupMenuImportTest(t)
+	config := changedTestMenuConfig(t, svcCtx)
+
+	resp := importTestMenuConfig(t, svcCtx, config, true)
+
+	assert.False(t, resp.Applied)
+	assert.Empty(t, resp.Errors)
+	assert.Equal(t, 1, resp.Created)
+	assert.Equal(t, 1, resp.Updated)
+	assert.Equal(t, 1, resp.Moved)
+	assert.Equal(t, 1, resp.Deleted)
+	assert.Equal(t, 1, resp.Unchanged)
+	actions := make(map[string]types.MenuImportChange, len(resp.Changes))
+	for _, change := range resp.Changes {
+		actions[change.Code] = change
+	}
+	assert.Equal(t, MenuImportActionCreate, actions["role_list"].Action)
+	assert.Equal(t, []string{"name"}, actions["user_list"].ChangedFields)
+	assert.Equal(t, MenuImportActionMove, actions["audit"].Action)
+	assert.Equal(t, "system", actions["audit"].NewParentCode)
+	assert.Equal(t, MenuImportActionDelete, actions["legacy"].Action)
+
+	_, err := svcCtx.MenuModel.FindOneByCode(context.Background(), "role_list")
+	assert.Equal(t, menus.ErrMenuNotFound, err)
+	assert.Empty(t, auditLogModel.Logs)
+}
+
+func TestImportMenus_AppliesChangesPreservingIds(t *testing.T) {
+	svcCtx, _, auditLogModel := setupMenuImportTest(t)
+	ctx := context.Background()
+	config := changedTestMenuConfig(t, svcCtx)
+
+	resp := importTestMenuConfig(t, svcCtx, config, false)
+
+	assert.True(t, resp.Applied)
+	assert.Empty(t, resp.Errors)
+	userList, err := svcCtx.MenuModel.FindOneByCode(ctx, "user_list")
+	require.NoError(t, err)
+	assert.Equal(t, "menu-user_list", userList.Id)
+	assert.Equal(t, "用户列表", userList.Name)
+	audit, err := svcCtx.MenuModel.FindOneByCode(ctx, "audit")
+	require.NoError(t, err)
+	require.NotNil(t, audit.ParentId)
+	assert.Equal(t, "menu-system", *audit.ParentId)
+	roleList, err := svcCtx.MenuModel.FindOneByCode(ctx, "role_list")
+	require.NoError(t, err)
+	assert.Equal(t, "menu-system", *roleList.ParentId)
+	_, err = svcCtx.MenuModel.FindOneByCode(ctx, "legacy")
+	assert.Equal(t, menus.ErrMenuNotFound, err)
+
+	require.Len(t, auditLogModel.Logs, 4)
+	for _, log := range auditLogModel.Logs {
+		require.NotNil(t, log.Remark)
+		assert.Equal(t, menuImportRemark, *log.Remark)
+	}
+
+	// 再次导入相同配置没有变更
+	resp = importTestMenuConfig(t, svcCtx, config, true)
+	assert.Empty(t, resp.Changes)
+	assert.Equal(t, 4, resp.Unchanged)
+}
+
+func TestImportMenus_ValidationErrorsBlockApply(t *testing.T) {
+	svcCtx, _, auditLogModel := setupMenuImportTest(t)
+	config := &menuConfig{
+		Version: menuConfigVersion,
+		Menus: []menuConfigItem{
+			{Code: "system", Name: "系统", Type: "directory", ParentCode: "audit"},
+			{Code: "audit", Name: "审计", Type: "directory", ParentCode: "system"},
+			{Code: "no_path", Name: "缺少路径", Type: "page"},
+			{Code: "orphan", Name: "孤儿", Type: "directory", ParentCode: "missing"},
+			{Code: "dup_path", Name: "重复路径", Type: "page", Path: "/user_list"},
+			{Code: "unregistered", Name: "未登记", Type: "button", PermissionKey: "user:delete"},
+		},
+	}
+
+	content, err := marshalMenuConfig(MenuConfigFormatYAML, config)
+	require.NoError(t, err)
+	resp, err := NewImportMenusLogic(context.Background(), svcCtx).ImportMenus(&types.ImportMenusReq{}, "menus.yml", content)
+
+	require.NoError(t, err)
+	assert.False(t, resp.Applied)
+	failed := make(map[string]bool)
+	for _, e := range resp.Errors {
+		failed[e.Code] = true
+	}
+	for _, code := range []string{"system", "audit", "no_path", "orphan", "dup_path", "unregistered"} {
+		assert.True(t, failed[code], code)
+	}
+	_, err = svcCtx.MenuModel.FindOneByCode(context.Background(), "no_path")
+	assert.Equal(t, menus.ErrMenuNotFound, err)
+	assert.Empty(t, auditLogModel.Logs)
+}
+
+func TestImportMenus_GroupCode(t *testing.T) {
+	svcCtx, db, _ := setupMenuImportTest(t)
+	ctx := context.Background()
+	adminId := createTestMenuGroup(t, svcCtx, "admin", 0)
+	require.NoError(t, db.Model(&menus.Menu{}).Where("id = ?", "menu-system").Update("group_id", adminId).Error)
+
+	// 导出携带分组编码
+	config := exportTestMenuConfig(t, svcCtx)
+	groupCodes := make(map[string]string, len(config.Menus))
+	for _, item := range config.Menus {
+		groupCodes[item.Code] = item.GroupCode
+	}
+	assert.Equal(t, "admin", groupCodes["system"])
+	assert.Empty(t, groupCodes["audit"])
+
+	// 导入按分组编码设置分组
+	for i := range config.Menus {
+		if config.Menus[i].Code == "audit" {
+			config.Menus[i].GroupCode = "admin"
+		}
+	}
+	resp := importTestMenuConfig(t, svcCtx, config, false)
+	assert.True(t, resp.Applied)
+	assert.Equal(t, 1, resp.Updated)
+	audit, err := svcCtx.MenuModel.FindOneByCode(ctx, "audit")
+	require.NoError(t, err)
+	require.NotNil(t, audit.GroupId)
+	assert.Equal(t, adminId, *audit.GroupId)
+
+	// 分组不存在、与父菜单分组不一致时报错
+	createTestMenuGroup(t, svcCtx, "ops", 1)
+	for i := range config.Menus {
+		switch config.Menus[i].Code {
+		case "audit":
+			config.Menus[i].GroupCode = "missing"
+		case "user_list":
+			config.Menus[i].GroupCode = "ops"
+		}
+	}
+	resp = importTestMenuConfig(t, svcCtx, config, false)
+	assert.False(t, resp.Applied)
+	reasons := make(map[string]string, len(resp.Errors))
+	for _, e := range resp.Errors {
+		reasons[e.Code] = e.Reason
+	}
+	assert.Contains(t, reasons["audit"], menu_groups.ErrMenuGroupNotFound.Error())
+	assert.Equal(t, menus.ErrMenuGroupConstraint.Error(), reasons["user_list"])
+}
@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListMenuGroupsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListMenuGroupsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListMenuGroupsLogic {
+	return &ListMenuGroupsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListMenuGroupsLogic) ListMenuGroups() (resp *types.ListMenuGroupsResp, err error) {
+	// 1. 查询全部分组
+	groups, err := l.svcCtx.MenuGroupModel.FindAll(l.ctx)
+	if err != nil {
+		logx.Errorf("查询菜单分组列表失败: %v", err)
+		return nil, fmt.Errorf("查询菜单分组列表失败: %w", err)
+	}
+
+	// 2. 统计各分组菜单数量
+	counts, err := l.svcCtx.MenuModel.CountByGroup(l.ctx)
+	if err != nil {
+		logx.Errorf("统计分组菜单数量失败: %v", err)
+		return nil, fmt.Errorf("统计分组菜单数量失败: %w", err)
+	}
+
+	// 3. 转换为响应类型
+	resp = &types.ListMenuGroupsResp{
+		Groups: make([]types.MenuGroup, 0, len(groups)),
+	}
+	for _, group := range groups {
+		resp.Groups = append(resp.Groups, convertToMenuGroupType(group, counts[group.Id]))
+	}
+
+	return
+}
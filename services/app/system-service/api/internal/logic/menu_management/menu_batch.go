@@ -0,0 +1,171 @@
+package menu_management
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_audit_logs"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/datatypes"
+)
+
+// 批量操作模式
+const (
+	// MenuBatchModeAtomic 单个事务执行，任一菜单失败则全部回滚
+	MenuBatchModeAtomic = "atomic"
+	// MenuBatchModeBestEffort 每个菜单单独提交，失败项不影响其他菜单
+	MenuBatchModeBestEffort = "best_effort"
+)
+
+// menuBatchMaxSize 单次批量操作的菜单数量上限
+const menuBatchMaxSize = 200
+
+// menuBatchRemark 批量操作审计日志备注
+const menuBatchRemark = "批量操作"
+
+// errMenuBatchRollback atomic 模式下存在失败项时用于回滚事务
+var errMenuBatchRollback = errors.New("批量操作存在失败项")
+
+// menuBatchAudit 批量操作中单个菜单的审计内容
+type menuBatchAudit struct {
+	menuId        string
+	operationType string
+	changedFields []string
+	oldValue      map[string]interface{}
+	newValue      map[string]interface{}
+	remark        string // 为空时使用 menuBatchRemark
+}
+
+// menuBatchFunc 处理单个菜单，返回需要记录的审计内容（菜单无变化时返回空）
+type menuBatchFunc func(ctx context.Context, model menus.Model, id string) ([]menuBatchAudit, error)
+
+// runMenuBatch 逐个菜单执行 fn 并汇总每个菜单的失败原因，写入成功后为每个受影响的菜单记录审计日志
+// atomic 模式下存在失败项时不写入任何变更（success_count 为 0）
+func runMenuBatch(ctx context.Context, svcCtx *svc.ServiceContext, ids []string, mode string, fn menuBatchFunc) (*types.BatchMenuOperationResp, error) {
+	// 1. 参数校验（去重并保持请求顺序）
+	ids = uniqueMenuIds(ids)
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("菜单ID列表不能为空")
+	}
+	if len(ids) > menuBatchMaxSize {
+		return nil, menus.ErrMenuBatchTooLarge
+	}
+
+	resp := &types.BatchMenuOperationResp{
+		Errors: []types.MenuOperationError{},
+	}
+	var audits []menuBatchAudit
+
+	// 2. 执行批量操作
+	if mode == MenuBatchModeBestEffort {
+		for _, id := range ids {
+			var itemAudits []menuBatchAudit
+			err := svcCtx.MenuModel.Trans(ctx, func(ctx context.Context, model menus.Model) error {
+				var err error
+				itemAudits, err = fn(ctx, model, id)
+				return err
+			})
+			if err != nil {
+				resp.Errors = append(resp.Errors, types.MenuOperationError{Id: id, Reason: err.Error()})
+				continue
+			}
+			audits = append(audits, itemAudits...)
+		}
+		resp.FailedCount = len(resp.Errors)
+		resp.SuccessCount = len(ids) - resp.FailedCount
+		resp.Applied = resp.SuccessCount > 0
+	} else {
+		err := svcCtx.MenuModel.Trans(ctx, func(ctx context.Context, model menus.Model) error {
+			for _, id := range ids {
+				itemAudits, err := fn(ctx, model, id)
+				if err != nil {
+					resp.Errors = append(resp.Errors, types.MenuOperationError{Id: id, Reason: err.Error()})
+					continue
+				}
+				audits = append(audits, itemAudits...)
+			}
+			if len(resp.Errors) > 0 {
+				return errMenuBatchRollback
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errMenuBatchRollback) {
+			logx.Errorf("批量操作菜单失败: %v", err)
+			return nil, fmt.Errorf("批量操作菜单失败: %w", err)
+		}
+		resp.FailedCount = len(resp.Errors)
+		if err == nil {
+			resp.Applied = true
+			resp.SuccessCount = len(ids)
+		} else {
+			audits = nil
+		}
+	}
+
+	// 3. 记录审计日志（失败不影响主流程）
+	for _, audit := range audits {
+		if err := recordMenuBatchAuditLog(ctx, svcCtx, audit); err != nil {
+			logx.Errorf("记录菜单 %s 批量操作审计日志失败: %v", audit.menuId, err)
+		}
+	}
+
+	return resp, nil
+}
+
+// recordMenuBatchAuditLog 记录批量操作中单个菜单的审计日志
+func recordMenuBatchAuditLog(ctx context.Context, svcCtx *svc.ServiceContext, audit menuBatchAudit) error {
+	remark := audit.remark
+	if remark == "" {
+		remark = menuBatchRemark
+	}
+
+	auditLogId, _ := uuid.NewV7()
+	auditLog := &menu_audit_logs.MenuAuditLog{
+		Id:            auditLogId.String(),
+		MenuId:        audit.menuId,
+		OperationType: audit.operationType,
+		Remark:        stringPtr(remark),
+	}
+
+	// 获取操作人信息（从 context 中）
+	if userID, ok := ctx.Value(contextkeys.UserIDKey).(string); ok && userID != "" {
+		auditLog.OperatorId = &userID
+	}
+
+	if len(audit.changedFields) > 0 {
+		changedFieldsJSON, _ := json.Marshal(audit.changedFields)
+		auditLog.ChangedFields = datatypes.JSON(changedFieldsJSON)
+	}
+	if audit.oldValue != nil {
+		oldValueJSON, _ := json.Marshal(audit.oldValue)
+		auditLog.OldValue = datatypes.JSON(oldValueJSON)
+	}
+	if audit.newValue != nil {
+		newValueJSON, _ := json.Marshal(audit.newValue)
+		auditLog.NewValue = datatypes.JSON(newValueJSON)
+	}
+
+	_, err := svcCtx.MenuAuditLogModel.Insert(ctx, auditLog)
+	return err
+}
+
+// uniqueMenuIds 去除重复的菜单ID（保持原有顺序）
+func uniqueMenuIds(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
@@ -0,0 +1,142 @@
+package menu_management
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchToggleMenusEnabled_AtomicAndBestEffort(t *testing.T) {
+	svcCtx, _, auditLogModel := setupMenuImportTest(t)
+	ctx := context.WithValue(context.Background(), contextkeys.UserIDKey, "admin-1")
+	ids := []string{"menu-user_list", "menu-legacy", "menu-missing"}
+
+	// atomic：存在失败项时不写入
+	resp, err := NewBatchToggleMenusEnabledLogic(ctx, svcCtx).BatchToggleMenusEnabled(&types.BatchToggleMenusEnabledReq{
+		Ids: ids, Enabled: false, Mode: MenuBatchModeAtomic,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Applied)
+	assert.Equal(t, 0, resp.SuccessCount)
+	assert.Equal(t, 1, resp.FailedCount)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "menu-missing", resp.Errors[0].Id)
+	assert.Equal(t, menus.ErrMenuNotFound.Error(), resp.Errors[0].Reason)
+	userList, err := svcCtx.MenuModel.FindOne(ctx, "menu-user_list")
+	require.NoError(t, err)
+	assert.True(t, userList.Enabled)
+	assert.Empty(t, auditLogModel.Logs)
+
+	// best_effort：失败项不影响其他菜单
+	resp, err = NewBatchToggleMenusEnabledLogic(ctx, svcCtx).BatchToggleMenusEnabled(&types.BatchToggleMenusEnabledReq{
+		Ids: ids, Enabled: false, Mode: MenuBatchModeBestEffort,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Applied)
+	assert.Equal(t, 2, resp.SuccessCount)
+	assert.Equal(t, 1, resp.FailedCount)
+	userList, err = svcCtx.MenuModel.FindOne(ctx, "menu-user_list")
+	require.NoError(t, err)
+	assert.False(t, userList.Enabled)
+	require.Len(t, auditLogModel.Logs, 2)
+	for _, log := range auditLogModel.Logs {
+		assert.Equal(t, "disable", log.OperationType)
+		require.NotNil(t, log.OperatorId)
+		assert.Equal(t, "admin-1", *log.OperatorId)
+	}
+}
+
+func TestBatchDeleteMenus_Cascade(t *testing.T) {
+	svcCtx, _, auditLogModel := setupMenuImportTest(t)
+	ctx := context.Background()
+
+	// 未级联时有子菜单的菜单失败
+	resp, err := NewBatchDeleteMenusLogic(ctx, svcCtx).BatchDeleteMenus(&types.BatchDeleteMenusReq{
+		Ids: []string{"menu-audit", "menu-system"}, Mode: MenuBatchModeBestEffort,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.SuccessCount)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "menu-system", resp.Errors[0].Id)
+	assert.Equal(t, menus.ErrMenuHasChildren.Error(), resp.Errors[0].Reason)
+
+	// 级联删除：子菜单随父菜单删除，同批次再次出现时视为成功
+	resp, err = NewBatchDeleteMenusLogic(ctx, svcCtx).BatchDeleteMenus(&types.BatchDeleteMenusReq{
+		Ids: []string{"menu-system", "menu-user_list"}, Cascade: true, Mode: MenuBatchModeAtomic,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Applied)
+	assert.Equal(t, 2, resp.SuccessCount)
+	for _, code := range []string{"system", "user_list", "legacy"} {
+		_, err := svcCtx.MenuModel.FindOneByCode(ctx, code)
+		assert.Equal(t, menus.ErrMenuNotFound, err, code)
+	}
+
+	// 每个受影响菜单一条审计日志
+	require.Len(t, auditLogModel.Logs, 4)
+	deletedIds := make(map[string]bool)
+	for _, log := range auditLogModel.Logs {
+		assert.Equal(t, "delete", log.OperationType)
+		deletedIds[log.MenuId] = true
+	}
+	assert.Len(t, deletedIds, 4)
+}
+
+func TestBatchMoveMenus_AppendsAndRejectsCycle(t *testing.T) {
+	svcCtx, _, auditLogModel := setupMenuImportTest(t)
+	ctx := context.Background()
+
+	resp, err := NewBatchMoveMenusLogic(ctx, svcCtx).BatchMoveMenus(&types.BatchMoveMenusReq{
+		Ids: []string{"menu-user_list", "menu-legacy"}, NewParentId: "menu-audit", Mode: MenuBatchModeAtomic,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Applied)
+	children, err := svcCtx.MenuModel.FindChildren(ctx, "menu-audit")
+	require.NoError(t, err)
+	require.Len(t, children, 2)
+	orders := map[string]int{children[0].Code: children[0].Order, children[1].Code: children[1].Order}
+	assert.Equal(t, map[string]int{"user_list": 0, "legacy": 1}, orders)
+	require.Len(t, auditLogModel.Logs, 2)
+	assert.Equal(t, "move", auditLogModel.Logs[0].OperationType)
+
+	// 移动到自身子孙下形成循环
+	resp, err = NewBatchMoveMenusLogic(ctx, svcCtx).BatchMoveMenus(&types.BatchMoveMenusReq{
+		Ids: []string{"menu-system", "menu-audit"}, NewParentId: "menu-user_list", Mode: MenuBatchModeAtomic,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Applied)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "menu-audit", resp.Errors[0].Id)
+	assert.Equal(t, menus.ErrMenuCycleDetected.Error(), resp.Errors[0].Reason)
+	system, err := svcCtx.MenuModel.FindOne(ctx, "menu-system")
+	require.NoError(t, err)
+	assert.Nil(t, system.ParentId)
+}
+
+func TestBatchBindPermission_SkipsUnchanged(t *testing.T) {
+	svcCtx, _, auditLogModel := setupMenuImportTest(t)
+	ctx := context.Background()
+
+	_, err := NewBatchBindPermissionLogic(ctx, svcCtx).BatchBindPermission(&types.BatchBindPermissionReq{
+		Ids: []string{"menu-legacy"}, PermissionKey: "user:delete", Mode: MenuBatchModeAtomic,
+	})
+	assert.Error(t, err)
+
+	resp, err := NewBatchBindPermissionLogic(ctx, svcCtx).BatchBindPermission(&types.BatchBindPermissionReq{
+		Ids: []string{"menu-legacy", "menu-user_list", "menu-legacy"}, PermissionKey: "user:read", Mode: MenuBatchModeAtomic,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.SuccessCount)
+	legacy, err := svcCtx.MenuModel.FindOne(ctx, "menu-legacy")
+	require.NoError(t, err)
+	require.NotNil(t, legacy.PermissionKey)
+	assert.Equal(t, "user:read", *legacy.PermissionKey)
+	require.Len(t, auditLogModel.Logs, 1)
+	assert.Equal(t, "menu-legacy", auditLogModel.Logs[0].MenuId)
+}
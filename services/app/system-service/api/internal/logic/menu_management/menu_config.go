@@ -0,0 +1,200 @@
+package menu_management
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 菜单配置文件格式
+const (
+	MenuConfigFormatJSON = "json"
+	MenuConfigFormatYAML = "yaml"
+)
+
+// menuConfigVersion 菜单配置文件格式版本
+const menuConfigVersion = 1
+
+// menuConfig 菜单配置文件
+// 菜单以 code 标识、以 parent_code 关联父子关系、以 group_code 关联分组，不包含菜单ID、分组ID等随环境变化的字段
+type menuConfig struct {
+	Version  int              `json:"version" yaml:"version"`
+	RootCode string           `json:"root_code,omitempty" yaml:"root_code,omitempty"` // 导出子树时的根菜单编码，导入时限定范围
+	Menus    []menuConfigItem `json:"menus" yaml:"menus"`                             // 父菜单在前
+}
+
+// menuConfigItem 菜单配置项
+type menuConfigItem struct {
+	Code          string `json:"code" yaml:"code"`
+	ParentCode    string `json:"parent_code,omitempty" yaml:"parent_code,omitempty"`
+	GroupCode     string `json:"group_code,omitempty" yaml:"group_code,omitempty"`
+	Name          string `json:"name" yaml:"name"`
+	Type          string `json:"type" yaml:"type"`
+	Path          string `json:"path,omitempty" yaml:"path,omitempty"`
+	RouteName     string `json:"route_name,omitempty" yaml:"route_name,omitempty"`
+	ComponentKey  string `json:"component_key,omitempty" yaml:"component_key,omitempty"`
+	ExternalUrl   string `json:"external_url,omitempty" yaml:"external_url,omitempty"`
+	OpenMode      string `json:"open_mode,omitempty" yaml:"open_mode,omitempty"`
+	PermissionKey string `json:"permission_key,omitempty" yaml:"permission_key,omitempty"`
+	Icon          string `json:"icon,omitempty" yaml:"icon,omitempty"`
+	Visible       bool   `json:"visible" yaml:"visible"`
+	Enabled       bool   `json:"enabled" yaml:"enabled"`
+	Order         int    `json:"order" yaml:"order"`
+	ShowInNav     bool   `json:"show_in_nav" yaml:"show_in_nav"`
+	Cacheable     bool   `json:"cacheable" yaml:"cacheable"`
+}
+
+// toMenuConfigItem 将菜单转换为配置项，codeById、groupCodeById 用于将父菜单ID、分组ID转换为编码
+func toMenuConfigItem(menu *menus.Menu, codeById, groupCodeById map[string]string) menuConfigItem {
+	item := menuConfigItem{
+		Code:          menu.Code,
+		Name:          menu.Name,
+		Type:          menu.Type,
+		Path:          stringValue(menu.Path),
+		RouteName:     stringValue(menu.RouteName),
+		ComponentKey:  stringValue(menu.ComponentKey),
+		ExternalUrl:   stringValue(menu.ExternalUrl),
+		OpenMode:      stringValue(menu.OpenMode),
+		PermissionKey: stringValue(menu.PermissionKey),
+		Icon:          stringValue(menu.Icon),
+		Visible:       menu.Visible,
+		Enabled:       menu.Enabled,
+		Order:         menu.Order,
+		ShowInNav:     menu.ShowInNav,
+		Cacheable:     menu.Cacheable,
+	}
+	if menu.ParentId != nil {
+		item.ParentCode = codeById[*menu.ParentId]
+	}
+	if menu.GroupId != nil {
+		item.GroupCode = groupCodeById[*menu.GroupId]
+	}
+	return item
+}
+
+// values 返回配置项的字段值（用于差异计算和审计日志）
+func (i *menuConfigItem) values() map[string]interface{} {
+	return map[string]interface{}{
+		"parent_code":    i.ParentCode,
+		"group_code":     i.GroupCode,
+		"name":           i.Name,
+		"type":           i.Type,
+		"path":           i.Path,
+		"route_name":     i.RouteName,
+		"component_key":  i.ComponentKey,
+		"external_url":   i.ExternalUrl,
+		"open_mode":      i.OpenMode,
+		"permission_key": i.PermissionKey,
+		"icon":           i.Icon,
+		"visible":        i.Visible,
+		"enabled":        i.Enabled,
+		"order":          i.Order,
+		"show_in_nav":    i.ShowInNav,
+		"cacheable":      i.Cacheable,
+	}
+}
+
+// createReq 转换为创建菜单请求，用于复用创建菜单的类型字段校验
+func (i *menuConfigItem) createReq() *types.CreateMenuReq {
+	return &types.CreateMenuReq{
+		Name:          i.Name,
+		Code:          i.Code,
+		Type:          i.Type,
+		Path:          i.Path,
+		RouteName:     i.RouteName,
+		ComponentKey:  i.ComponentKey,
+		ExternalUrl:   i.ExternalUrl,
+		OpenMode:      i.OpenMode,
+		PermissionKey: i.PermissionKey,
+		Icon:          i.Icon,
+		Visible:       i.Visible,
+		Enabled:       i.Enabled,
+		Order:         i.Order,
+		ShowInNav:     i.ShowInNav,
+		Cacheable:     i.Cacheable,
+	}
+}
+
+// applyTo 将配置项写入菜单实体（父菜单ID、分组ID由调用方设置）
+func (i *menuConfigItem) applyTo(menu *menus.Menu) {
+	menu.Code = i.Code
+	menu.Name = i.Name
+	menu.Type = i.Type
+	menu.Path = optionalString(i.Path)
+	menu.RouteName = optionalString(i.RouteName)
+	menu.ComponentKey = optionalString(i.ComponentKey)
+	menu.ExternalUrl = optionalString(i.ExternalUrl)
+	menu.OpenMode = optionalString(i.OpenMode)
+	menu.PermissionKey = optionalString(i.PermissionKey)
+	menu.Icon = optionalString(i.Icon)
+	menu.Visible = i.Visible
+	menu.Enabled = i.Enabled
+	menu.Order = i.Order
+	menu.ShowInNav = i.ShowInNav
+	menu.Cacheable = i.Cacheable
+}
+
+// menuConfigFormat 解析配置文件格式，未指定时按文件扩展名识别（默认 json）
+func menuConfigFormat(format, filename string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(filename)) {
+		case ".yaml", ".yml":
+			format = MenuConfigFormatYAML
+		default:
+			format = MenuConfigFormatJSON
+		}
+	}
+	if format != MenuConfigFormatJSON && format != MenuConfigFormatYAML {
+		return "", fmt.Errorf("配置文件格式必须是 json 或 yaml")
+	}
+	return format, nil
+}
+
+// marshalMenuConfig 序列化菜单配置
+func marshalMenuConfig(format string, config *menuConfig) ([]byte, error) {
+	if format == MenuConfigFormatYAML {
+		return yaml.Marshal(config)
+	}
+	return json.MarshalIndent(config, "", "  ")
+}
+
+// unmarshalMenuConfig 解析菜单配置
+func unmarshalMenuConfig(format string, content []byte) (*menuConfig, error) {
+	config := &menuConfig{}
+	var err error
+	if format == MenuConfigFormatYAML {
+		err = yaml.Unmarshal(content, config)
+	} else {
+		err = json.Unmarshal(content, config)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if config.Version > menuConfigVersion {
+		return nil, fmt.Errorf("不支持的配置文件版本: %d", config.Version)
+	}
+	return config, nil
+}
+
+// stringValue 返回字符串指针的值，nil 时返回空字符串
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// optionalString 空字符串转为 nil
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,50 @@
+package menu_management
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_groups"
+)
+
+// ensureMenuGroup 校验菜单分组存在
+func ensureMenuGroup(ctx context.Context, svcCtx *svc.ServiceContext, groupId string) error {
+	_, err := svcCtx.MenuGroupModel.FindOne(ctx, groupId)
+	return err
+}
+
+// loadMenuGroupCodes 查询全部菜单分组，返回 ID -> 编码 与 编码 -> ID 映射（用于菜单配置导入导出）
+func loadMenuGroupCodes(ctx context.Context, svcCtx *svc.ServiceContext) (map[string]string, map[string]string, error) {
+	groups, err := svcCtx.MenuGroupModel.FindAll(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	codeById := make(map[string]string, len(groups))
+	idByCode := make(map[string]string, len(groups))
+	for _, group := range groups {
+		codeById[group.Id] = group.Code
+		idByCode[group.Code] = group.Id
+	}
+	return codeById, idByCode, nil
+}
+
+// convertToMenuGroupType 将菜单分组实体转换为 API 类型
+func convertToMenuGroupType(group *menu_groups.MenuGroup, menuCount int64) types.MenuGroup {
+	item := types.MenuGroup{
+		Id:        group.Id,
+		Code:      group.Code,
+		Name:      group.Name,
+		Order:     group.Order,
+		MenuCount: menuCount,
+		CreatedAt: group.CreatedAt.Format("2006-01-02 15:04:05.000"),
+		UpdatedAt: group.UpdatedAt.Format("2006-01-02 15:04:05.000"),
+	}
+	if group.Icon != nil {
+		item.Icon = *group.Icon
+	}
+	if group.Description != nil {
+		item.Description = *group.Description
+	}
+	return item
+}
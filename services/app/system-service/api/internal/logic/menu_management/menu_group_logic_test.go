@@ -0,0 +1,169 @@
+package menu_management
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_groups"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupMenuGroupTest 创建菜单分组测试环境（SQLite）
+func setupMenuGroupTest(t *testing.T) (*svc.ServiceContext, *gorm.DB, *MockMenuAuditLogModel) {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	// menus、menu_groups 使用 MySQL 专有默认值，SQLite 下手动建表
+	for _, ddl := range []string{
+		`CREATE TABLE IF NOT EXISTS menus (
+			id TEXT PRIMARY KEY, name TEXT NOT NULL, code TEXT NOT NULL, type TEXT NOT NULL,
+			group_id TEXT, parent_id TEXT, path TEXT, route_name TEXT, component_key TEXT,
+			external_url TEXT, open_mode TEXT, permission_key TEXT, icon TEXT,
+			visible INTEGER NOT NULL DEFAULT 1, enabled INTEGER NOT NULL DEFAULT 1,
+			"order" INTEGER NOT NULL DEFAULT 0, show_in_nav INTEGER NOT NULL DEFAULT 1,
+			cacheable INTEGER NOT NULL DEFAULT 0, created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			created_by TEXT, updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, updated_by TEXT,
+			deleted_at DATETIME
+		)`,
+		`CREATE TABLE IF NOT EXISTS menu_groups (
+			id TEXT PRIMARY KEY, code TEXT NOT NULL, name TEXT NOT NULL, "order" INTEGER NOT NULL DEFAULT 0,
+			icon TEXT, description TEXT, created_by TEXT NOT NULL,
+			created_at DATETIME, updated_by TEXT, updated_at DATETIME, deleted_at DATETIME
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	auditLogModel := &MockMenuAuditLogModel{}
+	return &svc.ServiceContext{
+		DB:                db,
+		MenuModel:         menus.NewModel(db),
+		MenuAuditLogModel: auditLogModel,
+		MenuGroupModel:    menu_groups.NewModel(db),
+	}, db, auditLogModel
+}
+
+// createTestMenuGroup 通过接口逻辑创建菜单分组
+func createTestMenuGroup(t *testing.T, svcCtx *svc.ServiceContext, code string, order int) string {
+	resp, err := NewCreateMenuGroupLogic(context.Background(), svcCtx).CreateMenuGroup(&types.CreateMenuGroupReq{
+		Code:  code,
+		Name:  code,
+		Order: order,
+	})
+	require.NoError(t, err)
+	return resp.Id
+}
+
+// createGroupedTestMenu 在分组下创建根页面菜单
+func createGroupedTestMenu(t *testing.T, db *gorm.DB, code, groupId string) {
+	menu := newNavTestMenu(code, "page", "", "", 0)
+	menu.Path = testStringPtr("/" + code)
+	menu.GroupId = testStringPtr(groupId)
+	require.NoError(t, db.Create(menu).Error)
+}
+
+func TestMenuGroups_CRUDWithMenuCounts(t *testing.T) {
+	svcCtx, db, _ := setupMenuGroupTest(t)
+	ctx := context.Background()
+	opsId := createTestMenuGroup(t, svcCtx, "ops", 2)
+	adminId := createTestMenuGroup(t, svcCtx, "admin", 1)
+	createGroupedTestMenu(t, db, "user_list", adminId)
+	createGroupedTestMenu(t, db, "role_list", adminId)
+	createGroupedTestMenu(t, db, "monitor", opsId)
+
+	// 分组编码唯一
+	_, err := NewCreateMenuGroupLogic(ctx, svcCtx).CreateMenuGroup(&types.CreateMenuGroupReq{Code: "ops", Name: "运维"})
+	assert.Equal(t, menu_groups.ErrMenuGroupCodeExists, err)
+
+	// 列表按排序号升序并带菜单数量
+	list, err := NewListMenuGroupsLogic(ctx, svcCtx).ListMenuGroups()
+	require.NoError(t, err)
+	require.Len(t, list.Groups, 2)
+	assert.Equal(t, "admin", list.Groups[0].Code)
+	assert.Equal(t, int64(2), list.Groups[0].MenuCount)
+	assert.Equal(t, "ops", list.Groups[1].Code)
+	assert.Equal(t, int64(1), list.Groups[1].MenuCount)
+
+	// 更新后详情反映新值
+	_, err = NewUpdateMenuGroupLogic(ctx, svcCtx).UpdateMenuGroup(&types.UpdateMenuGroupReq{
+		Id: opsId, Name: "运维中心", Order: 0, Icon: "Monitor",
+	})
+	require.NoError(t, err)
+	detail, err := NewGetMenuGroupLogic(ctx, svcCtx).GetMenuGroup(&types.GetMenuGroupReq{Id: opsId})
+	require.NoError(t, err)
+	assert.Equal(t, "ops", detail.Data.Code)
+	assert.Equal(t, "运维中心", detail.Data.Name)
+	assert.Equal(t, "Monitor", detail.Data.Icon)
+	assert.Equal(t, int64(1), detail.Data.MenuCount)
+
+	// 分组范围统计
+	stats, err := NewGetMenuStatsLogic(ctx, svcCtx).GetMenuStats(&types.GetMenuStatsReq{GroupId: adminId})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.Total)
+	stats, err = NewGetMenuStatsLogic(ctx, svcCtx).GetMenuStats(&types.GetMenuStatsReq{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stats.Total)
+}
+
+func TestMenuGroups_UnknownGroupIsRejected(t *testing.T) {
+	svcCtx, _, _ := setupMenuGroupTest(t)
+	ctx := context.Background()
+
+	_, err := NewCreateMenuLogic(ctx, svcCtx).CreateMenu(&types.CreateMenuReq{
+		Name: "用户列表", Code: "user_list", Type: "page", Path: "/users", GroupId: "missing",
+	})
+	assert.Equal(t, menu_groups.ErrMenuGroupNotFound, err)
+	_, err = svcCtx.MenuModel.FindOneByCode(ctx, "user_list")
+	assert.Equal(t, menus.ErrMenuNotFound, err)
+
+	_, err = NewGetMenuTreeLogic(ctx, svcCtx).GetMenuTree(&types.GetMenuTreeReq{GroupId: "missing"})
+	assert.Equal(t, menu_groups.ErrMenuGroupNotFound, err)
+
+	_, err = NewGetMenuStatsLogic(ctx, svcCtx).GetMenuStats(&types.GetMenuStatsReq{GroupId: "missing"})
+	assert.Equal(t, menu_groups.ErrMenuGroupNotFound, err)
+}
+
+func TestDeleteMenuGroup_ReassignsMenus(t *testing.T) {
+	svcCtx, db, auditLogModel := setupMenuGroupTest(t)
+	ctx := context.Background()
+	adminId := createTestMenuGroup(t, svcCtx, "admin", 1)
+	opsId := createTestMenuGroup(t, svcCtx, "ops", 2)
+	createGroupedTestMenu(t, db, "user_list", adminId)
+	createGroupedTestMenu(t, db, "role_list", adminId)
+	createGroupedTestMenu(t, db, "monitor", opsId)
+
+	// 不能改挂到被删除的分组自身
+	_, err := NewDeleteMenuGroupLogic(ctx, svcCtx).DeleteMenuGroup(&types.DeleteMenuGroupReq{Id: adminId, ReassignTo: adminId})
+	assert.Equal(t, menu_groups.ErrMenuGroupReassignInvalid, err)
+
+	// 改挂到 ops
+	resp, err := NewDeleteMenuGroupLogic(ctx, svcCtx).DeleteMenuGroup(&types.DeleteMenuGroupReq{Id: adminId, ReassignTo: opsId})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.ReassignedMenus)
+	_, err = svcCtx.MenuGroupModel.FindOne(ctx, adminId)
+	assert.Equal(t, menu_groups.ErrMenuGroupNotFound, err)
+	userList, err := svcCtx.MenuModel.FindOneByCode(ctx, "user_list")
+	require.NoError(t, err)
+	require.NotNil(t, userList.GroupId)
+	assert.Equal(t, opsId, *userList.GroupId)
+	require.Len(t, auditLogModel.Logs, 2)
+
+	// 未指定目标分组时清空菜单分组
+	resp, err = NewDeleteMenuGroupLogic(ctx, svcCtx).DeleteMenuGroup(&types.DeleteMenuGroupReq{Id: opsId})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp.ReassignedMenus)
+	monitor, err := svcCtx.MenuModel.FindOneByCode(ctx, "monitor")
+	require.NoError(t, err)
+	assert.Nil(t, monitor.GroupId)
+}
@@ -0,0 +1,47 @@
+package menu_management
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/permission_point"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	pointmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_points"
+)
+
+// defaultPermissionModule 菜单新建权限点的默认所属模块
+const defaultPermissionModule = "menu"
+
+// ensurePermissionPoint 校验权限标识已登记为权限点
+func ensurePermissionPoint(ctx context.Context, svcCtx *svc.ServiceContext, permissionKey string) error {
+	_, err := svcCtx.PermissionPointModel.FindOneByKey(ctx, permissionKey)
+	return err
+}
+
+// createMenuPermission 为菜单创建权限点并返回权限标识
+// 权限标识缺省为 menu:<code>，所属模块缺省为 menu；按钮菜单创建按钮类型权限点，其余为菜单类型
+func createMenuPermission(ctx context.Context, svcCtx *svc.ServiceContext, menu *menus.Menu, permissionKey, permissionName, module string) (string, error) {
+	if permissionKey == "" {
+		permissionKey = fmt.Sprintf("menu:%s", menu.Code)
+	}
+	if module == "" {
+		module = defaultPermissionModule
+	}
+	pointType := pointmodel.TypeMenu
+	if menu.Type == "button" {
+		pointType = pointmodel.TypeButton
+	}
+
+	_, err := permission_point.NewCreatePermissionPointLogic(ctx, svcCtx).CreatePermissionPoint(&types.CreatePermissionPointReq{
+		PermissionKey: permissionKey,
+		Name:          permissionName,
+		Type:          pointType,
+		Module:        module,
+	})
+	if err != nil {
+		return "", err
+	}
+	return permissionKey, nil
+}
@@ -0,0 +1,352 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_audit_logs"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/datatypes"
+)
+
+type RevertMenuLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRevertMenuLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevertMenuLogic {
+	return &RevertMenuLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// RevertMenu 将菜单恢复到审计记录捕获的状态
+// 记录了旧值的操作恢复到操作前的状态；创建记录没有旧值，恢复到创建时的状态；已删除的菜单同时取消删除
+func (l *RevertMenuLogic) RevertMenu(req *types.RevertMenuReq) (resp *types.RevertMenuResp, err error) {
+	// 1. 查询菜单（包括已删除）
+	existingMenu, err := l.svcCtx.MenuModel.FindOneIncludingDeleted(l.ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	deleted := existingMenu.DeletedAt.Valid
+
+	// 2. 查询审计记录并校验属于该菜单
+	auditLog, err := l.svcCtx.MenuAuditLogModel.FindOne(l.ctx, req.AuditId)
+	if err != nil {
+		return nil, err
+	}
+	if auditLog.MenuId != existingMenu.Id {
+		return nil, menus.ErrMenuRevertInvalid
+	}
+
+	// 3. 解析审计记录捕获的状态
+	snapshot, err := menuAuditSnapshot(auditLog)
+	if err != nil {
+		logx.Errorf("解析审计记录失败: id=%s, error=%v", auditLog.Id, err)
+		return nil, menus.ErrMenuRevertInvalid
+	}
+	if len(snapshot) == 0 && !deleted {
+		return nil, menus.ErrMenuRevertInvalid
+	}
+	// 导入记录以父菜单编码表示父子关系，转换为父菜单ID
+	if value, ok := snapshot["parent_code"]; ok {
+		delete(snapshot, "parent_code")
+		parentId := ""
+		if parentCode := snapshotString(value); parentCode != "" {
+			parentMenu, err := l.svcCtx.MenuModel.FindOneByCode(l.ctx, parentCode)
+			if err == menus.ErrMenuNotFound {
+				return nil, menus.ErrMenuRevertParentMissing
+			}
+			if err != nil {
+				logx.Errorf("查询父菜单失败: %v", err)
+				return nil, fmt.Errorf("查询父菜单失败: %w", err)
+			}
+			parentId = parentMenu.Id
+		}
+		snapshot["parent_id"] = parentId
+	}
+
+	// 4. 应用捕获的状态
+	updateLogic := NewUpdateMenuLogic(l.ctx, l.svcCtx)
+	oldValueMap := updateLogic.menuToMap(existingMenu)
+	restored := *existingMenu
+	applyMenuSnapshot(&restored, snapshot)
+	newValueMap := updateLogic.menuToMap(&restored)
+	changedFields := diffMenuValues(oldValueMap, newValueMap)
+
+	// 5. 校验恢复后的菜单不会产生冲突
+	if err := l.validateRevert(existingMenu, &restored, deleted); err != nil {
+		return nil, err
+	}
+
+	// 6. 保存（已删除的菜单同时取消删除）
+	if deleted {
+		err = l.svcCtx.MenuModel.Restore(l.ctx, &restored)
+	} else if len(changedFields) > 0 {
+		err = l.svcCtx.MenuModel.Update(l.ctx, &restored)
+	}
+	if err != nil {
+		logx.Errorf("恢复菜单失败: %v", err)
+		return nil, fmt.Errorf("恢复菜单失败: %w", err)
+	}
+
+	// 7. 记录恢复审计日志
+	if deleted || len(changedFields) > 0 {
+		if err := l.recordRevertAuditLog(&restored, auditLog.Id, changedFields, oldValueMap, newValueMap, deleted); err != nil {
+			logx.Errorf("记录恢复审计日志失败: %v", err)
+			// 审计日志失败不影响主流程，只记录错误
+		}
+	}
+
+	return &types.RevertMenuResp{
+		Menu:          updateLogic.convertMenuToType(&restored),
+		ChangedFields: changedFields,
+		Undeleted:     deleted,
+	}, nil
+}
+
+// validateRevert 校验恢复后的菜单：类型字段、编码与路由冲突、父菜单存在且不形成循环、分组与权限点存在
+// 已删除的菜单恢复时所有字段都需要重新校验，其余只校验发生变化的字段
+func (l *RevertMenuLogic) validateRevert(existingMenu, restored *menus.Menu, deleted bool) error {
+	changed := func(before, after *string) bool {
+		return deleted || stringValue(before) != stringValue(after)
+	}
+
+	// 1. 类型相关必填字段
+	item := toMenuConfigItem(restored, nil, nil)
+	if err := NewCreateMenuLogic(l.ctx, l.svcCtx).validateTypeFields(item.createReq()); err != nil {
+		return err
+	}
+
+	// 2. code 唯一性
+	if changed(&existingMenu.Code, &restored.Code) {
+		sameCode, err := l.svcCtx.MenuModel.FindOneByCode(l.ctx, restored.Code)
+		if err != nil && err != menus.ErrMenuNotFound {
+			logx.Errorf("检查菜单编码唯一性失败: %v", err)
+			return fmt.Errorf("检查菜单编码唯一性失败: %w", err)
+		}
+		if sameCode != nil && sameCode.Id != restored.Id {
+			return menus.ErrMenuCodeExists
+		}
+	}
+
+	// 3. path 冲突
+	if restored.Path != nil && *restored.Path != "" && changed(existingMenu.Path, restored.Path) {
+		conflictingMenus, err := l.svcCtx.MenuModel.FindByPath(l.ctx, *restored.Path)
+		if err != nil {
+			logx.Errorf("检查路径冲突失败: %v", err)
+			return fmt.Errorf("检查路径冲突失败: %w", err)
+		}
+		for _, menu := range conflictingMenus {
+			if menu.Id != restored.Id {
+				return menus.ErrMenuRouteConflict
+			}
+		}
+	}
+
+	// 4. 父菜单存在、不形成循环且满足分组约束
+	if restored.ParentId != nil && (changed(existingMenu.ParentId, restored.ParentId) || changed(existingMenu.GroupId, restored.GroupId)) {
+		parentMenu, err := l.svcCtx.MenuModel.FindOne(l.ctx, *restored.ParentId)
+		if err == menus.ErrMenuNotFound {
+			return menus.ErrMenuRevertParentMissing
+		}
+		if err != nil {
+			logx.Errorf("查询父菜单失败: %v", err)
+			return fmt.Errorf("查询父菜单失败: %w", err)
+		}
+		hasCycle, err := l.svcCtx.MenuModel.CheckCycle(l.ctx, restored.Id, parentMenu.Id)
+		if err != nil {
+			logx.Errorf("检查循环引用失败: %v", err)
+			return fmt.Errorf("检查循环引用失败: %w", err)
+		}
+		if hasCycle {
+			return menus.ErrMenuCycleDetected
+		}
+		if restored.GroupId != nil && parentMenu.GroupId != nil && *parentMenu.GroupId != *restored.GroupId {
+			return menus.ErrMenuGroupConstraint
+		}
+	}
+
+	// 5. 分组存在
+	if restored.GroupId != nil && changed(existingMenu.GroupId, restored.GroupId) {
+		if err := ensureMenuGroup(l.ctx, l.svcCtx, *restored.GroupId); err != nil {
+			return err
+		}
+	}
+
+	// 6. 权限标识已登记为权限点
+	if restored.PermissionKey != nil && changed(existingMenu.PermissionKey, restored.PermissionKey) {
+		if err := ensurePermissionPoint(l.ctx, l.svcCtx, *restored.PermissionKey); err != nil {
+			logx.Errorf("校验权限标识失败: key=%s, error=%v", *restored.PermissionKey, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordRevertAuditLog 记录恢复审计日志
+func (l *RevertMenuLogic) recordRevertAuditLog(menu *menus.Menu, auditId string, changedFields []string, oldValueMap, newValueMap map[string]interface{}, undeleted bool) error {
+	auditLogId, _ := uuid.NewV7()
+
+	oldValueJSONMap := make(map[string]interface{}, len(changedFields))
+	newValueJSONMap := make(map[string]interface{}, len(changedFields))
+	for _, field := range changedFields {
+		oldValueJSONMap[field] = oldValueMap[field]
+		newValueJSONMap[field] = newValueMap[field]
+	}
+	oldValueJSON, _ := json.Marshal(oldValueJSONMap)
+	newValueJSON, _ := json.Marshal(newValueJSONMap)
+	changedFieldsJSON, _ := json.Marshal(changedFields)
+
+	remark := fmt.Sprintf("恢复至审计记录 %s", auditId)
+	if undeleted {
+		remark += "（取消删除）"
+	}
+	auditLog := &menu_audit_logs.MenuAuditLog{
+		Id:            auditLogId.String(),
+		MenuId:        menu.Id,
+		OperationType: "revert",
+		ChangedFields: datatypes.JSON(changedFieldsJSON),
+		OldValue:      datatypes.JSON(oldValueJSON),
+		NewValue:      datatypes.JSON(newValueJSON),
+		Remark:        &remark,
+	}
+
+	// 获取操作人信息（从 context 中）
+	if userID, ok := l.ctx.Value(contextkeys.UserIDKey).(string); ok && userID != "" {
+		auditLog.OperatorId = &userID
+	}
+
+	_, err := l.svcCtx.MenuAuditLogModel.Insert(l.ctx, auditLog)
+	return err
+}
+
+// menuAuditSnapshot 解析审计记录捕获的菜单状态（字段 -> 值）
+// 记录了变更字段时取这些字段的旧值（旧值缺失表示操作前为空）；否则取旧值快照（删除记录），没有旧值时取新值（创建记录）
+func menuAuditSnapshot(log *menu_audit_logs.MenuAuditLog) (map[string]interface{}, error) {
+	var changedFields []string
+	oldValue := map[string]interface{}{}
+	newValue := map[string]interface{}{}
+	for _, item := range []struct {
+		data   datatypes.JSON
+		target interface{}
+	}{
+		{log.ChangedFields, &changedFields},
+		{log.OldValue, &oldValue},
+		{log.NewValue, &newValue},
+	} {
+		if len(item.data) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(item.data, item.target); err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := make(map[string]interface{})
+	switch {
+	case len(changedFields) > 0:
+		for _, field := range changedFields {
+			snapshot[field] = oldValue[field]
+		}
+	case len(oldValue) > 0:
+		snapshot = oldValue
+	default:
+		snapshot = newValue
+	}
+	delete(snapshot, "id")
+	return snapshot, nil
+}
+
+// applyMenuSnapshot 将捕获的字段值写入菜单实体（未知字段忽略）
+func applyMenuSnapshot(menu *menus.Menu, snapshot map[string]interface{}) {
+	for field, value := range snapshot {
+		switch field {
+		case "name":
+			if name := snapshotString(value); name != "" {
+				menu.Name = name
+			}
+		case "code":
+			if code := snapshotString(value); code != "" {
+				menu.Code = code
+			}
+		case "type":
+			if menuType := snapshotString(value); menuType != "" {
+				menu.Type = menuType
+			}
+		case "group_id":
+			menu.GroupId = optionalString(snapshotString(value))
+		case "parent_id":
+			menu.ParentId = optionalString(snapshotString(value))
+		case "path":
+			menu.Path = optionalString(snapshotString(value))
+		case "route_name":
+			menu.RouteName = optionalString(snapshotString(value))
+		case "component_key":
+			menu.ComponentKey = optionalString(snapshotString(value))
+		case "external_url":
+			menu.ExternalUrl = optionalString(snapshotString(value))
+		case "open_mode":
+			menu.OpenMode = optionalString(snapshotString(value))
+		case "permission_key":
+			menu.PermissionKey = optionalString(snapshotString(value))
+		case "icon":
+			menu.Icon = optionalString(snapshotString(value))
+		case "visible":
+			menu.Visible = snapshotBool(value)
+		case "enabled":
+			menu.Enabled = snapshotBool(value)
+		case "show_in_nav":
+			menu.ShowInNav = snapshotBool(value)
+		case "cacheable":
+			menu.Cacheable = snapshotBool(value)
+		case "order":
+			if order, ok := value.(float64); ok {
+				menu.Order = int(order)
+			}
+		}
+	}
+}
+
+// diffMenuValues 返回两份菜单字段值之间发生变化的字段（按字段名排序）
+func diffMenuValues(before, after map[string]interface{}) []string {
+	changedFields := []string{}
+	for field, value := range after {
+		if before[field] != value {
+			changedFields = append(changedFields, field)
+		}
+	}
+	for field := range before {
+		if _, ok := after[field]; !ok {
+			changedFields = append(changedFields, field)
+		}
+	}
+	sort.Strings(changedFields)
+	return changedFields
+}
+
+// snapshotString 读取字符串字段值（null 视为空字符串）
+func snapshotString(value interface{}) string {
+	s, _ := value.(string)
+	return s
+}
+
+// snapshotBool 读取布尔字段值
+func snapshotBool(value interface{}) bool {
+	b, _ := value.(bool)
+	return b
+}
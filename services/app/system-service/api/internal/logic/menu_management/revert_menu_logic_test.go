@@ -0,0 +1,124 @@
+package menu_management
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_audit_logs"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+// addTestAuditLog 写入一条菜单审计记录
+func addTestAuditLog(t *testing.T, auditLogModel *MockMenuAuditLogModel, id, menuId, operationType string, changedFields []string, oldValue, newValue map[string]interface{}) {
+	log := &menu_audit_logs.MenuAuditLog{Id: id, MenuId: menuId, OperationType: operationType}
+	if changedFields != nil {
+		data, err := json.Marshal(changedFields)
+		require.NoError(t, err)
+		log.ChangedFields = datatypes.JSON(data)
+	}
+	if oldValue != nil {
+		data, err := json.Marshal(oldValue)
+		require.NoError(t, err)
+		log.OldValue = datatypes.JSON(data)
+	}
+	if newValue != nil {
+		data, err := json.Marshal(newValue)
+		require.NoError(t, err)
+		log.NewValue = datatypes.JSON(data)
+	}
+	auditLogModel.Logs = append(auditLogModel.Logs, log)
+}
+
+func TestRevertMenu_RestoresChangedFields(t *testing.T) {
+	svcCtx, _, auditLogModel := setupMenuImportTest(t)
+	ctx := context.WithValue(context.Background(), contextkeys.UserIDKey, "admin-1")
+	// 记录：user_list 名称由“用户管理”改为 user_list，同时移动到 system 下第 0 位
+	addTestAuditLog(t, auditLogModel, "audit-1", "menu-user_list", "update",
+		[]string{"name", "icon"}, map[string]interface{}{"name": "用户管理"}, map[string]interface{}{"name": "user_list"})
+	addTestAuditLog(t, auditLogModel, "audit-2", "menu-user_list", "move",
+		[]string{"parent_id", "order"}, map[string]interface{}{"parent_id": nil, "order": 5}, map[string]interface{}{"parent_id": "menu-system", "order": 0})
+
+	resp, err := NewRevertMenuLogic(ctx, svcCtx).RevertMenu(&types.RevertMenuReq{Id: "menu-user_list", AuditId: "audit-1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name"}, resp.ChangedFields)
+	assert.False(t, resp.Undeleted)
+	assert.Equal(t, "用户管理", resp.Menu.Name)
+
+	resp, err = NewRevertMenuLogic(ctx, svcCtx).RevertMenu(&types.RevertMenuReq{Id: "menu-user_list", AuditId: "audit-2"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"order", "parent_id"}, resp.ChangedFields)
+	userList, err := svcCtx.MenuModel.FindOne(ctx, "menu-user_list")
+	require.NoError(t, err)
+	assert.Nil(t, userList.ParentId)
+	assert.Equal(t, 5, userList.Order)
+
+	// 恢复操作本身记录审计日志
+	require.Len(t, auditLogModel.Logs, 4)
+	revertLog := auditLogModel.Logs[3]
+	assert.Equal(t, "revert", revertLog.OperationType)
+	require.NotNil(t, revertLog.Remark)
+	assert.Contains(t, *revertLog.Remark, "audit-2")
+	require.NotNil(t, revertLog.OperatorId)
+	assert.Equal(t, "admin-1", *revertLog.OperatorId)
+}
+
+func TestRevertMenu_UndeletesMenu(t *testing.T) {
+	svcCtx, _, auditLogModel := setupMenuImportTest(t)
+	ctx := context.Background()
+	require.NoError(t, svcCtx.MenuModel.Delete(ctx, "menu-legacy"))
+	addTestAuditLog(t, auditLogModel, "audit-1", "menu-legacy", "delete", nil,
+		map[string]interface{}{"id": "menu-legacy", "name": "旧页面", "code": "legacy", "type": "page", "parent_id": "menu-system", "path": "/legacy", "order": 1}, nil)
+
+	resp, err := NewRevertMenuLogic(ctx, svcCtx).RevertMenu(&types.RevertMenuReq{Id: "menu-legacy", AuditId: "audit-1"})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Undeleted)
+	assert.Equal(t, []string{"name"}, resp.ChangedFields)
+	legacy, err := svcCtx.MenuModel.FindOneByCode(ctx, "legacy")
+	require.NoError(t, err)
+	assert.Equal(t, "旧页面", legacy.Name)
+	require.NotNil(t, legacy.ParentId)
+	assert.Equal(t, "menu-system", *legacy.ParentId)
+}
+
+func TestRevertMenu_RejectsConflicts(t *testing.T) {
+	svcCtx, _, auditLogModel := setupMenuImportTest(t)
+	ctx := context.Background()
+	require.NoError(t, svcCtx.MenuModel.Delete(ctx, "menu-legacy"))
+	addTestAuditLog(t, auditLogModel, "code-conflict", "menu-audit", "update",
+		[]string{"code"}, map[string]interface{}{"code": "system"}, map[string]interface{}{"code": "audit"})
+	addTestAuditLog(t, auditLogModel, "cycle", "menu-system", "move",
+		[]string{"parent_id"}, map[string]interface{}{"parent_id": "menu-user_list"}, map[string]interface{}{"parent_id": nil})
+	addTestAuditLog(t, auditLogModel, "deleted-parent", "menu-audit", "move",
+		[]string{"parent_id"}, map[string]interface{}{"parent_id": "menu-legacy"}, map[string]interface{}{"parent_id": nil})
+	addTestAuditLog(t, auditLogModel, "route-conflict", "menu-legacy", "delete", nil,
+		map[string]interface{}{"code": "legacy", "type": "page", "path": "/user_list"}, nil)
+
+	cases := []struct {
+		menuId  string
+		auditId string
+		want    error
+	}{
+		{"menu-audit", "code-conflict", menus.ErrMenuCodeExists},
+		{"menu-system", "cycle", menus.ErrMenuCycleDetected},
+		{"menu-audit", "deleted-parent", menus.ErrMenuRevertParentMissing},
+		{"menu-legacy", "route-conflict", menus.ErrMenuRouteConflict},
+		{"menu-user_list", "code-conflict", menus.ErrMenuRevertInvalid},
+	}
+	for _, tc := range cases {
+		_, err := NewRevertMenuLogic(ctx, svcCtx).RevertMenu(&types.RevertMenuReq{Id: tc.menuId, AuditId: tc.auditId})
+		assert.Equal(t, tc.want, err, tc.auditId)
+	}
+
+	// 校验失败不写入
+	_, err := svcCtx.MenuModel.FindOneByCode(ctx, "legacy")
+	assert.Equal(t, menus.ErrMenuNotFound, err)
+	require.Len(t, auditLogModel.Logs, 4)
+}
@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package menu_management
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateMenuGroupLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdateMenuGroupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateMenuGroupLogic {
+	return &UpdateMenuGroupLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateMenuGroupLogic) UpdateMenuGroup(req *types.UpdateMenuGroupReq) (resp *types.UpdateMenuGroupResp, err error) {
+	// 1. 查询分组
+	group, err := l.svcCtx.MenuGroupModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 更新字段（分组编码不可修改）
+	group.Name = req.Name
+	group.Order = req.Order
+	group.Icon = optionalString(req.Icon)
+	group.Description = optionalString(req.Description)
+	if userID, ok := l.ctx.Value(contextkeys.UserIDKey).(string); ok && userID != "" {
+		group.UpdatedBy = &userID
+	}
+
+	// 3. 保存
+	if err := l.svcCtx.MenuGroupModel.Update(l.ctx, group); err != nil {
+		logx.Errorf("更新菜单分组失败: %v", err)
+		return nil, err
+	}
+
+	return &types.UpdateMenuGroupResp{
+		Success: true,
+	}, nil
+}
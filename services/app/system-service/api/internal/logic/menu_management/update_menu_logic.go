@@ -108,6 +108,9 @@ func (l *UpdateMenuLogic) UpdateMenu(req *types.UpdateMenuReq) (resp *types.Upda
 
 	// 7. 分组约束检查（如变更 group_id 或 parent_id）
 	if req.GroupId != "" {
+		if err := ensureMenuGroup(l.ctx, l.svcCtx, req.GroupId); err != nil {
+			return nil, err
+		}
 		if existingMenu.ParentId != nil {
 			parentMenu, err := l.svcCtx.MenuModel.FindOne(l.ctx, *existingMenu.ParentId)
 			if err != nil {
@@ -138,6 +141,13 @@ func (l *UpdateMenuLogic) UpdateMenu(req *types.UpdateMenuReq) (resp *types.Upda
 		existingMenu.OpenMode = &req.OpenMode
 	}
 	if req.PermissionKey != "" {
+		// 变更绑定时校验权限标识已登记为权限点
+		if existingMenu.PermissionKey == nil || *existingMenu.PermissionKey != req.PermissionKey {
+			if err := ensurePermissionPoint(l.ctx, l.svcCtx, req.PermissionKey); err != nil {
+				logx.Errorf("校验权限标识失败: key=%s, error=%v", req.PermissionKey, err)
+				return nil, err
+			}
+		}
 		existingMenu.PermissionKey = &req.PermissionKey
 	}
 	// 处理图标字段：如果传入空字符串，清空图标；如果传入非空字符串，更新图标
@@ -12,6 +12,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/orgaudit"
 	baseErrorx "github.com/DataSemanticHub/services/app/system-service/pkg/errorx"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -76,8 +77,13 @@ func (l *CreateOrgLogic) CreateOrg(req *types.CreateOrgReq) (resp *types.CreateO
 		return nil, err
 	}
 
-	// 6. TODO: 记录审计日志
-	// l.svcCtx.AuditLogModel.Record(l.ctx, "create", "org", result.Id, nil, result)
+	// 6. 记录审计日志
+	recordOrgAudit(l.ctx, l.svcCtx, result.Id, orgaudit.OperationCreate, nil, orgSnapshot(result))
+
+	// 7. 失效全部用户的部门集合缓存（上级部门的数据范围包含新部门）
+	if err := l.svcCtx.DataScope.InvalidateAll(l.ctx); err != nil {
+		l.Errorf("失效用户部门缓存失败: %v", err)
+	}
 
 	l.Infof("成功创建部门: id=%s, name=%s, parentId=%s", result.Id, result.Name, result.ParentId)
 
@@ -8,6 +8,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/orgaudit"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -25,7 +26,7 @@ func setupCreateTestDB(t *testing.T) *gorm.DB {
 	})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&organization.SysOrganization{})
+	err = db.AutoMigrate(&organization.SysOrganization{}, &orgaudit.OrgAudit{})
 	require.NoError(t, err)
 
 	return db
@@ -68,6 +69,7 @@ func TestCreateOrg_NormalFlow_ReturnsOrgId(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -114,6 +116,7 @@ func TestCreateOrg_RootNode_ReturnsOrgId(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -152,6 +155,7 @@ func TestCreateOrg_ParentNotFound_ReturnsError(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -182,6 +186,7 @@ func TestCreateOrg_DuplicateName_ReturnsError(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -216,6 +221,7 @@ func TestCreateOrg_WithLeader_ReturnsOrgId(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
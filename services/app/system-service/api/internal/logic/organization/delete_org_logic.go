@@ -9,6 +9,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/orgaudit"
 	baseErrorx "github.com/DataSemanticHub/services/app/system-service/pkg/errorx"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -69,8 +70,8 @@ func (l *DeleteOrgLogic) DeleteOrg(req *types.DeleteOrgReq) (resp *types.DeleteO
 		return nil, err
 	}
 
-	// 6. TODO: 记录审计日志
-	// l.svcCtx.AuditLogModel.Record(l.ctx, "delete", "org", req.Id, org, nil)
+	// 6. 记录审计日志
+	recordOrgAudit(l.ctx, l.svcCtx, req.Id, orgaudit.OperationDelete, orgSnapshot(org), nil)
 
 	l.Infof("成功删除部门: id=%s, name=%s", req.Id, org.Name)
 
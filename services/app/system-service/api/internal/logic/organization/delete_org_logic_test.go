@@ -8,6 +8,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/orgaudit"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -25,7 +26,7 @@ func setupDeleteTestDB(t *testing.T) *gorm.DB {
 	})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&organization.SysOrganization{})
+	err = db.AutoMigrate(&organization.SysOrganization{}, &orgaudit.OrgAudit{})
 	require.NoError(t, err)
 
 	return db
@@ -76,6 +77,7 @@ func TestDeleteOrg_NotFound_ReturnsError(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -102,6 +104,7 @@ func TestDeleteOrg_RootNode_ReturnsError(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -130,6 +133,7 @@ func TestDeleteOrg_WithChildren_ReturnsError(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package organization
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	baseErrorx "github.com/DataSemanticHub/services/app/system-service/pkg/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOrgAuditsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询部门审计日志
+func NewGetOrgAuditsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOrgAuditsLogic {
+	return &GetOrgAuditsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetOrgAuditsLogic) GetOrgAudits(req *types.GetOrgAuditsReq) (resp *types.OrgAuditListResp, err error) {
+	// 1. 参数校验
+	if req.Id == "" {
+		return nil, baseErrorx.New(errorx.ErrCodeOrgParamInvalid, "部门ID不能为空")
+	}
+
+	// 2. 查询审计日志（已删除的部门仍可查询历史）
+	return findOrgAudits(l.ctx, l.svcCtx, &orgAuditQuery{
+		OrgId:      req.Id,
+		Operation:  req.Operation,
+		OperatorId: req.OperatorId,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+}
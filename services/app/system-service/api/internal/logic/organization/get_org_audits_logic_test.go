@@ -0,0 +1,137 @@
+package organization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/orgaudit"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/userdept"
+	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupOrgAuditsTestSvc 创建测试数据库和服务上下文
+func setupOrgAuditsTestSvc(t *testing.T) *svc.ServiceContext {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(&organization.SysOrganization{}, &orgaudit.OrgAudit{}, &userdept.SysUserDept{}, &users.User{})
+	require.NoError(t, err)
+
+	orgModel := organization.NewModel(db)
+	return &svc.ServiceContext{
+		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
+		OrgTreeService: organization.NewTreeService(orgModel),
+		UserModel:      users.NewModel(db),
+	}
+}
+
+// TestGetOrgAudits_AfterCreateAndUpdate_ReturnsDiffAndOperator 测试创建、更新后查询部门审计日志
+func TestGetOrgAudits_AfterCreateAndUpdate_ReturnsDiffAndOperator(t *testing.T) {
+	svcCtx := setupOrgAuditsTestSvc(t)
+	operatorId, _ := uuid.NewV7()
+	operator, err := svcCtx.UserModel.Insert(context.Background(), &users.User{
+		Id:            operatorId.String(),
+		Name:          "审计员",
+		Email:         "auditor@example.com",
+		Status:        1,
+		AccountSource: "local",
+	})
+	require.NoError(t, err)
+	ctx := context.WithValue(context.Background(), contextkeys.UserIDKey, operator.Id)
+
+	// 创建并更新部门
+	created, err := NewCreateOrgLogic(ctx, svcCtx).CreateOrg(&types.CreateOrgReq{
+		ParentId: "0",
+		Name:     "研发部",
+		Code:     "RD",
+		Type:     2,
+	})
+	require.NoError(t, err)
+	_, err = NewUpdateOrgLogic(ctx, svcCtx).UpdateOrg(&types.UpdateOrgReq{
+		Id:        created.Id,
+		Name:      "研发中心",
+		SortOrder: -1,
+		Status:    1,
+	})
+	require.NoError(t, err)
+
+	// 查询审计日志
+	resp, err := NewGetOrgAuditsLogic(ctx, svcCtx).GetOrgAudits(&types.GetOrgAuditsReq{
+		Id:       created.Id,
+		Page:     1,
+		PageSize: 20,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), resp.Total)
+	require.Len(t, resp.Logs, 2)
+
+	operations := map[string]*types.OrgAuditLog{}
+	for _, log := range resp.Logs {
+		operations[log.Operation] = log
+		assert.Equal(t, operator.Id, log.OperatorId)
+		assert.Equal(t, "审计员", log.OperatorName)
+		assert.Equal(t, "研发中心", log.OrgName)
+	}
+	require.Contains(t, operations, orgaudit.OperationCreate)
+	require.Contains(t, operations, orgaudit.OperationUpdate)
+
+	update := operations[orgaudit.OperationUpdate]
+	assert.Equal(t, map[string]interface{}{
+		"name": map[string]interface{}{"old": "研发部", "new": "研发中心"},
+	}, update.Changes)
+	assert.Nil(t, operations[orgaudit.OperationCreate].OldValue)
+}
+
+// TestListOrgAudits_DeletedOrg_KeepsHistory 测试部门删除后仍可按操作类型查询历史
+func TestListOrgAudits_DeletedOrg_KeepsHistory(t *testing.T) {
+	svcCtx := setupOrgAuditsTestSvc(t)
+	ctx := context.Background()
+
+	// 删除后部门记录不存在，仅保留审计快照（Delete 使用 MySQL NOW()，此处直接写入审计日志）
+	child := &organization.SysOrganization{Id: "deleted-org", ParentId: "0", Name: "财务部", Code: "FIN", Type: 2, Status: 1}
+	recordOrgAudit(ctx, svcCtx, child.Id, orgaudit.OperationDelete, orgSnapshot(child), nil)
+
+	resp, err := NewListOrgAuditsLogic(ctx, svcCtx).ListOrgAudits(&types.ListOrgAuditsReq{
+		Operation: orgaudit.OperationDelete,
+		Page:      1,
+		PageSize:  20,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Logs, 1)
+
+	log := resp.Logs[0]
+	assert.Equal(t, child.Id, log.OrgId)
+	assert.Equal(t, "财务部", log.OrgName)
+	assert.Equal(t, errorx.SystemOperatorID, log.OperatorId)
+	assert.Equal(t, errorx.SystemOperatorName, log.OperatorName)
+	assert.Nil(t, log.NewValue)
+	assert.Contains(t, log.Changes, "name")
+}
+
+// TestListOrgAudits_InvalidTimeRange_ReturnsError 测试时间范围参数错误
+func TestListOrgAudits_InvalidTimeRange_ReturnsError(t *testing.T) {
+	svcCtx := setupOrgAuditsTestSvc(t)
+
+	_, err := NewListOrgAuditsLogic(context.Background(), svcCtx).ListOrgAudits(&types.ListOrgAuditsReq{
+		StartTime: "not-a-time",
+		Page:      1,
+		PageSize:  20,
+	})
+	assert.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/datascope"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	org "github.com/DataSemanticHub/services/app/system-service/model/system/organization"
@@ -42,6 +43,14 @@ func (l *GetOrgTreeLogic) GetOrgTree(req *types.GetOrgTreeReq) (resp *types.GetO
 		return nil, err
 	}
 
+	// 1.1 按调用方的数据范围过滤
+	scope, err := l.svcCtx.DataScope.Resolve(l.ctx, datascope.ModuleOrganization)
+	if err != nil {
+		l.Errorf("解析数据范围失败: %v", err)
+		return nil, err
+	}
+	allOrgs = filterOrgsByScope(allOrgs, scope)
+
 	// 2. 模糊搜索过滤（如果提供了名称）
 	var filteredOrgs []*org.SysOrganization
 	if req.Name != "" {
@@ -64,6 +73,32 @@ func (l *GetOrgTreeLogic) GetOrgTree(req *types.GetOrgTreeReq) (resp *types.GetO
 	return &types.GetOrgTreeResp{Tree: respTree}, nil
 }
 
+// filterOrgsByScope 按数据范围过滤部门，保留范围内部门的上级部门以维持树形结构
+func filterOrgsByScope(orgs []*org.SysOrganization, scope *datascope.Scope) []*org.SysOrganization {
+	if scope.All {
+		return orgs
+	}
+	visible := make(map[string]struct{})
+	for _, item := range orgs {
+		if !scope.AllowsDept(item.Id) {
+			continue
+		}
+		visible[item.Id] = struct{}{}
+		for _, id := range strings.Split(item.Ancestors, ",") {
+			if id != "" && id != "0" {
+				visible[id] = struct{}{}
+			}
+		}
+	}
+	filtered := make([]*org.SysOrganization, 0, len(visible))
+	for _, item := range orgs {
+		if _, ok := visible[item.Id]; ok {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
 // contains 简单的字符串包含判断
 func contains(s, substr string) bool {
 	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
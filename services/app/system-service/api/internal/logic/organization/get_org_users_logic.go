@@ -6,6 +6,7 @@ package organization
 import (
 	"context"
 
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/datascope"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 
@@ -54,11 +55,19 @@ func (l *GetOrgUsersLogic) GetOrgUsers(req *types.GetOrgUsersReq) (resp *types.G
 		l.Infof("查询部门用户: dept=%s (非递归)", org.Name)
 	}
 
+	// 2.1 解析调用方的数据范围
+	scope, err := l.svcCtx.DataScope.Resolve(l.ctx, datascope.ModuleOrganization)
+	if err != nil {
+		l.Errorf("解析数据范围失败: %v", err)
+		return nil, err
+	}
+
 	// 3. 查询所有部门关联的用户
 	userDepts := make(map[string]*types.DeptUser) // userId -> DeptUser (去重，保留主部门标记)
 	primaryDepts := make(map[string]bool)         // userId -> isPrimary
 
 	for _, deptId := range deptIds {
+		inScope := scope.AllowsDept(deptId)
 		// 查询该部门的所有用户（主部门+辅助部门）
 		depts, err := l.svcCtx.UserDeptModel.FindUsersByDeptId(l.ctx, deptId, nil)
 		if err != nil {
@@ -67,6 +76,11 @@ func (l *GetOrgUsersLogic) GetOrgUsers(req *types.GetOrgUsersReq) (resp *types.G
 		}
 
 		for _, ud := range depts {
+			// 数据范围外的部门只返回本人
+			if !inScope && ud.UserId != scope.UserId {
+				continue
+			}
+
 			// 如果用户已存在，保留主部门标记
 			if existing, ok := userDepts[ud.UserId]; ok {
 				if ud.IsPrimary == 1 {
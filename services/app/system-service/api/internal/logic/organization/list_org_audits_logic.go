@@ -0,0 +1,40 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package organization
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOrgAuditsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询组织架构审计日志
+func NewListOrgAuditsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOrgAuditsLogic {
+	return &ListOrgAuditsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListOrgAuditsLogic) ListOrgAudits(req *types.ListOrgAuditsReq) (resp *types.OrgAuditListResp, err error) {
+	return findOrgAudits(l.ctx, l.svcCtx, &orgAuditQuery{
+		OrgId:      req.OrgId,
+		Operation:  req.Operation,
+		OperatorId: req.OperatorId,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+}
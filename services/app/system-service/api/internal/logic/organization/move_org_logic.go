@@ -9,6 +9,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/orgaudit"
 	baseErrorx "github.com/DataSemanticHub/services/app/system-service/pkg/errorx"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -107,12 +108,21 @@ func (l *MoveOrgLogic) MoveOrg(req *types.MoveOrgReq) (resp *types.MoveOrgResp,
 		}
 	}
 
-	// 7. TODO: 记录审计日志
-	// l.svcCtx.AuditLogModel.Record(l.ctx, "move", "org", req.Id, map[string]interface{}{
-	//     "old_parent_id": oldParentId,
-	//     "new_parent_id": req.TargetParentId,
-	//     "old_ancestors": oldAncestors,
-	// }, nil)
+	// 9. 记录审计日志
+	recordOrgAudit(l.ctx, l.svcCtx, req.Id, orgaudit.OperationMove, map[string]interface{}{
+		"name":      org.Name,
+		"parentId":  oldParentId,
+		"ancestors": oldAncestors,
+	}, map[string]interface{}{
+		"name":      org.Name,
+		"parentId":  req.TargetParentId,
+		"ancestors": newAncestors,
+	})
+
+	// 10. 失效全部用户的部门集合缓存（新旧上级部门的数据范围均发生变化）
+	if err := l.svcCtx.DataScope.InvalidateAll(l.ctx); err != nil {
+		l.Errorf("失效用户部门缓存失败: %v", err)
+	}
 
 	l.Infof("成功移动部门: id=%s, name=%s, oldParentId=%s, newParentId=%s", req.Id, org.Name, oldParentId, req.TargetParentId)
 
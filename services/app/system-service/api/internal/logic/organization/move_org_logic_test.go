@@ -8,6 +8,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/orgaudit"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -25,7 +26,7 @@ func setupMoveTestDB(t *testing.T) *gorm.DB {
 	})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&organization.SysOrganization{})
+	err = db.AutoMigrate(&organization.SysOrganization{}, &orgaudit.OrgAudit{})
 	require.NoError(t, err)
 
 	return db
@@ -68,6 +69,7 @@ func TestMoveOrg_NormalFlow_ReturnsSuccess(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -106,6 +108,7 @@ func TestMoveOrg_MoveToRoot_ReturnsSuccess(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -142,6 +145,7 @@ func TestMoveOrg_NodeNotFound_ReturnsError(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -169,6 +173,7 @@ func TestMoveOrg_TargetParentNotFound_ReturnsError(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -199,6 +204,7 @@ func TestMoveOrg_MoveToSelf_ReturnsError(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -228,6 +234,7 @@ func TestMoveOrg_MoveToDescendant_ReturnsError(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -262,6 +269,7 @@ func TestMoveOrg_WithDescendants_ReturnsSuccess(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
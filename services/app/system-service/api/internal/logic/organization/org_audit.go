@@ -0,0 +1,173 @@
+package organization
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/audit"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/orgaudit"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// orgSnapshot 部门审计快照（记录到审计日志的新旧值）
+func orgSnapshot(org *organization.SysOrganization) map[string]interface{} {
+	return map[string]interface{}{
+		"name":      org.Name,
+		"code":      org.Code,
+		"parentId":  org.ParentId,
+		"ancestors": org.Ancestors,
+		"sortOrder": org.SortOrder,
+		"leaderId":  org.LeaderId,
+		"type":      org.Type,
+		"status":    org.Status,
+		"desc":      org.Desc,
+	}
+}
+
+// recordOrgAudit 记录组织架构审计日志（失败只记录错误，不影响业务操作）
+func recordOrgAudit(ctx context.Context, svcCtx *svc.ServiceContext, orgId, operation string, oldValue, newValue map[string]interface{}) {
+	operatorId, _ := ctx.Value(contextkeys.UserIDKey).(string)
+	if operatorId == "" {
+		operatorId = errorx.SystemOperatorID
+	}
+
+	// 空值记录为 JSON null，json 列不接受空字符串
+	oldJSON, _ := json.Marshal(oldValue)
+	newJSON, _ := json.Marshal(newValue)
+	if _, err := svcCtx.OrgAuditModel.Insert(ctx, &orgaudit.OrgAudit{
+		OrgId:      orgId,
+		Operation:  operation,
+		OperatorId: operatorId,
+		OldValue:   string(oldJSON),
+		NewValue:   string(newJSON),
+	}); err != nil {
+		logx.WithContext(ctx).Errorf("记录组织架构审计日志失败: orgId=%s, operation=%s, error=%v", orgId, operation, err)
+	}
+}
+
+// orgAuditQuery 审计日志查询条件（部门审计和全局审计共用）
+type orgAuditQuery struct {
+	OrgId      string
+	Operation  string
+	OperatorId string
+	StartTime  string
+	EndTime    string
+	Page       int
+	PageSize   int
+}
+
+// findOrgAudits 查询审计日志并补全部门名称、操作人名称和字段变更
+func findOrgAudits(ctx context.Context, svcCtx *svc.ServiceContext, query *orgAuditQuery) (*types.OrgAuditListResp, error) {
+	logger := logx.WithContext(ctx)
+
+	// 1. 构建查询条件
+	findReq := &orgaudit.FindListReq{
+		OrgId:      query.OrgId,
+		Operation:  query.Operation,
+		OperatorId: query.OperatorId,
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+	}
+	var err error
+	findReq.StartTime, findReq.EndTime, err = audit.ParseTimeRange(query.StartTime, query.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 查询审计日志
+	audits, total, err := svcCtx.OrgAuditModel.FindList(ctx, findReq)
+	if err != nil {
+		logger.Errorf("查询组织架构审计日志失败: %v", err)
+		return nil, err
+	}
+
+	// 3. 转换响应（部门、操作人名称在本次查询内缓存）
+	orgNames := make(map[string]string)
+	operatorNames := make(map[string]string)
+	logs := make([]*types.OrgAuditLog, 0, len(audits))
+	for _, item := range audits {
+		log := &types.OrgAuditLog{
+			Id:         item.Id,
+			OrgId:      item.OrgId,
+			Operation:  item.Operation,
+			OperatorId: item.OperatorId,
+			OldValue:   jsonObject(item.OldValue),
+			NewValue:   jsonObject(item.NewValue),
+			CreatedAt:  formatOrgAuditTime(item.CreatedAt),
+		}
+		log.Changes = audit.DiffValues(log.OldValue, log.NewValue)
+		log.OrgName = lookupOrgName(ctx, svcCtx, log, orgNames)
+		log.OperatorName = lookupOperatorName(ctx, svcCtx, item.OperatorId, operatorNames)
+		logs = append(logs, log)
+	}
+
+	return &types.OrgAuditListResp{
+		Total:    total,
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		Logs:     logs,
+	}, nil
+}
+
+// lookupOrgName 查询部门名称；部门已删除时取审计快照中的名称
+func lookupOrgName(ctx context.Context, svcCtx *svc.ServiceContext, log *types.OrgAuditLog, cache map[string]string) string {
+	if name, ok := cache[log.OrgId]; ok && name != "" {
+		return name
+	}
+	name := ""
+	if org, err := svcCtx.OrgModel.FindOne(ctx, log.OrgId); err == nil && org != nil {
+		name = org.Name
+	}
+	if name == "" {
+		for _, snapshot := range []map[string]interface{}{log.NewValue, log.OldValue} {
+			if value, ok := snapshot["name"].(string); ok && value != "" {
+				return value
+			}
+		}
+	}
+	cache[log.OrgId] = name
+	return name
+}
+
+// lookupOperatorName 通过 UserModel 查询操作人名称
+func lookupOperatorName(ctx context.Context, svcCtx *svc.ServiceContext, operatorId string, cache map[string]string) string {
+	if operatorId == errorx.SystemOperatorID {
+		return errorx.SystemOperatorName
+	}
+	if name, ok := cache[operatorId]; ok {
+		return name
+	}
+	name := ""
+	if user, err := svcCtx.UserModel.FindOne(ctx, operatorId); err == nil && user != nil {
+		name = user.Name
+	}
+	cache[operatorId] = name
+	return name
+}
+
+// jsonObject 解析 JSON 对象，空值或非对象返回 nil
+func jsonObject(value string) map[string]interface{} {
+	if value == "" {
+		return nil
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &result); err != nil {
+		return nil
+	}
+	return result
+}
+
+// formatOrgAuditTime 统一审计时间格式（数据库可能返回 RFC3339 格式）
+func formatOrgAuditTime(value string) string {
+	if len(value) > 19 {
+		return strings.Replace(value[:19], "T", " ", 1)
+	}
+	return value
+}
@@ -42,6 +42,11 @@ func (l *SetUserPrimaryDeptLogic) SetUserPrimaryDept(req *types.SetUserPrimaryDe
 		return nil, err
 	}
 
+	// 3. 失效用户的部门集合缓存（数据范围按新主部门重新计算）
+	if err := l.svcCtx.DataScope.Invalidate(l.ctx, req.UserId); err != nil {
+		l.Errorf("失效用户部门缓存失败: userId=%s, error=%v", req.UserId, err)
+	}
+
 	l.Infof("成功设置用户主部门: userId=%s, deptId=%s, deptName=%s", req.UserId, req.DeptId, org.Name)
 
 	return &types.SetUserPrimaryDeptResp{
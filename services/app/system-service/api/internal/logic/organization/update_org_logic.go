@@ -11,6 +11,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/orgaudit"
 	baseErrorx "github.com/DataSemanticHub/services/app/system-service/pkg/errorx"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -71,6 +72,7 @@ func (l *UpdateOrgLogic) UpdateOrg(req *types.UpdateOrgReq) (resp *types.UpdateO
 	}
 
 	// 4. 更新字段（不修改 parent_id，通过 MoveOrg 接口移动）
+	before := orgSnapshot(org)
 	now := time.Now().Format("2006-01-02 15:04:05")
 
 	// 检查是否有字段需要更新
@@ -122,6 +124,9 @@ func (l *UpdateOrgLogic) UpdateOrg(req *types.UpdateOrgReq) (resp *types.UpdateO
 			return nil, err
 		}
 
+		// 6. 记录审计日志
+		recordOrgAudit(l.ctx, l.svcCtx, org.Id, orgaudit.OperationUpdate, before, orgSnapshot(org))
+
 		l.Infof("成功更新部门: id=%s, name=%s", org.Id, org.Name)
 	}
 
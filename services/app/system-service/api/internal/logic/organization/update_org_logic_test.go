@@ -8,6 +8,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/orgaudit"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -25,7 +26,7 @@ func setupUpdateTestDB(t *testing.T) *gorm.DB {
 	})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&organization.SysOrganization{})
+	err = db.AutoMigrate(&organization.SysOrganization{}, &orgaudit.OrgAudit{})
 	require.NoError(t, err)
 
 	return db
@@ -68,6 +69,7 @@ func TestUpdateOrg_NormalFlow_ReturnsSuccess(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -111,6 +113,7 @@ func TestUpdateOrg_NotFound_ReturnsError(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -139,6 +142,7 @@ func TestUpdateOrg_DuplicateName_ReturnsError(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -171,6 +175,7 @@ func TestUpdateOrg_DisableWithActiveChildren_ReturnsError(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -201,6 +206,7 @@ func TestUpdateOrg_DisableWithDisabledChildren_ReturnsSuccess(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
@@ -237,6 +243,7 @@ func TestUpdateOrg_WithLeader_ReturnsSuccess(t *testing.T) {
 	treeService := organization.NewTreeService(orgModel)
 	svcCtx := &svc.ServiceContext{
 		OrgModel:       orgModel,
+		OrgAuditModel:  orgaudit.NewModel(db),
 		OrgTreeService: treeService,
 	}
 	ctx := context.Background()
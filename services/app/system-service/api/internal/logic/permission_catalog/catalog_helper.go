@@ -0,0 +1,107 @@
+package permission_catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	catalogmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_catalog"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"gorm.io/datatypes"
+)
+
+// catalogTimeLayout 权限目录时间字段输出格式（与权限模板保持一致）
+const catalogTimeLayout = "2006-01-02 15:04:05.000"
+
+// wildcard 通配符，不可作为模块或动作编码登记
+const wildcard = "*"
+
+// currentOperatorID 获取当前操作人ID，缺失时回退为系统
+func currentOperatorID(ctx context.Context) string {
+	if userID, ok := ctx.Value(contextkeys.UserIDKey).(string); ok && userID != "" {
+		return userID
+	}
+	return errorx.SystemOperatorID
+}
+
+// buildModuleDefinition 校验并序列化模块的动作与数据范围
+// 动作编码不能为空、重复或为通配符；数据范围须为系统支持的取值，为空时允许全部
+func buildModuleDefinition(actions []types.CatalogAction, scopes []string) (datatypes.JSON, datatypes.JSON, error) {
+	if len(actions) == 0 {
+		return nil, nil, baseErrorx.New(errorx.ErrCatalogModuleInvalid, "模块至少需要一个动作")
+	}
+	modelActions := make([]catalogmodel.Action, 0, len(actions))
+	seen := make(map[string]struct{}, len(actions))
+	for _, action := range actions {
+		if action.Code == "" || action.Code == wildcard {
+			return nil, nil, baseErrorx.New(errorx.ErrCatalogModuleInvalid, fmt.Sprintf("动作编码无效: %q", action.Code))
+		}
+		if _, ok := seen[action.Code]; ok {
+			return nil, nil, baseErrorx.New(errorx.ErrCatalogModuleInvalid, fmt.Sprintf("动作编码重复: %s", action.Code))
+		}
+		seen[action.Code] = struct{}{}
+		modelActions = append(modelActions, catalogmodel.Action{Code: action.Code, Name: action.Name})
+	}
+
+	if len(scopes) == 0 {
+		scopes = catalogmodel.AllScopes
+	}
+	for _, scope := range scopes {
+		if !isKnownScope(scope) {
+			return nil, nil, baseErrorx.New(errorx.ErrCatalogModuleInvalid, fmt.Sprintf("数据范围无效: %s", scope))
+		}
+	}
+
+	actionsJSON, err := json.Marshal(modelActions)
+	if err != nil {
+		return nil, nil, err
+	}
+	scopesJSON, err := json.Marshal(scopes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return actionsJSON, scopesJSON, nil
+}
+
+// isKnownScope 判断是否为系统支持的数据范围
+func isKnownScope(scope string) bool {
+	for _, s := range catalogmodel.AllScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// toCatalogModule 将目录模块实体转换为 API 类型
+func toCatalogModule(module *catalogmodel.CatalogModule) (types.CatalogModule, error) {
+	actions, err := module.ParseActions()
+	if err != nil {
+		return types.CatalogModule{}, err
+	}
+	scopes, err := module.ParseScopes()
+	if err != nil {
+		return types.CatalogModule{}, err
+	}
+
+	item := types.CatalogModule{
+		Id:        module.Id,
+		Code:      module.Code,
+		Name:      module.Name,
+		Actions:   make([]types.CatalogAction, 0, len(actions)),
+		Scopes:    scopes,
+		SortOrder: module.SortOrder,
+		UpdatedAt: module.UpdatedAt.Format(catalogTimeLayout),
+	}
+	if module.Description != nil {
+		item.Description = *module.Description
+	}
+	for _, action := range actions {
+		item.Actions = append(item.Actions, types.CatalogAction{Code: action.Code, Name: action.Name})
+	}
+	return item, nil
+}
@@ -0,0 +1,93 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_catalog
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	catalogmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_catalog"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// templateScanPageSize 扫描已发布模板时的分页大小
+const templateScanPageSize = 100
+
+type DeleteCatalogModuleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除权限目录模块
+func NewDeleteCatalogModuleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteCatalogModuleLogic {
+	return &DeleteCatalogModuleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteCatalogModuleLogic) DeleteCatalogModule(req *types.DeleteCatalogModuleReq) (resp *types.DeleteCatalogModuleResp, err error) {
+	// 1. 查询模块
+	module, err := l.svcCtx.PermissionCatalogModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询权限目录模块失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 校验模块未被已发布模板引用
+	templateCode, err := l.findPublishedTemplateUsing(module.Code)
+	if err != nil {
+		l.Errorf("查询模块引用失败: %v", err)
+		return nil, err
+	}
+	if templateCode != "" {
+		l.Errorf("权限目录模块被已发布模板引用，无法删除: module=%s, template=%s", module.Code, templateCode)
+		return nil, catalogmodel.ErrCatalogModuleInUse
+	}
+
+	// 3. 执行软删除
+	if err := l.svcCtx.PermissionCatalogModel.Delete(l.ctx, module.Id); err != nil {
+		l.Errorf("删除权限目录模块失败: %v", err)
+		return nil, err
+	}
+
+	l.Infof("删除权限目录模块成功: id=%s, code=%s", module.Id, module.Code)
+
+	return &types.DeleteCatalogModuleResp{
+		Success: true,
+	}, nil
+}
+
+// findPublishedTemplateUsing 返回策略矩阵引用指定模块的第一个已发布模板编码，未引用时返回空字符串
+func (l *DeleteCatalogModuleLogic) findPublishedTemplateUsing(moduleCode string) (string, error) {
+	for page := 1; ; page++ {
+		templates, total, err := l.svcCtx.PermissionTemplateModel.List(l.ctx, &permissiontemplatemodel.ListFilter{
+			Status:   permissiontemplatemodel.StatusPublished,
+			Page:     page,
+			PageSize: templateScanPageSize,
+		})
+		if err != nil {
+			return "", err
+		}
+		for _, template := range templates {
+			var matrix map[string]json.RawMessage
+			if err := json.Unmarshal(template.PolicyMatrix, &matrix); err != nil {
+				l.Errorf("解析策略矩阵失败: template=%s, error=%v", template.Code, err)
+				continue
+			}
+			if _, ok := matrix[moduleCode]; ok {
+				return template.Code, nil
+			}
+		}
+		if int64(page*templateScanPageSize) >= total || len(templates) == 0 {
+			return "", nil
+		}
+	}
+}
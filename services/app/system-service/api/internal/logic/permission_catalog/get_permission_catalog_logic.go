@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_catalog
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	catalogmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_catalog"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPermissionCatalogLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询权限目录
+func NewGetPermissionCatalogLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPermissionCatalogLogic {
+	return &GetPermissionCatalogLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPermissionCatalogLogic) GetPermissionCatalog() (resp *types.GetPermissionCatalogResp, err error) {
+	// 1. 查询全部目录模块
+	modules, err := l.svcCtx.PermissionCatalogModel.FindAll(l.ctx)
+	if err != nil {
+		l.Errorf("查询权限目录失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 转换响应
+	items := make([]types.CatalogModule, 0, len(modules))
+	for _, module := range modules {
+		item, err := toCatalogModule(module)
+		if err != nil {
+			l.Errorf("转换权限目录模块失败: %v", err)
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return &types.GetPermissionCatalogResp{
+		Modules: items,
+		Scopes:  catalogmodel.AllScopes,
+	}, nil
+}
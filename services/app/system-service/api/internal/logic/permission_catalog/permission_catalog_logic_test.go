@@ -0,0 +1,230 @@
+package permission_catalog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/middleware"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/testutil"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	catalogmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_catalog"
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupCatalogTest 创建测试用的 ServiceContext（SQLite）
+func setupCatalogTest(t *testing.T) (*svc.ServiceContext, *gorm.DB) {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	testutil.CreatePermissionTables(t, db)
+
+	// permission_catalog_modules 使用 MySQL 专有默认值，SQLite 下手动建表
+	for _, ddl := range []string{
+		`CREATE TABLE IF NOT EXISTS permission_catalog_modules (
+			id TEXT PRIMARY KEY, code TEXT NOT NULL, name TEXT NOT NULL, description TEXT,
+			actions TEXT NOT NULL, scopes TEXT NOT NULL, sort_order INTEGER NOT NULL DEFAULT 0, created_by TEXT NOT NULL,
+			created_at DATETIME, updated_by TEXT, updated_at DATETIME, deleted_at DATETIME
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	svcCtx := &svc.ServiceContext{
+		DB:                      db,
+		PermissionCatalogModel:  catalogmodel.NewModel(db),
+		PermissionTemplateModel: permissiontemplates.NewModel(db),
+	}
+	return svcCtx, db
+}
+
+// createTestModule 通过接口逻辑登记目录模块
+func createTestModule(t *testing.T, svcCtx *svc.ServiceContext, code string, sortOrder int, scopes ...string) string {
+	resp, err := NewCreateCatalogModuleLogic(context.Background(), svcCtx).CreateCatalogModule(&types.CreateCatalogModuleReq{
+		Code:      code,
+		Name:      code,
+		Actions:   []types.CatalogAction{{Code: "read", Name: "查看"}, {Code: "update", Name: "编辑"}},
+		Scopes:    scopes,
+		SortOrder: sortOrder,
+	})
+	require.NoError(t, err)
+	return resp.Id
+}
+
+// assertCodeError 断言返回指定错误码
+func assertCodeError(t *testing.T, err error, code int) {
+	require.Error(t, err)
+	codeErr, ok := err.(*baseErrorx.CodeError)
+	require.True(t, ok)
+	assert.Equal(t, code, codeErr.Code)
+}
+
+func TestCreateCatalogModule_ListedInSortOrder(t *testing.T) {
+	svcCtx, _ := setupCatalogTest(t)
+	createTestModule(t, svcCtx, "user", 20)
+	createTestModule(t, svcCtx, "audit", 10, catalogmodel.ScopeGlobal)
+
+	resp, err := NewGetPermissionCatalogLogic(context.Background(), svcCtx).GetPermissionCatalog()
+
+	require.NoError(t, err)
+	require.Len(t, resp.Modules, 2)
+	assert.Equal(t, "audit", resp.Modules[0].Code)
+	assert.Equal(t, []string{catalogmodel.ScopeGlobal}, resp.Modules[0].Scopes)
+	assert.Equal(t, "user", resp.Modules[1].Code)
+	assert.Equal(t, catalogmodel.AllScopes, resp.Modules[1].Scopes)
+	assert.Equal(t, []types.CatalogAction{{Code: "read", Name: "查看"}, {Code: "update", Name: "编辑"}}, resp.Modules[1].Actions)
+	assert.Equal(t, catalogmodel.AllScopes, resp.Scopes)
+}
+
+func TestCreateCatalogModule_Validation(t *testing.T) {
+	svcCtx, _ := setupCatalogTest(t)
+	createTestModule(t, svcCtx, "user", 0)
+	logic := NewCreateCatalogModuleLogic(context.Background(), svcCtx)
+
+	_, err := logic.CreateCatalogModule(&types.CreateCatalogModuleReq{
+		Code: "user", Name: "用户", Actions: []types.CatalogAction{{Code: "read", Name: "查看"}},
+	})
+	assertCodeError(t, err, errorx.ErrCatalogModuleCodeExists)
+
+	_, err = logic.CreateCatalogModule(&types.CreateCatalogModuleReq{
+		Code: "role", Name: "角色", Actions: []types.CatalogAction{{Code: "read", Name: "查看"}, {Code: "read", Name: "读取"}},
+	})
+	assertCodeError(t, err, errorx.ErrCatalogModuleInvalid)
+
+	_, err = logic.CreateCatalogModule(&types.CreateCatalogModuleReq{
+		Code: "role", Name: "角色", Actions: []types.CatalogAction{{Code: "read", Name: "查看"}}, Scopes: []string{"tenant"},
+	})
+	assertCodeError(t, err, errorx.ErrCatalogModuleInvalid)
+
+	_, err = logic.CreateCatalogModule(&types.CreateCatalogModuleReq{
+		Code: "*", Name: "全部", Actions: []types.CatalogAction{{Code: "read", Name: "查看"}},
+	})
+	assertCodeError(t, err, errorx.ErrCatalogModuleInvalid)
+}
+
+func TestUpdateCatalogModule_ReplacesActionsAndScopes(t *testing.T) {
+	svcCtx, _ := setupCatalogTest(t)
+	id := createTestModule(t, svcCtx, "user", 0)
+	ctx := context.Background()
+
+	_, err := NewUpdateCatalogModuleLogic(ctx, svcCtx).UpdateCatalogModule(&types.UpdateCatalogModuleReq{
+		Id:      id,
+		Name:    "用户管理",
+		Actions: []types.CatalogAction{{Code: "read", Name: "查看"}},
+		Scopes:  []string{catalogmodel.ScopeOrganization},
+	})
+	require.NoError(t, err)
+
+	module, err := svcCtx.PermissionCatalogModel.FindOne(ctx, id)
+	require.NoError(t, err)
+	actions, err := module.ParseActions()
+	require.NoError(t, err)
+	scopes, err := module.ParseScopes()
+	require.NoError(t, err)
+	assert.Equal(t, "user", module.Code)
+	assert.Equal(t, "用户管理", module.Name)
+	assert.Equal(t, []catalogmodel.Action{{Code: "read", Name: "查看"}}, actions)
+	assert.Equal(t, []string{catalogmodel.ScopeOrganization}, scopes)
+	require.NotNil(t, module.UpdatedBy)
+}
+
+func TestDeleteCatalogModule_BlockedByPublishedTemplate(t *testing.T) {
+	svcCtx, db := setupCatalogTest(t)
+	userId := createTestModule(t, svcCtx, "user", 0)
+	auditId := createTestModule(t, svcCtx, "audit", 0)
+	now := time.Now()
+	for _, template := range []*permissiontemplates.PermissionTemplate{
+		{Id: "tpl-published", Code: "user_admin", Name: "用户管理员", Status: permissiontemplates.StatusPublished, Version: 2,
+			PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read"],"scope":"global"}}`), CreatedBy: "system", CreatedAt: now, UpdatedAt: now},
+		{Id: "tpl-draft", Code: "auditor", Name: "审计员", Status: permissiontemplates.StatusDraft, Version: 1,
+			PolicyMatrix: datatypes.JSON(`{"audit":{"actions":["read"],"scope":"global"}}`), CreatedBy: "system", CreatedAt: now, UpdatedAt: now},
+	} {
+		require.NoError(t, db.Create(template).Error)
+	}
+	logic := NewDeleteCatalogModuleLogic(context.Background(), svcCtx)
+
+	_, err := logic.DeleteCatalogModule(&types.DeleteCatalogModuleReq{Id: userId})
+	assertCodeError(t, err, errorx.ErrCatalogModuleInUse)
+
+	// 仅被草稿引用的模块可以删除
+	resp, err := logic.DeleteCatalogModule(&types.DeleteCatalogModuleReq{Id: auditId})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	_, err = svcCtx.PermissionCatalogModel.FindOne(context.Background(), auditId)
+	assertCodeError(t, err, errorx.ErrCatalogModuleNotFound)
+}
+
+func TestSeedFromFile_OnlyAddsMissingModules(t *testing.T) {
+	svcCtx, _ := setupCatalogTest(t)
+	ctx := context.Background()
+	createTestModule(t, svcCtx, "user", 5)
+
+	path := filepath.Join(t.TempDir(), "permission_catalog.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+Modules:
+  - Code: user
+    Name: 用户管理
+    Actions:
+      - { Code: delete, Name: 删除 }
+  - Code: authz
+    Name: 授权判定
+    SortOrder: 80
+    Scopes: [global]
+    Actions:
+      - { Code: check, Name: 判定 }
+`), 0o644))
+
+	SeedFromFile(ctx, svcCtx, path)
+	SeedFromFile(ctx, svcCtx, filepath.Join(t.TempDir(), "missing.yaml"))
+
+	modules, err := svcCtx.PermissionCatalogModel.FindAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, modules, 2)
+	assert.Equal(t, "user", modules[0].Code)
+	actions, err := modules[0].ParseActions()
+	require.NoError(t, err)
+	assert.Len(t, actions, 2) // 已存在的模块不被覆盖
+	assert.Equal(t, "authz", modules[1].Code)
+	assert.Equal(t, 80, modules[1].SortOrder)
+	actions, err = modules[1].ParseActions()
+	require.NoError(t, err)
+	assert.Equal(t, []catalogmodel.Action{{Code: "check", Name: "判定"}}, actions)
+}
+
+func TestSeedFromFile_BundledCatalogCoversRoutePermissions(t *testing.T) {
+	svcCtx, _ := setupCatalogTest(t)
+	ctx := context.Background()
+
+	SeedFromFile(ctx, svcCtx, "../../../etc/permission_catalog.yaml")
+
+	// 内置目录须覆盖全部路由权限声明，否则按目录校验后无法配置对应权限
+	modules, err := svcCtx.PermissionCatalogModel.FindAll(ctx)
+	require.NoError(t, err)
+	actions := make(map[string]map[string]bool, len(modules))
+	for _, module := range modules {
+		parsed, err := module.ParseActions()
+		require.NoError(t, err)
+		actions[module.Code] = make(map[string]bool, len(parsed))
+		for _, action := range parsed {
+			actions[module.Code][action.Code] = true
+		}
+	}
+	for _, rule := range middleware.DefaultRoutePermissions {
+		assert.True(t, actions[rule.Module][rule.Action], "目录缺少 %s:%s（%s %s）", rule.Module, rule.Action, rule.Method, rule.Path)
+	}
+}
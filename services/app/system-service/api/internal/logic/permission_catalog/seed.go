@@ -0,0 +1,77 @@
+package permission_catalog
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// seedFile 权限目录初始化文件结构
+type seedFile struct {
+	Modules []seedModule `json:",optional"`
+}
+
+// seedModule 初始化文件中的模块定义
+type seedModule struct {
+	Code        string
+	Name        string
+	Description string   `json:",optional"`
+	SortOrder   int      `json:",optional"`
+	Scopes      []string `json:",optional"`
+	Actions     []types.CatalogAction
+}
+
+// SeedFromFile 从 YAML 文件初始化权限目录
+// 仅登记编码尚不存在（含已删除）的模块，不覆盖已通过接口编辑的内容；文件不存在或单个模块失败时仅记录日志
+func SeedFromFile(ctx context.Context, svcCtx *svc.ServiceContext, path string) {
+	logger := logx.WithContext(ctx)
+	if path == "" {
+		return
+	}
+
+	// 1. 读取并解析初始化文件
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			logger.Infof("权限目录初始化文件不存在，跳过: %s", path)
+			return
+		}
+		logger.Errorf("读取权限目录初始化文件失败: %v", err)
+		return
+	}
+	var file seedFile
+	if err := conf.LoadFromYamlBytes(content, &file); err != nil {
+		logger.Errorf("解析权限目录初始化文件失败: %v", err)
+		return
+	}
+
+	// 2. 逐个登记缺失的模块
+	creator := NewCreateCatalogModuleLogic(ctx, svcCtx)
+	created := 0
+	for _, module := range file.Modules {
+		existing, err := svcCtx.PermissionCatalogModel.FindOneByCodeIncludingDeleted(ctx, module.Code)
+		if err == nil && existing != nil {
+			continue
+		}
+		if _, err := creator.CreateCatalogModule(&types.CreateCatalogModuleReq{
+			Code:        module.Code,
+			Name:        module.Name,
+			Description: module.Description,
+			Actions:     module.Actions,
+			Scopes:      module.Scopes,
+			SortOrder:   module.SortOrder,
+		}); err != nil {
+			logger.Errorf("初始化权限目录模块失败: code=%s, error=%v", module.Code, err)
+			continue
+		}
+		created++
+	}
+
+	logger.Infof("权限目录初始化完成: file=%s, created=%d", path, created)
+}
@@ -0,0 +1,68 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_catalog
+
+import (
+	"context"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateCatalogModuleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 更新权限目录模块
+func NewUpdateCatalogModuleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateCatalogModuleLogic {
+	return &UpdateCatalogModuleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateCatalogModuleLogic) UpdateCatalogModule(req *types.UpdateCatalogModuleReq) (resp *types.UpdateCatalogModuleResp, err error) {
+	// 1. 查询模块
+	module, err := l.svcCtx.PermissionCatalogModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询权限目录模块失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 校验并序列化动作与数据范围
+	actionsJSON, scopesJSON, err := buildModuleDefinition(req.Actions, req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 更新模块（编码不可修改）
+	var description *string
+	if req.Description != "" {
+		description = &req.Description
+	}
+	operatorId := currentOperatorID(l.ctx)
+	module.Name = req.Name
+	module.Description = description
+	module.Actions = actionsJSON
+	module.Scopes = scopesJSON
+	module.SortOrder = req.SortOrder
+	module.UpdatedBy = &operatorId
+	module.UpdatedAt = time.Now()
+	if err := l.svcCtx.PermissionCatalogModel.Update(l.ctx, module); err != nil {
+		l.Errorf("更新权限目录模块失败: %v", err)
+		return nil, err
+	}
+
+	l.Infof("更新权限目录模块成功: id=%s, code=%s", module.Id, module.Code)
+
+	return &types.UpdateCatalogModuleResp{
+		Success: true,
+	}, nil
+}
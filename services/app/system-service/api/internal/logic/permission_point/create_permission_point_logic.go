@@ -0,0 +1,85 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_point
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	pointmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_points"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreatePermissionPointLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建权限点
+func NewCreatePermissionPointLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatePermissionPointLogic {
+	return &CreatePermissionPointLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreatePermissionPointLogic) CreatePermissionPoint(req *types.CreatePermissionPointReq) (resp *types.CreatePermissionPointResp, err error) {
+	// 1. 校验权限标识唯一性（包括已删除的记录）
+	existing, err := l.svcCtx.PermissionPointModel.FindOneByKeyIncludingDeleted(l.ctx, req.PermissionKey)
+	if err != nil && !errors.Is(err, pointmodel.ErrPermissionPointNotFound) {
+		l.Errorf("查询权限标识唯一性失败: %v", err)
+		return nil, err
+	}
+	if existing != nil {
+		l.Errorf("权限标识已存在: %s", req.PermissionKey)
+		return nil, pointmodel.ErrPermissionPointKeyExists
+	}
+
+	// 2. 校验所属模块
+	if err := validateModule(l.ctx, l.svcCtx, req.Module); err != nil {
+		return nil, err
+	}
+
+	// 3. 生成 UUID v7 主键
+	id, err := uuid.NewV7()
+	if err != nil {
+		l.Errorf("生成UUID v7失败: %v", err)
+		return nil, err
+	}
+
+	// 4. 插入数据库
+	var description *string
+	if req.Description != "" {
+		description = &req.Description
+	}
+	now := time.Now()
+	point := &pointmodel.PermissionPoint{
+		Id:            id.String(),
+		PermissionKey: req.PermissionKey,
+		Name:          req.Name,
+		Type:          req.Type,
+		Module:        req.Module,
+		Description:   description,
+		CreatedBy:     currentOperatorID(l.ctx),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if _, err := l.svcCtx.PermissionPointModel.Insert(l.ctx, point); err != nil {
+		l.Errorf("创建权限点失败: %v", err)
+		return nil, err
+	}
+
+	l.Infof("创建权限点成功: id=%s, key=%s", point.Id, point.PermissionKey)
+
+	return &types.CreatePermissionPointResp{
+		Id: point.Id,
+	}, nil
+}
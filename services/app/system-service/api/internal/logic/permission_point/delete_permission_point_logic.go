@@ -0,0 +1,61 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_point
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	pointmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_points"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeletePermissionPointLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除权限点
+func NewDeletePermissionPointLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeletePermissionPointLogic {
+	return &DeletePermissionPointLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeletePermissionPointLogic) DeletePermissionPoint(req *types.DeletePermissionPointReq) (resp *types.DeletePermissionPointResp, err error) {
+	// 1. 查询权限点
+	point, err := l.svcCtx.PermissionPointModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询权限点失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 校验未被菜单引用
+	menus, err := l.svcCtx.MenuModel.FindByPermissionKey(l.ctx, point.PermissionKey)
+	if err != nil {
+		l.Errorf("查询引用权限点的菜单失败: %v", err)
+		return nil, err
+	}
+	if len(menus) > 0 {
+		l.Errorf("权限点被菜单引用，无法删除: key=%s, menus=%d", point.PermissionKey, len(menus))
+		return nil, pointmodel.ErrPermissionPointInUse
+	}
+
+	// 3. 执行软删除
+	if err := l.svcCtx.PermissionPointModel.Delete(l.ctx, point.Id); err != nil {
+		l.Errorf("删除权限点失败: %v", err)
+		return nil, err
+	}
+
+	l.Infof("删除权限点成功: id=%s, key=%s", point.Id, point.PermissionKey)
+
+	return &types.DeletePermissionPointResp{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,40 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_point
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPermissionPointLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取权限点详情
+func NewGetPermissionPointLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPermissionPointLogic {
+	return &GetPermissionPointLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPermissionPointLogic) GetPermissionPoint(req *types.GetPermissionPointReq) (resp *types.GetPermissionPointResp, err error) {
+	point, err := l.svcCtx.PermissionPointModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询权限点失败: %v", err)
+		return nil, err
+	}
+
+	return &types.GetPermissionPointResp{
+		Data: toPermissionPoint(point),
+	}, nil
+}
@@ -0,0 +1,124 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_point
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 权限模板授予权限点的来源
+const (
+	sourcePolicyMatrix  = "policy_matrix"
+	sourceAdvancedPerms = "advanced_perms"
+)
+
+// templateScanPageSize 扫描权限模板时的分页大小
+const templateScanPageSize = 100
+
+type GetPermissionPointReferencesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询引用权限点的菜单与权限模板
+func NewGetPermissionPointReferencesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPermissionPointReferencesLogic {
+	return &GetPermissionPointReferencesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPermissionPointReferencesLogic) GetPermissionPointReferences(req *types.GetPermissionPointReferencesReq) (resp *types.GetPermissionPointReferencesResp, err error) {
+	// 1. 查询权限点
+	point, err := l.svcCtx.PermissionPointModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询权限点失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 查询绑定该权限标识的菜单
+	menus, err := l.svcCtx.MenuModel.FindByPermissionKey(l.ctx, point.PermissionKey)
+	if err != nil {
+		l.Errorf("查询引用权限点的菜单失败: %v", err)
+		return nil, err
+	}
+	menuRefs := make([]types.PermissionPointMenuRef, 0, len(menus))
+	for _, menu := range menus {
+		menuRefs = append(menuRefs, types.PermissionPointMenuRef{
+			Id:   menu.Id,
+			Name: menu.Name,
+			Code: menu.Code,
+			Type: menu.Type,
+		})
+	}
+
+	// 3. 查询授予该权限标识的权限模板
+	templateRefs, err := l.findTemplateRefs(point.PermissionKey)
+	if err != nil {
+		l.Errorf("查询授予权限点的权限模板失败: %v", err)
+		return nil, err
+	}
+
+	return &types.GetPermissionPointReferencesResp{
+		PermissionKey: point.PermissionKey,
+		Menus:         menuRefs,
+		Templates:     templateRefs,
+	}, nil
+}
+
+// findTemplateRefs 分页扫描权限模板，返回通过高级权限点或策略矩阵授予权限标识的模板
+// 判定规则与菜单可见性一致：命中已启用的高级权限点，或按 模块:动作 被策略矩阵允许
+func (l *GetPermissionPointReferencesLogic) findTemplateRefs(key string) ([]types.PermissionPointTemplateRef, error) {
+	module, action, splittable := authz.SplitPermissionKey(key)
+	refs := make([]types.PermissionPointTemplateRef, 0)
+	for page := 1; ; page++ {
+		templates, total, err := l.svcCtx.PermissionTemplateModel.List(l.ctx, &permissiontemplatemodel.ListFilter{
+			Page:     page,
+			PageSize: templateScanPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, template := range templates {
+			source := ""
+			var advanced map[string]authz.AdvancedPermEntry
+			if len(template.AdvancedPerms) > 0 && json.Unmarshal(template.AdvancedPerms, &advanced) == nil && advanced[key].Enabled {
+				source = sourceAdvancedPerms
+			} else if splittable {
+				var matrix map[string]authz.PolicyMatrixEntry
+				if err := json.Unmarshal(template.PolicyMatrix, &matrix); err != nil {
+					l.Errorf("解析策略矩阵失败: template=%s, error=%v", template.Code, err)
+					continue
+				}
+				if authz.MatrixAllows(matrix, module, action) {
+					source = sourcePolicyMatrix
+				}
+			}
+			if source == "" {
+				continue
+			}
+			refs = append(refs, types.PermissionPointTemplateRef{
+				Id:      template.Id,
+				Code:    template.Code,
+				Name:    template.Name,
+				Status:  template.Status,
+				Version: template.Version,
+				Source:  source,
+			})
+		}
+		if int64(page*templateScanPageSize) >= total || len(templates) == 0 {
+			return refs, nil
+		}
+	}
+}
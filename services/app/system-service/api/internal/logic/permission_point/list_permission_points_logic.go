@@ -0,0 +1,55 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_point
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	pointmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_points"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListPermissionPointsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询权限点列表
+func NewListPermissionPointsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPermissionPointsLogic {
+	return &ListPermissionPointsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListPermissionPointsLogic) ListPermissionPoints(req *types.ListPermissionPointsReq) (resp *types.ListPermissionPointsResp, err error) {
+	// 1. 查询权限点列表
+	points, total, err := l.svcCtx.PermissionPointModel.List(l.ctx, &pointmodel.ListFilter{
+		Keyword:  req.Keyword,
+		Type:     req.Type,
+		Module:   req.Module,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("查询权限点列表失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 转换为响应类型
+	data := make([]types.PermissionPoint, 0, len(points))
+	for _, point := range points {
+		data = append(data, toPermissionPoint(point))
+	}
+
+	return &types.ListPermissionPointsResp{
+		Total: total,
+		Data:  data,
+	}, nil
+}
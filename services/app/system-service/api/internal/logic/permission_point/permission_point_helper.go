@@ -0,0 +1,59 @@
+package permission_point
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	pointmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_points"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+)
+
+// pointTimeLayout 权限点时间字段输出格式
+const pointTimeLayout = "2006-01-02 15:04:05.000"
+
+// currentOperatorID 获取当前操作人ID，缺失时回退为系统
+func currentOperatorID(ctx context.Context) string {
+	if userID, ok := ctx.Value(contextkeys.UserIDKey).(string); ok && userID != "" {
+		return userID
+	}
+	return errorx.SystemOperatorID
+}
+
+// validateModule 校验所属模块已在权限目录中登记（目录为空时不校验）
+func validateModule(ctx context.Context, svcCtx *svc.ServiceContext, module string) error {
+	modules, err := svcCtx.PermissionCatalogModel.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	if len(modules) == 0 {
+		return nil
+	}
+	for _, item := range modules {
+		if item.Code == module {
+			return nil
+		}
+	}
+	return baseErrorx.New(errorx.ErrPermissionPointModuleInvalid, fmt.Sprintf("所属模块未在权限目录中登记: %s", module))
+}
+
+// toPermissionPoint 将权限点实体转换为 API 类型
+func toPermissionPoint(point *pointmodel.PermissionPoint) types.PermissionPoint {
+	item := types.PermissionPoint{
+		Id:            point.Id,
+		PermissionKey: point.PermissionKey,
+		Name:          point.Name,
+		Type:          point.Type,
+		Module:        point.Module,
+		CreatedAt:     point.CreatedAt.Format(pointTimeLayout),
+		UpdatedAt:     point.UpdatedAt.Format(pointTimeLayout),
+	}
+	if point.Description != nil {
+		item.Description = *point.Description
+	}
+	return item
+}
@@ -0,0 +1,194 @@
+package permission_point
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/testutil"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	catalogmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_catalog"
+	pointmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_points"
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupPermissionPointTest 创建测试用的 ServiceContext（SQLite）
+func setupPermissionPointTest(t *testing.T) (*svc.ServiceContext, *gorm.DB) {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	testutil.CreatePermissionTables(t, db)
+
+	// permission_points、permission_catalog_modules、menus 使用 MySQL 专有默认值，SQLite 下手动建表
+	for _, ddl := range []string{
+		`CREATE TABLE IF NOT EXISTS permission_points (
+			id TEXT PRIMARY KEY, permission_key TEXT NOT NULL, name TEXT NOT NULL, type TEXT NOT NULL,
+			module TEXT NOT NULL, description TEXT, created_by TEXT NOT NULL,
+			created_at DATETIME, updated_by TEXT, updated_at DATETIME, deleted_at DATETIME
+		)`,
+		`CREATE TABLE IF NOT EXISTS permission_catalog_modules (
+			id TEXT PRIMARY KEY, code TEXT NOT NULL, name TEXT NOT NULL, description TEXT,
+			actions TEXT NOT NULL, scopes TEXT NOT NULL, sort_order INTEGER NOT NULL DEFAULT 0, created_by TEXT NOT NULL,
+			created_at DATETIME, updated_by TEXT, updated_at DATETIME, deleted_at DATETIME
+		)`,
+		`CREATE TABLE IF NOT EXISTS menus (
+			id TEXT PRIMARY KEY, name TEXT NOT NULL, code TEXT NOT NULL, type TEXT NOT NULL,
+			group_id TEXT, parent_id TEXT, path TEXT, route_name TEXT, component_key TEXT,
+			external_url TEXT, open_mode TEXT, permission_key TEXT, icon TEXT,
+			visible INTEGER NOT NULL DEFAULT 1, enabled INTEGER NOT NULL DEFAULT 1,
+			"order" INTEGER NOT NULL DEFAULT 0, show_in_nav INTEGER NOT NULL DEFAULT 1,
+			cacheable INTEGER NOT NULL DEFAULT 0, created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			created_by TEXT, updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, updated_by TEXT,
+			deleted_at DATETIME
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	svcCtx := &svc.ServiceContext{
+		DB:                      db,
+		PermissionPointModel:    pointmodel.NewModel(db),
+		PermissionCatalogModel:  catalogmodel.NewModel(db),
+		PermissionTemplateModel: permissiontemplates.NewModel(db),
+		MenuModel:               menus.NewModel(db),
+	}
+	return svcCtx, db
+}
+
+// createTestPoint 通过接口逻辑创建权限点
+func createTestPoint(t *testing.T, svcCtx *svc.ServiceContext, key, pointType, module string) string {
+	resp, err := NewCreatePermissionPointLogic(context.Background(), svcCtx).CreatePermissionPoint(&types.CreatePermissionPointReq{
+		PermissionKey: key,
+		Name:          key,
+		Type:          pointType,
+		Module:        module,
+	})
+	require.NoError(t, err)
+	return resp.Id
+}
+
+// assertCodeError 断言返回指定错误码
+func assertCodeError(t *testing.T, err error, code int) {
+	require.Error(t, err)
+	codeErr, ok := err.(*baseErrorx.CodeError)
+	require.True(t, ok)
+	assert.Equal(t, code, codeErr.Code)
+}
+
+func TestCreatePermissionPoint_KeyUniqueAndModuleRegistered(t *testing.T) {
+	svcCtx, db := setupPermissionPointTest(t)
+	logic := NewCreatePermissionPointLogic(context.Background(), svcCtx)
+	createTestPoint(t, svcCtx, "user:export", pointmodel.TypeButton, "user")
+
+	_, err := logic.CreatePermissionPoint(&types.CreatePermissionPointReq{
+		PermissionKey: "user:export", Name: "导出", Type: pointmodel.TypeButton, Module: "user",
+	})
+	assertCodeError(t, err, errorx.ErrPermissionPointKeyExists)
+
+	// 目录已登记模块后，所属模块须为目录中的模块
+	now := time.Now()
+	require.NoError(t, db.Create(&catalogmodel.CatalogModule{
+		Id: "module-user", Code: "user", Name: "用户管理", Actions: datatypes.JSON(`[{"code":"read","name":"查看"}]`),
+		Scopes: datatypes.JSON(`["global"]`), CreatedBy: "system", CreatedAt: now, UpdatedAt: now,
+	}).Error)
+	_, err = logic.CreatePermissionPoint(&types.CreatePermissionPointReq{
+		PermissionKey: "metadata:view", Name: "元数据", Type: pointmodel.TypeMenu, Module: "metadata",
+	})
+	assertCodeError(t, err, errorx.ErrPermissionPointModuleInvalid)
+
+	createTestPoint(t, svcCtx, "user:import", pointmodel.TypeButton, "user")
+}
+
+func TestListPermissionPoints_SearchAndFilter(t *testing.T) {
+	svcCtx, _ := setupPermissionPointTest(t)
+	createTestPoint(t, svcCtx, "user:export", pointmodel.TypeButton, "user")
+	createTestPoint(t, svcCtx, "menu:user_list", pointmodel.TypeMenu, "menu")
+	createTestPoint(t, svcCtx, "role:read", pointmodel.TypeAPI, "role")
+	logic := NewListPermissionPointsLogic(context.Background(), svcCtx)
+
+	resp, err := logic.ListPermissionPoints(&types.ListPermissionPointsReq{Keyword: "user", Page: 1, PageSize: 20})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.Total)
+	assert.Equal(t, "menu:user_list", resp.Data[0].PermissionKey)
+	assert.Equal(t, "user:export", resp.Data[1].PermissionKey)
+
+	resp, err = logic.ListPermissionPoints(&types.ListPermissionPointsReq{Type: pointmodel.TypeAPI, Page: 1, PageSize: 20})
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, "role", resp.Data[0].Module)
+}
+
+func TestUpdatePermissionPoint_KeepsKey(t *testing.T) {
+	svcCtx, _ := setupPermissionPointTest(t)
+	id := createTestPoint(t, svcCtx, "user:export", pointmodel.TypeButton, "user")
+	ctx := context.Background()
+
+	_, err := NewUpdatePermissionPointLogic(ctx, svcCtx).UpdatePermissionPoint(&types.UpdatePermissionPointReq{
+		Id: id, Name: "导出用户", Type: pointmodel.TypeAPI, Module: "user", Description: "导出用户列表",
+	})
+	require.NoError(t, err)
+
+	resp, err := NewGetPermissionPointLogic(ctx, svcCtx).GetPermissionPoint(&types.GetPermissionPointReq{Id: id})
+	require.NoError(t, err)
+	assert.Equal(t, "user:export", resp.Data.PermissionKey)
+	assert.Equal(t, "导出用户", resp.Data.Name)
+	assert.Equal(t, pointmodel.TypeAPI, resp.Data.Type)
+	assert.Equal(t, "导出用户列表", resp.Data.Description)
+}
+
+func TestPermissionPoint_ReferencesAndDeleteGuard(t *testing.T) {
+	svcCtx, db := setupPermissionPointTest(t)
+	ctx := context.Background()
+	exportId := createTestPoint(t, svcCtx, "user:export", pointmodel.TypeButton, "user")
+	unusedId := createTestPoint(t, svcCtx, "role:export", pointmodel.TypeButton, "role")
+
+	key := "user:export"
+	require.NoError(t, db.Create(&menus.Menu{Id: "menu-export", Name: "导出按钮", Code: "user_export", Type: "button", PermissionKey: &key}).Error)
+	now := time.Now()
+	for _, template := range []*permissiontemplates.PermissionTemplate{
+		{Id: "tpl-matrix", Code: "user_admin", Name: "用户管理员", Status: permissiontemplates.StatusPublished, Version: 2,
+			PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read","export"],"scope":"global"}}`), CreatedBy: "system", CreatedAt: now, UpdatedAt: now},
+		{Id: "tpl-advanced", Code: "exporter", Name: "导出员", Status: permissiontemplates.StatusDraft, Version: 1,
+			PolicyMatrix: datatypes.JSON(`{"audit":{"actions":["read"]}}`), AdvancedPerms: datatypes.JSON(`{"user:export":{"enabled":true}}`),
+			CreatedBy: "system", CreatedAt: now, UpdatedAt: now.Add(time.Second)},
+		{Id: "tpl-other", Code: "viewer", Name: "查看者", Status: permissiontemplates.StatusPublished, Version: 1,
+			PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read"]}}`), AdvancedPerms: datatypes.JSON(`{"user:export":{"enabled":false}}`),
+			CreatedBy: "system", CreatedAt: now, UpdatedAt: now},
+	} {
+		require.NoError(t, db.Create(template).Error)
+	}
+
+	resp, err := NewGetPermissionPointReferencesLogic(ctx, svcCtx).GetPermissionPointReferences(&types.GetPermissionPointReferencesReq{Id: exportId})
+	require.NoError(t, err)
+	assert.Equal(t, "user:export", resp.PermissionKey)
+	require.Len(t, resp.Menus, 1)
+	assert.Equal(t, "user_export", resp.Menus[0].Code)
+	sources := make(map[string]string, len(resp.Templates))
+	for _, ref := range resp.Templates {
+		sources[ref.Code] = ref.Source
+	}
+	assert.Equal(t, map[string]string{"user_admin": sourcePolicyMatrix, "exporter": sourceAdvancedPerms}, sources)
+
+	deleteLogic := NewDeletePermissionPointLogic(ctx, svcCtx)
+	_, err = deleteLogic.DeletePermissionPoint(&types.DeletePermissionPointReq{Id: exportId})
+	assertCodeError(t, err, errorx.ErrPermissionPointInUse)
+
+	_, err = deleteLogic.DeletePermissionPoint(&types.DeletePermissionPointReq{Id: unusedId})
+	require.NoError(t, err)
+	_, err = svcCtx.PermissionPointModel.FindOne(ctx, unusedId)
+	assertCodeError(t, err, errorx.ErrPermissionPointNotFound)
+}
@@ -0,0 +1,68 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_point
+
+import (
+	"context"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdatePermissionPointLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 更新权限点
+func NewUpdatePermissionPointLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdatePermissionPointLogic {
+	return &UpdatePermissionPointLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdatePermissionPointLogic) UpdatePermissionPoint(req *types.UpdatePermissionPointReq) (resp *types.UpdatePermissionPointResp, err error) {
+	// 1. 查询权限点
+	point, err := l.svcCtx.PermissionPointModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询权限点失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 校验所属模块
+	if req.Module != point.Module {
+		if err := validateModule(l.ctx, l.svcCtx, req.Module); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. 更新字段（权限标识被菜单与模板引用，不可修改）
+	var description *string
+	if req.Description != "" {
+		description = &req.Description
+	}
+	operatorId := currentOperatorID(l.ctx)
+	point.Name = req.Name
+	point.Type = req.Type
+	point.Module = req.Module
+	point.Description = description
+	point.UpdatedBy = &operatorId
+	point.UpdatedAt = time.Now()
+	if err := l.svcCtx.PermissionPointModel.Update(l.ctx, point); err != nil {
+		l.Errorf("更新权限点失败: %v", err)
+		return nil, err
+	}
+
+	l.Infof("更新权限点成功: id=%s, key=%s", point.Id, point.PermissionKey)
+
+	return &types.UpdatePermissionPointResp{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package permission_template
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+)
+
+// catalogWildcard 通配符，匹配全部模块或全部动作，不受权限目录约束
+const catalogWildcard = "*"
+
+// validatePolicyMatrixAgainstCatalog 校验策略矩阵中的模块、动作与数据范围均已在权限目录中登记
+// 权限目录为空时（尚未初始化）不做校验，以兼容存量部署
+func validatePolicyMatrixAgainstCatalog(ctx context.Context, svcCtx *svc.ServiceContext, matrix map[string]types.PolicyMatrixEntry) error {
+	// 1. 加载权限目录
+	modules, err := svcCtx.PermissionCatalogModel.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	if len(modules) == 0 {
+		return nil
+	}
+
+	type catalogEntry struct {
+		actions map[string]struct{}
+		scopes  map[string]struct{}
+	}
+	catalog := make(map[string]catalogEntry, len(modules))
+	for _, module := range modules {
+		actions, err := module.ParseActions()
+		if err != nil {
+			return err
+		}
+		scopes, err := module.ParseScopes()
+		if err != nil {
+			return err
+		}
+		entry := catalogEntry{
+			actions: make(map[string]struct{}, len(actions)),
+			scopes:  make(map[string]struct{}, len(scopes)),
+		}
+		for _, action := range actions {
+			entry.actions[action.Code] = struct{}{}
+		}
+		for _, scope := range scopes {
+			entry.scopes[scope] = struct{}{}
+		}
+		catalog[module.Code] = entry
+	}
+
+	// 2. 按模块编码顺序逐项校验，保证错误信息稳定
+	moduleCodes := make([]string, 0, len(matrix))
+	for code := range matrix {
+		moduleCodes = append(moduleCodes, code)
+	}
+	sort.Strings(moduleCodes)
+
+	for _, code := range moduleCodes {
+		if code == catalogWildcard {
+			continue
+		}
+		entry, ok := catalog[code]
+		if !ok {
+			return baseErrorx.New(errorx.ErrPermissionTemplateInvalidPolicyMatrix, fmt.Sprintf("策略矩阵模块未在权限目录中登记: %s", code))
+		}
+		for _, action := range matrix[code].Actions {
+			if action == catalogWildcard {
+				continue
+			}
+			if _, ok := entry.actions[action]; !ok {
+				return baseErrorx.New(errorx.ErrPermissionTemplateInvalidPolicyMatrix, fmt.Sprintf("模块 %s 不支持动作: %s", code, action))
+			}
+		}
+		if scope := matrix[code].Scope; scope != "" {
+			if _, ok := entry.scopes[scope]; !ok {
+				return baseErrorx.New(errorx.ErrPermissionTemplateInvalidPolicyMatrix, fmt.Sprintf("模块 %s 不支持数据范围: %s", code, scope))
+			}
+		}
+	}
+	return nil
+}
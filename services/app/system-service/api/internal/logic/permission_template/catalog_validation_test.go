@@ -0,0 +1,157 @@
+package permission_template
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/config"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	catalogmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_catalog"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+// newTestCatalog 创建包含 user、audit 两个模块的权限目录
+func newTestCatalog() *MockPermissionCatalogModel {
+	return &MockPermissionCatalogModel{Modules: []*catalogmodel.CatalogModule{
+		{
+			Id:      "module-user",
+			Code:    "user",
+			Actions: datatypes.JSON(`[{"code":"create","name":"新建"},{"code":"read","name":"查看"}]`),
+			Scopes:  datatypes.JSON(`["global","organization"]`),
+		},
+		{
+			Id:      "module-audit",
+			Code:    "audit",
+			Actions: datatypes.JSON(`[{"code":"read","name":"查看"}]`),
+			Scopes:  datatypes.JSON(`["global"]`),
+		},
+	}}
+}
+
+// assertInvalidPolicyMatrix 断言返回策略矩阵格式错误
+func assertInvalidPolicyMatrix(t *testing.T, err error) {
+	require.Error(t, err)
+	codeErr, ok := err.(*baseErrorx.CodeError)
+	require.True(t, ok)
+	assert.Equal(t, errorx.ErrPermissionTemplateInvalidPolicyMatrix, codeErr.Code)
+}
+
+func TestValidatePolicyMatrixAgainstCatalog(t *testing.T) {
+	svcCtx := &svc.ServiceContext{PermissionCatalogModel: newTestCatalog()}
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		matrix  map[string]types.PolicyMatrixEntry
+		wantErr string
+	}{
+		{
+			name: "登记的模块与动作",
+			matrix: map[string]types.PolicyMatrixEntry{
+				"user":  {Actions: []string{"create", "read"}, Scope: "organization"},
+				"audit": {Actions: []string{"read"}},
+			},
+		},
+		{
+			name: "通配符不受目录约束",
+			matrix: map[string]types.PolicyMatrixEntry{
+				"*":    {Actions: []string{"*"}, Scope: "project"},
+				"user": {Actions: []string{"*"}},
+			},
+		},
+		{
+			name:    "未登记的模块",
+			matrix:  map[string]types.PolicyMatrixEntry{"metadata": {Actions: []string{"read"}}},
+			wantErr: "metadata",
+		},
+		{
+			name:    "模块不支持的动作",
+			matrix:  map[string]types.PolicyMatrixEntry{"user": {Actions: []string{"read", "publish"}}},
+			wantErr: "publish",
+		},
+		{
+			name:    "模块不支持的数据范围",
+			matrix:  map[string]types.PolicyMatrixEntry{"audit": {Actions: []string{"read"}, Scope: "domain"}},
+			wantErr: "domain",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePolicyMatrixAgainstCatalog(ctx, svcCtx, tt.matrix)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assertInvalidPolicyMatrix(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidatePolicyMatrixAgainstCatalog_EmptyCatalogSkips(t *testing.T) {
+	svcCtx := &svc.ServiceContext{PermissionCatalogModel: &MockPermissionCatalogModel{}}
+
+	err := validatePolicyMatrixAgainstCatalog(context.Background(), svcCtx, map[string]types.PolicyMatrixEntry{
+		"anything": {Actions: []string{"whatever"}, Scope: "unknown"},
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestCreatePermissionTemplate_RejectsModuleOutsideCatalog(t *testing.T) {
+	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOneByCodeIncludingDeleted", mock.Anything, "meta_tpl").Return(nil, permissiontemplatemodel.ErrPermissionTemplateNotFound)
+	svcCtx := &svc.ServiceContext{
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
+		PermissionCatalogModel:          newTestCatalog(),
+	}
+
+	resp, err := NewCreatePermissionTemplateLogic(context.Background(), svcCtx).CreatePermissionTemplate(&types.CreatePermissionTemplateReq{
+		Name: "元数据模板",
+		Code: "meta_tpl",
+		PolicyMatrix: map[string]types.PolicyMatrixEntry{
+			"metadata": {Actions: []string{"read"}},
+		},
+	})
+
+	assertInvalidPolicyMatrix(t, err)
+	assert.Nil(t, resp)
+	mockModel.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+}
+
+func TestPublishPermissionTemplate_RejectsActionRemovedFromCatalog(t *testing.T) {
+	// 草稿保存后目录移除了 user:delete，发布时应被拦截
+	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOne", mock.Anything, "draft-id").Return(&permissiontemplatemodel.PermissionTemplate{
+		Id:           "draft-id",
+		Code:         "user_admin",
+		Status:       permissiontemplatemodel.StatusDraft,
+		Version:      1,
+		PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read","delete"],"scope":"global"}}`),
+	}, nil)
+	svcCtx := &svc.ServiceContext{
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
+		PermissionCatalogModel:          newTestCatalog(),
+	}
+
+	resp, err := NewPublishPermissionTemplateLogic(context.Background(), svcCtx).PublishPermissionTemplate(&types.PublishPermissionTemplateReq{Id: "draft-id"})
+
+	assertInvalidPolicyMatrix(t, err)
+	assert.Nil(t, resp)
+	mockModel.AssertNotCalled(t, "UpdateVersionWithStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
 )
 
 func TestClonePermissionTemplate_NormalClone(t *testing.T) {
@@ -45,8 +46,10 @@ func TestClonePermissionTemplate_NormalClone(t *testing.T) {
 	})).Return(&permissiontemplatemodel.PermissionTemplate{Id: "new-uuid-v7"}, nil)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	ctx := context.Background()
@@ -90,8 +93,10 @@ func TestClonePermissionTemplate_CodeConflict(t *testing.T) {
 	mockModel.On("FindOneByCodeIncludingDeleted", mock.Anything, "existing_code").Return(existingTemplate, nil)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	ctx := context.Background()
@@ -117,8 +122,10 @@ func TestClonePermissionTemplate_SourceNotFound(t *testing.T) {
 	mockModel.On("FindOne", mock.Anything, "non-existent-id").Return(nil, permissiontemplatemodel.ErrPermissionTemplateNotFound)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	ctx := context.Background()
@@ -144,12 +151,22 @@ func TestDeletePermissionTemplate_NormalDelete(t *testing.T) {
 	req := &types.DeletePermissionTemplateReq{Id: templateId}
 
 	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOne", mock.Anything, templateId).Return(&permissiontemplatemodel.PermissionTemplate{
+		Id:           templateId,
+		Name:         "待删除模板",
+		Code:         "to_delete",
+		Status:       permissiontemplatemodel.StatusDraft,
+		PolicyMatrix: datatypes.JSON(`{"user": {"actions": ["read"], "scope": "organization"}}`),
+		Version:      1,
+	}, nil)
 	mockModel.On("GetUsageStats", mock.Anything, templateId).Return(usageStats, nil)
 	mockModel.On("Delete", mock.Anything, templateId).Return(nil)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	ctx := context.Background()
@@ -175,11 +192,21 @@ func TestDeletePermissionTemplate_TemplateInUse(t *testing.T) {
 	req := &types.DeletePermissionTemplateReq{Id: templateId}
 
 	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOne", mock.Anything, templateId).Return(&permissiontemplatemodel.PermissionTemplate{
+		Id:           templateId,
+		Name:         "待删除模板",
+		Code:         "to_delete",
+		Status:       permissiontemplatemodel.StatusDraft,
+		PolicyMatrix: datatypes.JSON(`{"user": {"actions": ["read"], "scope": "organization"}}`),
+		Version:      1,
+	}, nil)
 	mockModel.On("GetUsageStats", mock.Anything, templateId).Return(usageStats, nil)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	ctx := context.Background()
@@ -197,20 +224,16 @@ func TestDeletePermissionTemplate_TemplateInUse(t *testing.T) {
 func TestDeletePermissionTemplate_TemplateNotFound(t *testing.T) {
 	templateId := "non-existent-id"
 
-	usageStats := &permissiontemplatemodel.UsageStats{
-		UsedByRoleCount: 0,
-		LastAppliedAt:   nil,
-	}
-
 	req := &types.DeletePermissionTemplateReq{Id: templateId}
 
 	mockModel := new(MockPermissionTemplateModel)
-	mockModel.On("GetUsageStats", mock.Anything, templateId).Return(usageStats, nil)
-	mockModel.On("Delete", mock.Anything, templateId).Return(permissiontemplatemodel.ErrPermissionTemplateNotFound)
+	mockModel.On("FindOne", mock.Anything, templateId).Return(nil, permissiontemplatemodel.ErrPermissionTemplateNotFound)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	ctx := context.Background()
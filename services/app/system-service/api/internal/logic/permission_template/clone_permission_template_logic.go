@@ -5,10 +5,12 @@ package permission_template
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
 
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -62,10 +64,12 @@ func (l *ClonePermissionTemplateLogic) ClonePermissionTemplate(req *types.CloneP
 		Description:     sourceTemplate.Description,
 		Status:          permissiontemplatemodel.StatusDraft, // 复制的模板默认为草稿状态
 		ScopeSuggestion: sourceTemplate.ScopeSuggestion,
-		PolicyMatrix:    sourceTemplate.PolicyMatrix,  // 直接复制 JSON
-		AdvancedPerms:   sourceTemplate.AdvancedPerms, // 直接复制 JSON
-		Version:         1,                            // 新模板版本从 1 开始
-		CreatedBy:       "system",                     // TODO: 从 context 中获取当前用户ID
+		PolicyMatrix:    sourceTemplate.PolicyMatrix,   // 直接复制 JSON
+		AdvancedPerms:   sourceTemplate.AdvancedPerms,  // 直接复制 JSON
+		ParentId:        sourceTemplate.ParentId,       // 复制继承关系，副本同样随父模板变化
+		PolicyRemovals:  sourceTemplate.PolicyRemovals, // 直接复制 JSON
+		Version:         1,                             // 新模板版本从 1 开始
+		CreatedBy:       "system",                      // TODO: 从 context 中获取当前用户ID
 	}
 
 	// 5. 插入新模板
@@ -75,6 +79,14 @@ func (l *ClonePermissionTemplateLogic) ClonePermissionTemplate(req *types.CloneP
 		return nil, err
 	}
 
+	// 6. 记录审计日志（记录在新模板上，备注来源模板）
+	recordTemplateAudit(l.ctx, l.svcCtx, &templateAuditEntry{
+		TemplateId:    result.Id,
+		OperationType: permission_template_audit_logs.OperationClone,
+		After:         result,
+		Remark:        fmt.Sprintf("复制自模板 %s（%s）", sourceTemplate.Code, sourceTemplate.Id),
+	})
+
 	logx.Infof("克隆权限模板成功: 源模板=%s, 新模板=%s, 新编码=%s", req.Id, result.Id, req.Code)
 
 	return &types.ClonePermissionTemplateResp{
@@ -10,6 +10,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
 
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -48,6 +49,18 @@ func (l *CreatePermissionTemplateLogic) CreatePermissionTemplate(req *types.Crea
 		return nil, permissiontemplatemodel.ErrPermissionTemplateCodeExists
 	}
 
+	// 2.1 校验策略矩阵符合权限目录
+	if err := validatePolicyMatrixAgainstCatalog(l.ctx, l.svcCtx, req.PolicyMatrix); err != nil {
+		l.Errorf("策略矩阵校验失败: %v", err)
+		return nil, err
+	}
+
+	// 2.2 校验父模板存在且继承关系无循环
+	if err := validateTemplateParent(l.ctx, l.svcCtx, "", req.ParentId); err != nil {
+		l.Errorf("父模板校验失败: %v", err)
+		return nil, err
+	}
+
 	// 3. 序列化 JSON 字段
 	policyMatrixJSON, err := json.Marshal(req.PolicyMatrix)
 	if err != nil {
@@ -55,6 +68,12 @@ func (l *CreatePermissionTemplateLogic) CreatePermissionTemplate(req *types.Crea
 		return nil, err
 	}
 
+	policyRemovalsJSON, err := marshalPolicyRemovals(req.PolicyRemovals)
+	if err != nil {
+		l.Errorf("序列化策略移除项失败: %v", err)
+		return nil, err
+	}
+
 	var advancedPermsJSON []byte
 	if req.AdvancedPerms != nil {
 		advancedPermsJSON, err = json.Marshal(req.AdvancedPerms)
@@ -85,6 +104,8 @@ func (l *CreatePermissionTemplateLogic) CreatePermissionTemplate(req *types.Crea
 		ScopeSuggestion: scopeSuggestion,
 		PolicyMatrix:    policyMatrixJSON,
 		AdvancedPerms:   advancedPermsJSON,
+		ParentId:        optionalString(req.ParentId),
+		PolicyRemovals:  policyRemovalsJSON,
 		Version:         1,
 		CreatedBy:       "system", // TODO: 从 context 中获取当前用户ID
 	}
@@ -96,6 +117,13 @@ func (l *CreatePermissionTemplateLogic) CreatePermissionTemplate(req *types.Crea
 		return nil, err
 	}
 
+	// 6. 记录审计日志
+	recordTemplateAudit(l.ctx, l.svcCtx, &templateAuditEntry{
+		TemplateId:    result.Id,
+		OperationType: permission_template_audit_logs.OperationCreate,
+		After:         result,
+	})
+
 	logx.Infof("创建权限模板成功: id=%s, code=%s, name=%s", result.Id, result.Code, result.Name)
 
 	return &types.CreatePermissionTemplateResp{
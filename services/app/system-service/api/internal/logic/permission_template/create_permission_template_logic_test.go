@@ -58,8 +58,12 @@ func TestCreatePermissionTemplate_NormalCreation(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -101,8 +105,12 @@ func TestCreatePermissionTemplate_CodeConflict(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -138,8 +146,12 @@ func TestCreatePermissionTemplate_EmptyPolicyMatrix(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -180,8 +192,12 @@ func TestCreatePermissionTemplate_WithoutAdvancedPerms(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -217,8 +233,12 @@ func TestCreatePermissionTemplate_DatabaseError(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -252,8 +272,12 @@ func TestCreatePermissionTemplate_CodeQueryError(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -299,8 +323,12 @@ func TestCreatePermissionTemplate_WithAllScopes(t *testing.T) {
 
 			// 创建 ServiceContext
 			svcCtx := &svc.ServiceContext{
-				Config:                  config.Config{},
-				PermissionTemplateModel: mockModel,
+				Config:                          config.Config{},
+				PermissionTemplateModel:         mockModel,
+				PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+				PermissionCatalogModel:          &MockPermissionCatalogModel{},
+				PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+				PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 			}
 
 			// 创建 Logic
@@ -5,10 +5,13 @@ package permission_template
 
 import (
 	"context"
+	"errors"
 
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
+	permissiontemplatedrafts "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_drafts"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -28,26 +31,61 @@ func NewDeletePermissionTemplateLogic(ctx context.Context, svcCtx *svc.ServiceCo
 }
 
 func (l *DeletePermissionTemplateLogic) DeletePermissionTemplate(req *types.DeletePermissionTemplateReq) (resp *types.DeletePermissionTemplateResp, err error) {
-	// 1. 查询模板使用统计
+	// 1. 查询模板（保留删除前快照用于审计）
+	template, err := l.svcCtx.PermissionTemplateModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询权限模板失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 查询模板使用统计
 	stats, err := l.svcCtx.PermissionTemplateModel.GetUsageStats(l.ctx, req.Id)
 	if err != nil {
 		l.Errorf("查询模板使用统计失败: %v", err)
 		return nil, err
 	}
 
-	// 2. 校验模板未被角色引用
+	// 3. 校验模板未被角色引用
 	if stats.UsedByRoleCount > 0 {
 		l.Errorf("模板正在被 %d 个角色引用，无法删除", stats.UsedByRoleCount)
 		return nil, permissiontemplatemodel.ErrPermissionTemplateInUse
 	}
 
-	// 3. 执行软删除
+	// 3.1 校验模板未被子模板继承（删除后子模板将失去继承的权限）
+	children, err := l.svcCtx.PermissionTemplateModel.FindByParentId(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询子模板失败: %v", err)
+		return nil, err
+	}
+	if len(children) > 0 {
+		l.Errorf("模板正在被 %d 个子模板继承，无法删除", len(children))
+		return nil, permissiontemplatemodel.ErrPermissionTemplateHasChildren
+	}
+
+	// 4. 执行软删除
 	err = l.svcCtx.PermissionTemplateModel.Delete(l.ctx, req.Id)
 	if err != nil {
 		l.Errorf("删除权限模板失败: %v", err)
 		return nil, err
 	}
 
+	// 4.1 清理工作草稿（模板已删除，草稿不再可发布）
+	if err := l.svcCtx.PermissionTemplateDraftModel.Delete(l.ctx, req.Id); err != nil && !errors.Is(err, permissiontemplatedrafts.ErrTemplateDraftNotFound) {
+		l.Errorf("删除权限模板工作草稿失败: %v", err)
+	}
+
+	// 5. 记录审计日志
+	recordTemplateAudit(l.ctx, l.svcCtx, &templateAuditEntry{
+		TemplateId:    req.Id,
+		OperationType: permission_template_audit_logs.OperationDelete,
+		Before:        template,
+	})
+
+	// 6. 使全部用户授权快照失效
+	if err := l.svcCtx.PermissionCache.InvalidateAll(l.ctx); err != nil {
+		l.Errorf("清除授权快照缓存失败: %v", err)
+	}
+
 	logx.Infof("删除权限模板成功: id=%s", req.Id)
 
 	return &types.DeletePermissionTemplateResp{
@@ -0,0 +1,62 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_template
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DiffPermissionTemplateVersionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDiffPermissionTemplateVersionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DiffPermissionTemplateVersionsLogic {
+	return &DiffPermissionTemplateVersionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DiffPermissionTemplateVersionsLogic) DiffPermissionTemplateVersions(req *types.DiffPermissionTemplateVersionsReq) (resp *types.DiffPermissionTemplateVersionsResp, err error) {
+	// 1. 查询起止版本快照
+	fromSnapshot, err := l.svcCtx.PermissionTemplateVersionModel.FindOne(l.ctx, req.Id, req.From)
+	if err != nil {
+		l.Errorf("查询起始版本失败: version=%d, error=%v", req.From, err)
+		return nil, err
+	}
+	toSnapshot, err := l.svcCtx.PermissionTemplateVersionModel.FindOne(l.ctx, req.Id, req.To)
+	if err != nil {
+		l.Errorf("查询目标版本失败: version=%d, error=%v", req.To, err)
+		return nil, err
+	}
+
+	// 2. 解析策略内容
+	fromMatrix, fromPerms, err := versionPolicy(fromSnapshot)
+	if err != nil {
+		l.Errorf("解析起始版本失败: %v", err)
+		return nil, err
+	}
+	toMatrix, toPerms, err := versionPolicy(toSnapshot)
+	if err != nil {
+		l.Errorf("解析目标版本失败: %v", err)
+		return nil, err
+	}
+
+	// 3. 按模块和权限点计算差异
+	return &types.DiffPermissionTemplateVersionsResp{
+		TemplateId:    req.Id,
+		FromVersion:   req.From,
+		ToVersion:     req.To,
+		Modules:       diffPolicyMatrix(fromMatrix, toMatrix),
+		AdvancedPerms: diffAdvancedPerms(fromPerms, toPerms),
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -41,6 +42,12 @@ func (l *DisablePermissionTemplateLogic) DisablePermissionTemplate(req *types.Di
 		return nil, permissiontemplatemodel.ErrPermissionTemplateNotPublished
 	}
 
+	// 2.1 失去权限的用户数超过阈值时，校验影响预览的确认令牌
+	changed := disabledState(template)
+	if err := checkImpactAck(l.ctx, l.svcCtx, template, changed, ImpactOperationDisable, req.AckToken); err != nil {
+		return nil, err
+	}
+
 	// 3. 更新状态为已停用
 	err = l.svcCtx.PermissionTemplateModel.UpdateStatus(l.ctx, req.Id, permissiontemplatemodel.StatusDisabled)
 	if err != nil {
@@ -48,9 +55,41 @@ func (l *DisablePermissionTemplateLogic) DisablePermissionTemplate(req *types.Di
 		return nil, err
 	}
 
+	// 4. 记录审计日志
+	recordTemplateAudit(l.ctx, l.svcCtx, &templateAuditEntry{
+		TemplateId:    req.Id,
+		OperationType: permission_template_audit_logs.OperationDisable,
+		Before:        template,
+		After:         changed,
+	})
+
+	// 5. 使全部用户授权快照失效
+	if err := l.svcCtx.PermissionCache.InvalidateAll(l.ctx); err != nil {
+		l.Errorf("清除授权快照缓存失败: %v", err)
+	}
+
+	// 6. 查询受影响的角色（停用后这些角色不再授予权限，查询失败不影响停用结果）
+	affectedRoles := make([]types.AffectedRole, 0)
+	roleList, err := l.svcCtx.RoleModel.FindByTemplateId(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询模板关联角色失败: %v", err)
+	}
+	for _, role := range roleList {
+		affectedRoles = append(affectedRoles, types.AffectedRole{
+			Id:              role.Id,
+			Code:            role.Code,
+			Name:            role.Name,
+			TemplateVersion: role.TemplateVersion,
+		})
+	}
+	if len(affectedRoles) > 0 {
+		l.Infof("停用权限模板影响 %d 个角色: code=%s", len(affectedRoles), template.Code)
+	}
+
 	logx.Infof("停用权限模板成功: id=%s, code=%s", req.Id, template.Code)
 
 	return &types.DisablePermissionTemplateResp{
-		Success: true,
+		Success:       true,
+		AffectedRoles: affectedRoles,
 	}, nil
 }
@@ -0,0 +1,68 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_template
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
+	permissiontemplatedrafts "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_drafts"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DiscardPermissionTemplateDraftLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDiscardPermissionTemplateDraftLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DiscardPermissionTemplateDraftLogic {
+	return &DiscardPermissionTemplateDraftLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DiscardPermissionTemplateDraftLogic) DiscardPermissionTemplateDraft(req *types.DiscardPermissionTemplateDraftReq) (resp *types.DiscardPermissionTemplateDraftResp, err error) {
+	// 1. 查询模板
+	template, err := l.svcCtx.PermissionTemplateModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询权限模板失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 查询工作草稿
+	draft, err := l.svcCtx.PermissionTemplateDraftModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		if !errors.Is(err, permissiontemplatedrafts.ErrTemplateDraftNotFound) {
+			l.Errorf("查询权限模板工作草稿失败: %v", err)
+		}
+		return nil, err
+	}
+
+	// 3. 删除工作草稿（已发布版本保持不变）
+	if err := l.svcCtx.PermissionTemplateDraftModel.Delete(l.ctx, req.Id); err != nil {
+		l.Errorf("删除权限模板工作草稿失败: %v", err)
+		return nil, err
+	}
+
+	// 4. 记录审计日志（草稿内容 -> 已发布内容）
+	recordTemplateAudit(l.ctx, l.svcCtx, &templateAuditEntry{
+		TemplateId:    req.Id,
+		OperationType: permission_template_audit_logs.OperationDiscardDraft,
+		Before:        applyDraft(template, draft),
+		After:         template,
+	})
+
+	logx.Infof("放弃权限模板工作草稿成功: id=%s, baseVersion=%d", req.Id, draft.BaseVersion)
+
+	return &types.DiscardPermissionTemplateDraftResp{
+		Success: true,
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -48,6 +49,21 @@ func (l *EnablePermissionTemplateLogic) EnablePermissionTemplate(req *types.Enab
 		return nil, err
 	}
 
+	// 4. 记录审计日志
+	changed := *template
+	changed.Status = permissiontemplatemodel.StatusPublished
+	recordTemplateAudit(l.ctx, l.svcCtx, &templateAuditEntry{
+		TemplateId:    req.Id,
+		OperationType: permission_template_audit_logs.OperationEnable,
+		Before:        template,
+		After:         &changed,
+	})
+
+	// 5. 使全部用户授权快照失效
+	if err := l.svcCtx.PermissionCache.InvalidateAll(l.ctx); err != nil {
+		l.Errorf("清除授权快照缓存失败: %v", err)
+	}
+
 	logx.Infof("重新启用权限模板成功: id=%s, code=%s", req.Id, template.Code)
 
 	return &types.EnablePermissionTemplateResp{
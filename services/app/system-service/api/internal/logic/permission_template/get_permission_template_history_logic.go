@@ -0,0 +1,113 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_template
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/datatypes"
+)
+
+type GetPermissionTemplateHistoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetPermissionTemplateHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPermissionTemplateHistoryLogic {
+	return &GetPermissionTemplateHistoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPermissionTemplateHistoryLogic) GetPermissionTemplateHistory(req *types.GetPermissionTemplateHistoryReq) (resp *types.GetPermissionTemplateHistoryResp, err error) {
+	// 1. 查询审计日志（不校验模板是否存在，已删除的模板仍可查看历史）
+	logs, total, err := l.svcCtx.PermissionTemplateAuditLogModel.FindList(l.ctx, &permission_template_audit_logs.FindListReq{
+		TemplateId:    req.Id,
+		OperationType: req.OperationType,
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("查询权限模板审计日志失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 转换为响应类型（操作人名称在本次查询内缓存）
+	operatorNames := make(map[string]string)
+	items := make([]types.PermissionTemplateAuditLog, 0, len(logs))
+	for _, log := range logs {
+		items = append(items, l.toAuditLog(log, operatorNames))
+	}
+
+	return &types.GetPermissionTemplateHistoryResp{
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Logs:     items,
+	}, nil
+}
+
+// toAuditLog 将 Model 层的审计日志转换为响应类型
+func (l *GetPermissionTemplateHistoryLogic) toAuditLog(log *permission_template_audit_logs.PermissionTemplateAuditLog, operatorNames map[string]string) types.PermissionTemplateAuditLog {
+	item := types.PermissionTemplateAuditLog{
+		Id:            log.Id,
+		TemplateId:    log.TemplateId,
+		OperationType: log.OperationType,
+		Version:       log.Version,
+		Changes:       auditObject(log.Changes),
+		OldValue:      auditObject(log.OldValue),
+		NewValue:      auditObject(log.NewValue),
+		CreatedAt:     log.CreatedAt.Format("2006-01-02 15:04:05.000"),
+	}
+	if log.OperatorId != nil {
+		item.OperatorId = *log.OperatorId
+	}
+	if log.OperatorName != nil {
+		item.OperatorName = *log.OperatorName
+	} else if item.OperatorId != "" {
+		item.OperatorName = l.operatorName(item.OperatorId, operatorNames)
+	}
+	if log.Remark != nil {
+		item.Remark = *log.Remark
+	}
+	return item
+}
+
+// operatorName 通过 UserModel 查询操作人名称；用户不存在时返回空
+func (l *GetPermissionTemplateHistoryLogic) operatorName(operatorId string, cache map[string]string) string {
+	if operatorId == errorx.SystemOperatorID {
+		return errorx.SystemOperatorName
+	}
+	if name, ok := cache[operatorId]; ok {
+		return name
+	}
+	name := ""
+	if user, err := l.svcCtx.UserModel.FindOne(l.ctx, operatorId); err == nil && user != nil {
+		name = user.Name
+	}
+	cache[operatorId] = name
+	return name
+}
+
+// auditObject 解析审计 JSON 对象，空值返回 nil
+func auditObject(data datatypes.JSON) map[string]interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	var value map[string]interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+	return value
+}
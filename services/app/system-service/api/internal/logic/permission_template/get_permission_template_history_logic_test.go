@@ -0,0 +1,168 @@
+package permission_template
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/config"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestUpdatePermissionTemplate_RecordsPolicyMatrixDiff(t *testing.T) {
+	templateId := "template-id"
+	existingTemplate := &permissiontemplatemodel.PermissionTemplate{
+		Id:            templateId,
+		Name:          "审计模板",
+		Code:          "audit_template",
+		Status:        permissiontemplatemodel.StatusDraft,
+		PolicyMatrix:  datatypes.JSON(`{"user": {"actions": ["read"], "scope": "organization"}, "menu": {"actions": ["read"], "scope": "global"}}`),
+		AdvancedPerms: datatypes.JSON(`{"export": {"enabled": false, "config": null}}`),
+		Version:       1,
+	}
+
+	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOne", mock.Anything, templateId).Return(existingTemplate, nil)
+	mockModel.On("Update", mock.Anything, mock.Anything).Return(nil)
+	auditModel := &MockPermissionTemplateAuditLogModel{}
+
+	svcCtx := &svc.ServiceContext{
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: auditModel,
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
+	}
+	ctx := context.WithValue(context.Background(), contextkeys.UserIDKey, "operator-id")
+
+	_, err := NewUpdatePermissionTemplateLogic(ctx, svcCtx).UpdatePermissionTemplate(&types.UpdatePermissionTemplateReq{
+		Id:   templateId,
+		Name: "审计模板",
+		Code: "audit_template",
+		PolicyMatrix: map[string]types.PolicyMatrixEntry{
+			"user": {Actions: []string{"read", "update"}, Scope: "organization"},
+			"menu": {Actions: []string{"read"}, Scope: "global"},
+		},
+		AdvancedPerms: map[string]types.AdvancedPermEntry{
+			"export": {Enabled: true},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, auditModel.Logs, 1)
+	log := auditModel.Logs[0]
+	assert.Equal(t, templateId, log.TemplateId)
+	assert.Equal(t, permission_template_audit_logs.OperationUpdate, log.OperationType)
+	require.NotNil(t, log.OperatorId)
+	assert.Equal(t, "operator-id", *log.OperatorId)
+
+	var changes map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal(log.Changes, &changes))
+	// 仅变更的模块、权限点出现在差异中
+	assert.Contains(t, changes["policy_matrix"], "user")
+	assert.NotContains(t, changes["policy_matrix"], "menu")
+	assert.Contains(t, changes["advanced_perms"], "export")
+	assert.NotContains(t, changes, "name")
+}
+
+func TestPublishPermissionTemplate_RecordsStatusAndVersion(t *testing.T) {
+	templateId := "template-id"
+	template := &permissiontemplatemodel.PermissionTemplate{
+		Id:           templateId,
+		Code:         "draft_template",
+		Status:       permissiontemplatemodel.StatusDraft,
+		PolicyMatrix: datatypes.JSON(`{"user": {"actions": ["read"], "scope": "organization"}}`),
+		Version:      1,
+	}
+
+	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOne", mock.Anything, templateId).Return(template, nil)
+	mockModel.On("UpdateVersionWithStatus", mock.Anything, templateId, 2, permissiontemplatemodel.StatusPublished).Return(nil)
+	auditModel := &MockPermissionTemplateAuditLogModel{}
+
+	svcCtx := &svc.ServiceContext{
+		Config:                          config.Config{},
+		DB:                              newTestTxDB(t),
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: auditModel,
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
+	}
+
+	_, err := NewPublishPermissionTemplateLogic(context.Background(), svcCtx).PublishPermissionTemplate(&types.PublishPermissionTemplateReq{Id: templateId})
+	require.NoError(t, err)
+
+	require.Len(t, auditModel.Logs, 1)
+	log := auditModel.Logs[0]
+	assert.Equal(t, permission_template_audit_logs.OperationPublish, log.OperationType)
+	assert.Equal(t, 2, log.Version)
+	assert.Equal(t, errorx.SystemOperatorID, *log.OperatorId)
+
+	var changes map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal(log.Changes, &changes))
+	assert.Equal(t, map[string]interface{}{"old": "draft", "new": "published"}, changes["status"])
+	assert.Equal(t, map[string]interface{}{"old": float64(1), "new": float64(2)}, changes["version"])
+	assert.NotContains(t, changes, "policy_matrix")
+}
+
+func TestGetPermissionTemplateHistory_ReturnsLogs(t *testing.T) {
+	templateId := "template-id"
+	systemOperator := errorx.SystemOperatorID
+	remark := "复制自模板 source（source-id）"
+	auditModel := &MockPermissionTemplateAuditLogModel{
+		Logs: []*permission_template_audit_logs.PermissionTemplateAuditLog{
+			{
+				Id:            "log-1",
+				TemplateId:    templateId,
+				OperationType: permission_template_audit_logs.OperationClone,
+				OperatorId:    &systemOperator,
+				Version:       1,
+				Changes:       datatypes.JSON(`{"name": {"old": null, "new": "副本"}}`),
+				NewValue:      datatypes.JSON(`{"name": "副本"}`),
+				Remark:        &remark,
+				CreatedAt:     time.Date(2024, 1, 2, 3, 4, 5, 0, time.Local),
+			},
+			{
+				Id:            "log-2",
+				TemplateId:    "other-template",
+				OperationType: permission_template_audit_logs.OperationCreate,
+			},
+		},
+	}
+
+	svcCtx := &svc.ServiceContext{
+		Config:                          config.Config{},
+		PermissionTemplateAuditLogModel: auditModel,
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
+	}
+
+	resp, err := NewGetPermissionTemplateHistoryLogic(context.Background(), svcCtx).GetPermissionTemplateHistory(&types.GetPermissionTemplateHistoryReq{
+		Id:       templateId,
+		Page:     1,
+		PageSize: 20,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resp.Total)
+	require.Len(t, resp.Logs, 1)
+
+	log := resp.Logs[0]
+	assert.Equal(t, "log-1", log.Id)
+	assert.Equal(t, errorx.SystemOperatorName, log.OperatorName)
+	assert.Equal(t, remark, log.Remark)
+	assert.Nil(t, log.OldValue)
+	assert.Equal(t, "副本", log.NewValue["name"])
+	assert.Equal(t, "2024-01-02 03:04:05.000", log.CreatedAt)
+}
@@ -52,6 +52,31 @@ func (l *GetPermissionTemplateLogic) GetPermissionTemplate(req *types.GetPermiss
 	// 4. 转换为响应类型
 	detail := l.convertToDetail(template, stats)
 
+	// 4.1 解析继承父模板后的有效策略矩阵和直接子模板
+	effective, err := effectivePolicyMatrix(l.ctx, l.svcCtx, template)
+	if err != nil {
+		// 有效策略矩阵解析失败不影响主流程，继续返回模板自身的策略矩阵
+		logx.Errorf("解析权限模板有效策略矩阵失败: %v", err)
+	}
+	detail.EffectivePolicyMatrix = effective
+
+	children, err := l.svcCtx.PermissionTemplateModel.FindByParentId(l.ctx, req.Id)
+	if err != nil {
+		logx.Errorf("查询权限模板子模板失败: %v", err)
+	}
+	detail.Children = toChildTemplates(children)
+
+	// 5. 查询待发布的工作草稿
+	draft, err := findWorkingDraft(l.ctx, l.svcCtx, req.Id)
+	if err != nil {
+		// 工作草稿查询失败不影响主流程，继续返回模板详情
+		logx.Errorf("查询权限模板工作草稿失败: %v", err)
+	}
+	if draft != nil {
+		detail.HasPendingChanges = true
+		detail.PendingDraft = toPendingDraft(draft)
+	}
+
 	return &types.GetPermissionTemplateResp{
 		Data: detail,
 	}, nil
@@ -87,6 +112,10 @@ func (l *GetPermissionTemplateLogic) convertToDetail(template *permissiontemplat
 		detail.UpdatedBy = *template.UpdatedBy
 	}
 
+	if template.ParentId != nil {
+		detail.ParentId = *template.ParentId
+	}
+
 	// 处理最后应用时间
 	if stats.LastAppliedAt != nil {
 		detail.LastAppliedAt = stats.LastAppliedAt.Format("2006-01-02 15:04:05.000")
@@ -107,5 +136,7 @@ func (l *GetPermissionTemplateLogic) convertToDetail(template *permissiontemplat
 		}
 	}
 
+	detail.PolicyRemovals = parsePolicyRemovals(l.ctx, template.PolicyRemovals)
+
 	return detail
 }
@@ -66,8 +66,10 @@ func TestGetPermissionTemplate_NormalQuery(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -118,8 +120,10 @@ func TestGetPermissionTemplate_TemplateNotFound(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -143,8 +147,10 @@ func TestGetPermissionTemplate_TemplateNotFound(t *testing.T) {
 func TestGetPermissionTemplate_EmptyId(t *testing.T) {
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: new(MockPermissionTemplateModel),
+		Config:                          config.Config{},
+		PermissionTemplateModel:         new(MockPermissionTemplateModel),
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -190,8 +196,10 @@ func TestGetPermissionTemplate_UsageStatsError(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -246,8 +254,10 @@ func TestGetPermissionTemplate_DraftTemplate(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -306,8 +316,10 @@ func TestGetPermissionTemplate_DisabledTemplate(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -365,8 +377,10 @@ func TestGetPermissionTemplate_WithNilUpdatedBy(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -0,0 +1,57 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_template
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPermissionTemplateVersionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetPermissionTemplateVersionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPermissionTemplateVersionLogic {
+	return &GetPermissionTemplateVersionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPermissionTemplateVersionLogic) GetPermissionTemplateVersion(req *types.GetPermissionTemplateVersionReq) (resp *types.GetPermissionTemplateVersionResp, err error) {
+	// 1. 查询模板（确认存在并获取当前版本）
+	template, err := l.svcCtx.PermissionTemplateModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询权限模板失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 查询版本快照
+	snapshot, err := l.svcCtx.PermissionTemplateVersionModel.FindOne(l.ctx, req.Id, req.Version)
+	if err != nil {
+		l.Errorf("查询权限模板版本失败: version=%d, error=%v", req.Version, err)
+		return nil, err
+	}
+
+	// 3. 解析策略内容并转换为响应类型
+	policyMatrix, advancedPerms, err := versionPolicy(snapshot)
+	if err != nil {
+		l.Errorf("解析权限模板版本失败: %v", err)
+		return nil, err
+	}
+	data := toPermissionTemplateVersion(snapshot, template.Version)
+	data.PolicyMatrix = policyMatrix
+	data.AdvancedPerms = advancedPerms
+
+	return &types.GetPermissionTemplateVersionResp{
+		Data: data,
+	}, nil
+}
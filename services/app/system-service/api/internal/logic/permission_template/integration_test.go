@@ -44,8 +44,12 @@ func TestConcurrentCreateSameCode(t *testing.T) {
 			// 每个 goroutine 创建自己的 mock 实例
 			localMock := new(MockPermissionTemplateModel)
 			localMockCtx := &svc.ServiceContext{
-				Config:                  config.Config{},
-				PermissionTemplateModel: localMock,
+				Config:                          config.Config{},
+				PermissionTemplateModel:         localMock,
+				PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+				PermissionCatalogModel:          &MockPermissionCatalogModel{},
+				PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+				PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 			}
 
 			// 设置 mock 期望
@@ -129,8 +133,12 @@ func TestConcurrentEditConflict(t *testing.T) {
 
 			localMock := new(MockPermissionTemplateModel)
 			localMockCtx := &svc.ServiceContext{
-				Config:                  config.Config{},
-				PermissionTemplateModel: localMock,
+				Config:                          config.Config{},
+				PermissionTemplateModel:         localMock,
+				PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+				PermissionCatalogModel:          &MockPermissionCatalogModel{},
+				PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+				PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 			}
 
 			// 每次更新都返回原始模板（模拟未加锁的情况）
@@ -187,8 +195,14 @@ func TestCompleteLifecycleWithStateTransitions(t *testing.T) {
 
 	mockModel := new(MockPermissionTemplateModel)
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		DB:                              newTestTxDB(t),
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
+		RoleModel:                       &MockRoleModel{},
 	}
 
 	ctx := context.Background()
@@ -261,8 +275,12 @@ func TestConcurrentCloneAndDelete(t *testing.T) {
 
 		localMock := new(MockPermissionTemplateModel)
 		localMockCtx := &svc.ServiceContext{
-			Config:                  config.Config{},
-			PermissionTemplateModel: localMock,
+			Config:                          config.Config{},
+			PermissionTemplateModel:         localMock,
+			PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+			PermissionCatalogModel:          &MockPermissionCatalogModel{},
+			PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+			PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 		}
 
 		localMock.On("FindOne", mock.Anything, sourceId).Return(sourceTemplate, nil)
@@ -294,10 +312,19 @@ func TestConcurrentCloneAndDelete(t *testing.T) {
 
 		localMock := new(MockPermissionTemplateModel)
 		localMockCtx := &svc.ServiceContext{
-			Config:                  config.Config{},
-			PermissionTemplateModel: localMock,
+			Config:                          config.Config{},
+			PermissionTemplateModel:         localMock,
+			PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+			PermissionCatalogModel:          &MockPermissionCatalogModel{},
+			PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+			PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 		}
 
+		localMock.On("FindOne", mock.Anything, deleteTargetId).Return(&permissiontemplatemodel.PermissionTemplate{
+			Id:     deleteTargetId,
+			Code:   "delete_target",
+			Status: permissiontemplatemodel.StatusDraft,
+		}, nil)
 		localMock.On("GetUsageStats", mock.Anything, deleteTargetId).Return(usageStats, nil)
 		localMock.On("Delete", mock.Anything, deleteTargetId).Return(nil)
 
@@ -337,8 +364,12 @@ func TestConcurrentListAndUpdate(t *testing.T) {
 
 		localMock := new(MockPermissionTemplateModel)
 		localMockCtx := &svc.ServiceContext{
-			Config:                  config.Config{},
-			PermissionTemplateModel: localMock,
+			Config:                          config.Config{},
+			PermissionTemplateModel:         localMock,
+			PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+			PermissionCatalogModel:          &MockPermissionCatalogModel{},
+			PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+			PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 		}
 
 		localMock.On("Count", mock.Anything, mock.Anything).Return(int64(1), nil)
@@ -364,8 +395,12 @@ func TestConcurrentListAndUpdate(t *testing.T) {
 
 		localMock := new(MockPermissionTemplateModel)
 		localMockCtx := &svc.ServiceContext{
-			Config:                  config.Config{},
-			PermissionTemplateModel: localMock,
+			Config:                          config.Config{},
+			PermissionTemplateModel:         localMock,
+			PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+			PermissionCatalogModel:          &MockPermissionCatalogModel{},
+			PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+			PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 		}
 
 		localMock.On("FindOne", mock.Anything, templateId).Return(templates[0], nil)
@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_template
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListPermissionTemplateVersionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListPermissionTemplateVersionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPermissionTemplateVersionsLogic {
+	return &ListPermissionTemplateVersionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListPermissionTemplateVersionsLogic) ListPermissionTemplateVersions(req *types.ListPermissionTemplateVersionsReq) (resp *types.ListPermissionTemplateVersionsResp, err error) {
+	// 1. 查询模板（确认存在并获取当前版本）
+	template, err := l.svcCtx.PermissionTemplateModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询权限模板失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 查询版本快照（按版本号降序）
+	snapshots, err := l.svcCtx.PermissionTemplateVersionModel.FindByTemplateId(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询权限模板版本列表失败: %v", err)
+		return nil, err
+	}
+
+	// 3. 转换为响应类型
+	data := make([]types.PermissionTemplateVersion, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		data = append(data, toPermissionTemplateVersion(snapshot, template.Version))
+	}
+
+	return &types.ListPermissionTemplateVersionsResp{
+		Data: data,
+	}, nil
+}
@@ -44,17 +44,36 @@ func (l *ListPermissionTemplatesLogic) ListPermissionTemplates(req *types.ListPe
 		return nil, err
 	}
 
+	// 批量查询待发布的工作草稿（查询失败不影响列表返回）
+	pendingIds := make(map[string]struct{})
+	if len(templates) > 0 {
+		templateIds := make([]string, 0, len(templates))
+		for _, tpl := range templates {
+			templateIds = append(templateIds, tpl.Id)
+		}
+		drafts, err := l.svcCtx.PermissionTemplateDraftModel.FindByTemplateIds(l.ctx, templateIds)
+		if err != nil {
+			logx.Errorf("查询权限模板工作草稿失败: %v", err)
+		}
+		for _, draft := range drafts {
+			pendingIds[draft.TemplateId] = struct{}{}
+		}
+	}
+
 	// 转换为响应类型
 	items := make([]types.PermissionTemplateItem, 0, len(templates))
 	for _, tpl := range templates {
+		_, hasPendingChanges := pendingIds[tpl.Id]
 		item := types.PermissionTemplateItem{
-			Id:              tpl.Id,
-			Name:            tpl.Name,
-			Code:            tpl.Code,
-			Status:          tpl.Status,
-			ScopeSuggestion: "",
-			Version:         tpl.Version,
-			UpdatedAt:       tpl.UpdatedAt.Format("2006-01-02 15:04:05.000"),
+			Id:                tpl.Id,
+			Name:              tpl.Name,
+			Code:              tpl.Code,
+			Status:            tpl.Status,
+			ScopeSuggestion:   "",
+			ParentId:          stringValue(tpl.ParentId),
+			Version:           tpl.Version,
+			UpdatedAt:         tpl.UpdatedAt.Format("2006-01-02 15:04:05.000"),
+			HasPendingChanges: hasPendingChanges,
 		}
 
 		// 处理可选字段
@@ -9,13 +9,21 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/config"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	catalogmodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_catalog"
 	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
+	permissiontemplatedrafts "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_drafts"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 )
 
 // MockPermissionTemplateModel 是 permissiontemplatemodel.Model 的 mock 实现
@@ -96,12 +104,28 @@ func (m *MockPermissionTemplateModel) GetUsageStats(ctx context.Context, id stri
 	return args.Get(0).(*permissiontemplatemodel.UsageStats), args.Error(1)
 }
 
-func (m *MockPermissionTemplateModel) WithTx(tx interface{}) permissiontemplatemodel.Model {
-	args := m.Called(tx)
+// FindByParentId 未设置期望时视为没有子模板，避免不涉及继承的用例逐个声明
+func (m *MockPermissionTemplateModel) FindByParentId(ctx context.Context, parentId string) ([]*permissiontemplatemodel.PermissionTemplate, error) {
+	expected := false
+	for _, call := range m.ExpectedCalls {
+		if call.Method == "FindByParentId" {
+			expected = true
+			break
+		}
+	}
+	if !expected {
+		return nil, nil
+	}
+	args := m.Called(ctx, parentId)
 	if args.Get(0) == nil {
-		return m
+		return nil, args.Error(1)
 	}
-	return args.Get(0).(permissiontemplatemodel.Model)
+	return args.Get(0).([]*permissiontemplatemodel.PermissionTemplate), args.Error(1)
+}
+
+// WithTx 事务内沿用同一个 mock，便于按调用断言
+func (m *MockPermissionTemplateModel) WithTx(tx interface{}) permissiontemplatemodel.Model {
+	return m
 }
 
 func (m *MockPermissionTemplateModel) Trans(ctx context.Context, fn func(ctx context.Context, model permissiontemplatemodel.Model) error) error {
@@ -109,6 +133,225 @@ func (m *MockPermissionTemplateModel) Trans(ctx context.Context, fn func(ctx con
 	return args.Error(0)
 }
 
+// newTestTxDB 创建空的 SQLite 数据库，供使用 mock Model 的逻辑开启事务
+func newTestTxDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file:tx_"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	return db
+}
+
+// MockPermissionTemplateAuditLogModel 记录写入的审计日志，用于断言
+type MockPermissionTemplateAuditLogModel struct {
+	Logs []*permission_template_audit_logs.PermissionTemplateAuditLog
+}
+
+func (m *MockPermissionTemplateAuditLogModel) Insert(ctx context.Context, data *permission_template_audit_logs.PermissionTemplateAuditLog) (*permission_template_audit_logs.PermissionTemplateAuditLog, error) {
+	m.Logs = append(m.Logs, data)
+	return data, nil
+}
+
+func (m *MockPermissionTemplateAuditLogModel) FindList(ctx context.Context, req *permission_template_audit_logs.FindListReq) ([]*permission_template_audit_logs.PermissionTemplateAuditLog, int64, error) {
+	var logs []*permission_template_audit_logs.PermissionTemplateAuditLog
+	for _, log := range m.Logs {
+		if req.TemplateId == "" || log.TemplateId == req.TemplateId {
+			logs = append(logs, log)
+		}
+	}
+	return logs, int64(len(logs)), nil
+}
+
+func (m *MockPermissionTemplateAuditLogModel) WithTx(tx interface{}) permission_template_audit_logs.Model {
+	return m
+}
+
+// MockRoleModel 基于内存列表的角色 Model，仅用于查询模板关联角色
+type MockRoleModel struct {
+	Roles []*roles.Role
+}
+
+func (m *MockRoleModel) Insert(ctx context.Context, data *roles.Role) (*roles.Role, error) {
+	m.Roles = append(m.Roles, data)
+	return data, nil
+}
+
+func (m *MockRoleModel) FindOne(ctx context.Context, id string) (*roles.Role, error) {
+	for _, role := range m.Roles {
+		if role.Id == id {
+			return role, nil
+		}
+	}
+	return nil, roles.ErrRoleNotFound
+}
+
+func (m *MockRoleModel) FindOneByCode(ctx context.Context, code string) (*roles.Role, error) {
+	for _, role := range m.Roles {
+		if role.Code == code {
+			return role, nil
+		}
+	}
+	return nil, roles.ErrRoleNotFound
+}
+
+func (m *MockRoleModel) FindOneByCodeIncludingDeleted(ctx context.Context, code string) (*roles.Role, error) {
+	return m.FindOneByCode(ctx, code)
+}
+
+func (m *MockRoleModel) FindByTemplateId(ctx context.Context, templateId string) ([]*roles.Role, error) {
+	var list []*roles.Role
+	for _, role := range m.Roles {
+		if role.TemplateId == templateId {
+			list = append(list, role)
+		}
+	}
+	return list, nil
+}
+
+func (m *MockRoleModel) List(ctx context.Context, filter *roles.ListFilter) ([]*roles.Role, int64, error) {
+	return m.Roles, int64(len(m.Roles)), nil
+}
+
+func (m *MockRoleModel) Update(ctx context.Context, data *roles.Role) error {
+	return nil
+}
+
+func (m *MockRoleModel) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *MockRoleModel) WithTx(tx interface{}) roles.Model {
+	return m
+}
+
+// MockPermissionCatalogModel 基于内存列表的权限目录 Model，默认为空目录（不校验策略矩阵）
+type MockPermissionCatalogModel struct {
+	Modules []*catalogmodel.CatalogModule
+}
+
+func (m *MockPermissionCatalogModel) Insert(ctx context.Context, data *catalogmodel.CatalogModule) (*catalogmodel.CatalogModule, error) {
+	m.Modules = append(m.Modules, data)
+	return data, nil
+}
+
+func (m *MockPermissionCatalogModel) FindOne(ctx context.Context, id string) (*catalogmodel.CatalogModule, error) {
+	for _, module := range m.Modules {
+		if module.Id == id {
+			return module, nil
+		}
+	}
+	return nil, catalogmodel.ErrCatalogModuleNotFound
+}
+
+func (m *MockPermissionCatalogModel) FindOneByCodeIncludingDeleted(ctx context.Context, code string) (*catalogmodel.CatalogModule, error) {
+	for _, module := range m.Modules {
+		if module.Code == code {
+			return module, nil
+		}
+	}
+	return nil, catalogmodel.ErrCatalogModuleNotFound
+}
+
+func (m *MockPermissionCatalogModel) FindAll(ctx context.Context) ([]*catalogmodel.CatalogModule, error) {
+	return m.Modules, nil
+}
+
+func (m *MockPermissionCatalogModel) Update(ctx context.Context, data *catalogmodel.CatalogModule) error {
+	return nil
+}
+
+func (m *MockPermissionCatalogModel) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *MockPermissionCatalogModel) WithTx(tx interface{}) catalogmodel.Model {
+	return m
+}
+
+// MockPermissionTemplateVersionModel 基于内存列表的权限模板版本快照 Model
+type MockPermissionTemplateVersionModel struct {
+	Versions []*permissiontemplateversions.PermissionTemplateVersion
+}
+
+func (m *MockPermissionTemplateVersionModel) Insert(ctx context.Context, data *permissiontemplateversions.PermissionTemplateVersion) (*permissiontemplateversions.PermissionTemplateVersion, error) {
+	for _, v := range m.Versions {
+		if v.TemplateId == data.TemplateId && v.Version == data.Version {
+			return nil, permissiontemplateversions.ErrTemplateVersionExists
+		}
+	}
+	m.Versions = append(m.Versions, data)
+	return data, nil
+}
+
+func (m *MockPermissionTemplateVersionModel) FindOne(ctx context.Context, templateId string, version int) (*permissiontemplateversions.PermissionTemplateVersion, error) {
+	for _, v := range m.Versions {
+		if v.TemplateId == templateId && v.Version == version {
+			return v, nil
+		}
+	}
+	return nil, permissiontemplateversions.ErrTemplateVersionNotFound
+}
+
+func (m *MockPermissionTemplateVersionModel) FindByTemplateId(ctx context.Context, templateId string) ([]*permissiontemplateversions.PermissionTemplateVersion, error) {
+	var list []*permissiontemplateversions.PermissionTemplateVersion
+	for i := len(m.Versions) - 1; i >= 0; i-- {
+		if m.Versions[i].TemplateId == templateId {
+			list = append(list, m.Versions[i])
+		}
+	}
+	return list, nil
+}
+
+func (m *MockPermissionTemplateVersionModel) WithTx(tx interface{}) permissiontemplateversions.Model {
+	return m
+}
+
+// MockPermissionTemplateDraftModel 基于内存 map 的权限模板工作草稿 Model
+type MockPermissionTemplateDraftModel struct {
+	Drafts map[string]*permissiontemplatedrafts.PermissionTemplateDraft
+}
+
+func (m *MockPermissionTemplateDraftModel) Upsert(ctx context.Context, data *permissiontemplatedrafts.PermissionTemplateDraft) error {
+	if m.Drafts == nil {
+		m.Drafts = make(map[string]*permissiontemplatedrafts.PermissionTemplateDraft)
+	}
+	if existing, ok := m.Drafts[data.TemplateId]; ok {
+		data.CreatedBy = existing.CreatedBy
+		data.CreatedAt = existing.CreatedAt
+	}
+	m.Drafts[data.TemplateId] = data
+	return nil
+}
+
+func (m *MockPermissionTemplateDraftModel) FindOne(ctx context.Context, templateId string) (*permissiontemplatedrafts.PermissionTemplateDraft, error) {
+	if draft, ok := m.Drafts[templateId]; ok {
+		return draft, nil
+	}
+	return nil, permissiontemplatedrafts.ErrTemplateDraftNotFound
+}
+
+func (m *MockPermissionTemplateDraftModel) FindByTemplateIds(ctx context.Context, templateIds []string) ([]*permissiontemplatedrafts.PermissionTemplateDraft, error) {
+	var list []*permissiontemplatedrafts.PermissionTemplateDraft
+	for _, id := range templateIds {
+		if draft, ok := m.Drafts[id]; ok {
+			list = append(list, draft)
+		}
+	}
+	return list, nil
+}
+
+func (m *MockPermissionTemplateDraftModel) Delete(ctx context.Context, templateId string) error {
+	if _, ok := m.Drafts[templateId]; !ok {
+		return permissiontemplatedrafts.ErrTemplateDraftNotFound
+	}
+	delete(m.Drafts, templateId)
+	return nil
+}
+
+func (m *MockPermissionTemplateDraftModel) WithTx(tx interface{}) permissiontemplatedrafts.Model {
+	return m
+}
+
 // 辅助函数：创建测试用的权限模板数据
 func createTestPermissionTemplates() []*permissiontemplatemodel.PermissionTemplate {
 	now := time.Now()
@@ -176,8 +419,10 @@ func TestListPermissionTemplates_NormalQuery(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -220,8 +465,10 @@ func TestListPermissionTemplates_SearchByKeyword(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -257,8 +504,10 @@ func TestListPermissionTemplates_FilterByStatus(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -299,8 +548,10 @@ func TestListPermissionTemplates_FilterByScopeSuggestion(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -341,8 +592,10 @@ func TestListPermissionTemplates_Pagination(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -372,8 +625,10 @@ func TestListPermissionTemplates_EmptyResult(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -403,8 +658,10 @@ func TestListPermissionTemplates_ErrorHandling(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -441,8 +698,10 @@ func TestListPermissionTemplates_MultipleFilters(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -0,0 +1,68 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission_template
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PreviewPermissionTemplateImpactLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPreviewPermissionTemplateImpactLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PreviewPermissionTemplateImpactLogic {
+	return &PreviewPermissionTemplateImpactLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PreviewPermissionTemplateImpactLogic) PreviewPermissionTemplateImpact(req *types.PreviewPermissionTemplateImpactReq) (resp *types.PreviewPermissionTemplateImpactResp, err error) {
+	// 1. 查询模板
+	template, err := l.svcCtx.PermissionTemplateModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询权限模板失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 按操作类型模拟变更后的模板（与发布、停用的状态校验一致）
+	var after *permissiontemplatemodel.PermissionTemplate
+	switch req.Operation {
+	case ImpactOperationPublish:
+		draft, err := findPublishDraft(l.ctx, l.svcCtx, template)
+		if err != nil {
+			return nil, err
+		}
+		after = publishedState(template, draft)
+	case ImpactOperationDisable:
+		if template.Status != permissiontemplatemodel.StatusPublished {
+			l.Errorf("只有已发布状态的模板可以停用，当前状态: %s", template.Status)
+			return nil, permissiontemplatemodel.ErrPermissionTemplateNotPublished
+		}
+		after = disabledState(template)
+	default:
+		return nil, permissiontemplatemodel.ErrPermissionTemplateStatusTransitionInvalid
+	}
+
+	// 3. 对比绑定用户变更前后的有效权限
+	resp, err = analyzeTemplateImpact(l.ctx, l.svcCtx, template, after, req.Operation)
+	if err != nil {
+		l.Errorf("分析权限模板变更影响失败: %v", err)
+		return nil, err
+	}
+
+	l.Infof("预览权限模板变更影响: id=%s, operation=%s, affected=%d, losing=%d",
+		req.Id, req.Operation, resp.AffectedUserCount, resp.LosingUserCount)
+
+	return resp, nil
+}
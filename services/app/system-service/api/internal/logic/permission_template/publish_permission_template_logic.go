@@ -5,13 +5,14 @@ package permission_template
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
 )
 
 type PublishPermissionTemplateLogic struct {
@@ -36,15 +37,27 @@ func (l *PublishPermissionTemplateLogic) PublishPermissionTemplate(req *types.Pu
 		return nil, err
 	}
 
-	// 2. 校验模板状态为草稿
-	if template.Status != permissiontemplatemodel.StatusDraft {
-		l.Errorf("只有草稿状态的模板可以发布，当前状态: %s", template.Status)
-		return nil, permissiontemplatemodel.ErrPermissionTemplateStatusTransitionInvalid
+	// 2. 校验模板状态：草稿直接发布；已发布模板发布其工作草稿
+	draft, err := findPublishDraft(l.ctx, l.svcCtx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	// 发布内容：草稿模板为模板本身，已发布模板为应用工作草稿后的模板
+	published := template
+	if draft != nil {
+		published = applyDraft(template, draft)
+	}
+
+	// 3. 校验继承关系无循环且父模板已发布
+	if err := validatePublishParent(l.ctx, l.svcCtx, published); err != nil {
+		l.Errorf("父模板校验失败: %v", err)
+		return nil, err
 	}
 
-	// 3. 校验策略矩阵非空
-	var policyMatrix map[string]interface{}
-	if err := json.Unmarshal(template.PolicyMatrix, &policyMatrix); err != nil {
+	// 3.1 校验有效策略矩阵（合并父模板后）非空
+	policyMatrix, err := effectivePolicyMatrix(l.ctx, l.svcCtx, published)
+	if err != nil {
 		l.Errorf("解析策略矩阵失败: %v", err)
 		return nil, err
 	}
@@ -53,18 +66,101 @@ func (l *PublishPermissionTemplateLogic) PublishPermissionTemplate(req *types.Pu
 		return nil, permissiontemplatemodel.ErrPermissionTemplateEmptyPolicyMatrix
 	}
 
-	// 4. 递增版本号并更新状态为已发布
-	newVersion := template.Version + 1
-	err = l.svcCtx.PermissionTemplateModel.UpdateVersionWithStatus(l.ctx, req.Id, newVersion, permissiontemplatemodel.StatusPublished)
+	// 3.2 校验策略矩阵符合权限目录（目录可能在保存草稿后发生变化）
+	if err := validatePolicyMatrixAgainstCatalog(l.ctx, l.svcCtx, policyMatrix); err != nil {
+		l.Errorf("策略矩阵校验失败: %v", err)
+		return nil, err
+	}
+
+	// 3.3 工作草稿修改了编码时，重新校验编码唯一性（保存草稿后可能被其他模板占用）
+	if published.Code != template.Code {
+		existing, err := l.svcCtx.PermissionTemplateModel.FindOneByCodeIncludingDeleted(l.ctx, published.Code)
+		if err != nil && err != permissiontemplatemodel.ErrPermissionTemplateNotFound {
+			l.Errorf("查询编码唯一性失败: %v", err)
+			return nil, err
+		}
+		if existing != nil {
+			l.Errorf("编码已存在: %s", published.Code)
+			return nil, permissiontemplatemodel.ErrPermissionTemplateCodeExists
+		}
+	}
+
+	// 3.4 失去权限的用户数超过阈值时，校验影响预览的确认令牌
+	after := publishedState(template, draft)
+	if err := checkImpactAck(l.ctx, l.svcCtx, template, after, ImpactOperationPublish, req.AckToken); err != nil {
+		return nil, err
+	}
+
+	// 4. 构建发布版本的不可变快照（固定该版本的角色按快照授权）
+	newVersion := after.Version
+	snapshot, err := newVersionSnapshot(l.ctx, l.svcCtx, after)
+	if err != nil {
+		l.Errorf("构建权限模板版本快照失败: %v", err)
+		return nil, err
+	}
+
+	// 5. 同一事务内发布模板、写入版本快照并删除已发布的工作草稿
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		// 5.1 递增版本号并更新状态为已发布（已发布模板同时写入工作草稿内容）
+		templateModel := l.svcCtx.PermissionTemplateModel.WithTx(tx)
+		if draft == nil {
+			err = templateModel.UpdateVersionWithStatus(l.ctx, req.Id, newVersion, permissiontemplatemodel.StatusPublished)
+		} else {
+			operatorId := currentOperatorID(l.ctx)
+			applied := *published
+			applied.Version = newVersion
+			applied.UpdatedBy = &operatorId
+			err = templateModel.Update(l.ctx, &applied)
+		}
+		if err != nil {
+			l.Errorf("发布权限模板失败: %v", err)
+			return err
+		}
+
+		// 5.2 写入版本快照
+		if _, err := l.svcCtx.PermissionTemplateVersionModel.WithTx(tx).Insert(l.ctx, snapshot); err != nil {
+			l.Errorf("写入权限模板版本快照失败: %v", err)
+			return err
+		}
+
+		// 5.3 删除已发布的工作草稿
+		if draft != nil {
+			if err := l.svcCtx.PermissionTemplateDraftModel.WithTx(tx).Delete(l.ctx, req.Id); err != nil {
+				l.Errorf("删除权限模板工作草稿失败: %v", err)
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		l.Errorf("发布权限模板失败: %v", err)
 		return nil, err
 	}
 
-	logx.Infof("发布权限模板成功: id=%s, code=%s, version=%d", req.Id, template.Code, newVersion)
+	// 6. 记录审计日志
+	recordTemplateAudit(l.ctx, l.svcCtx, &templateAuditEntry{
+		TemplateId:    req.Id,
+		OperationType: permission_template_audit_logs.OperationPublish,
+		Before:        template,
+		After:         after,
+	})
+
+	// 7. 使全部用户授权快照失效
+	if err := l.svcCtx.PermissionCache.InvalidateAll(l.ctx); err != nil {
+		l.Errorf("清除授权快照缓存失败: %v", err)
+	}
+
+	// 8. 查询继承该模板的子模板（有效策略矩阵随父模板发布而变化）
+	children, err := descendantTemplates(l.ctx, l.svcCtx, req.Id)
+	if err != nil {
+		// 子模板查询失败不影响发布结果
+		l.Errorf("查询权限模板子模板失败: %v", err)
+	}
+
+	logx.Infof("发布权限模板成功: id=%s, code=%s, version=%d", req.Id, published.Code, newVersion)
 
 	return &types.PublishPermissionTemplateResp{
-		Success: true,
-		Version: newVersion,
+		Success:          true,
+		Version:          newVersion,
+		AffectedChildren: toChildTemplates(children),
 	}, nil
 }
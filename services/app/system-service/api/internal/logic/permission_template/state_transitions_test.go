@@ -9,6 +9,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -37,8 +38,13 @@ func TestPublishPermissionTemplate_NormalPublish(t *testing.T) {
 	mockModel.On("UpdateVersionWithStatus", mock.Anything, templateId, 2, permissiontemplatemodel.StatusPublished).Return(nil)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		DB:                              newTestTxDB(t),
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	ctx := context.Background()
@@ -70,8 +76,12 @@ func TestPublishPermissionTemplate_NonDraftTemplate(t *testing.T) {
 	mockModel.On("FindOne", mock.Anything, templateId).Return(template, nil)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	ctx := context.Background()
@@ -104,8 +114,12 @@ func TestPublishPermissionTemplate_EmptyPolicyMatrix(t *testing.T) {
 	mockModel.On("FindOne", mock.Anything, templateId).Return(template, nil)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	ctx := context.Background()
@@ -136,9 +150,21 @@ func TestDisablePermissionTemplate_NormalDisable(t *testing.T) {
 	mockModel.On("FindOne", mock.Anything, templateId).Return(template, nil)
 	mockModel.On("UpdateStatus", mock.Anything, templateId, permissiontemplatemodel.StatusDisabled).Return(nil)
 
+	// 两个角色引用该模板，一个角色引用其他模板
+	roleModel := &MockRoleModel{Roles: []*roles.Role{
+		{Id: "role-1", Code: "auditor", Name: "审计员", TemplateId: templateId, TemplateVersion: 1},
+		{Id: "role-2", Code: "viewer", Name: "查看者", TemplateId: templateId, TemplateVersion: 1},
+		{Id: "role-3", Code: "other", Name: "其他", TemplateId: "other-template-id", TemplateVersion: 1},
+	}}
+
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
+		RoleModel:                       roleModel,
 	}
 
 	ctx := context.Background()
@@ -149,6 +175,9 @@ func TestDisablePermissionTemplate_NormalDisable(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 	assert.True(t, resp.Success)
+	require.Len(t, resp.AffectedRoles, 2)
+	assert.Equal(t, "auditor", resp.AffectedRoles[0].Code)
+	assert.Equal(t, "viewer", resp.AffectedRoles[1].Code)
 
 	mockModel.AssertExpectations(t)
 }
@@ -169,8 +198,12 @@ func TestDisablePermissionTemplate_NonPublishedTemplate(t *testing.T) {
 	mockModel.On("FindOne", mock.Anything, templateId).Return(template, nil)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	ctx := context.Background()
@@ -202,8 +235,12 @@ func TestEnablePermissionTemplate_NormalEnable(t *testing.T) {
 	mockModel.On("UpdateStatus", mock.Anything, templateId, permissiontemplatemodel.StatusPublished).Return(nil)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	ctx := context.Background()
@@ -234,8 +271,12 @@ func TestEnablePermissionTemplate_NonDisabledTemplate(t *testing.T) {
 	mockModel.On("FindOne", mock.Anything, templateId).Return(template, nil)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	ctx := context.Background()
@@ -273,8 +314,14 @@ func TestStateTransitions_CompleteLifecycle(t *testing.T) {
 	mockModel.On("UpdateVersionWithStatus", mock.Anything, templateId, 2, permissiontemplatemodel.StatusPublished).Once().Return(nil)
 
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		DB:                              newTestTxDB(t),
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
+		RoleModel:                       &MockRoleModel{},
 	}
 
 	ctx := context.Background()
@@ -0,0 +1,154 @@
+package permission_template
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/audit"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/datatypes"
+)
+
+// nestedAuditFields 按模块/权限点逐项比较的 JSON 字段
+var nestedAuditFields = []string{"policy_matrix", "policy_removals", "advanced_perms"}
+
+// templateAuditEntry 权限模板审计记录参数
+type templateAuditEntry struct {
+	TemplateId    string
+	OperationType string
+	Before        *permissiontemplatemodel.PermissionTemplate // 操作前模板（创建、复制时为 nil）
+	After         *permissiontemplatemodel.PermissionTemplate // 操作后模板（删除时为 nil）
+	Remark        string
+}
+
+// recordTemplateAudit 记录权限模板审计日志（失败只记录错误，不影响主流程）
+func recordTemplateAudit(ctx context.Context, svcCtx *svc.ServiceContext, entry *templateAuditEntry) {
+	// 1. 操作人（未登录上下文视为系统操作）
+	operatorId := currentOperatorID(ctx)
+
+	// 2. 生成快照和差异
+	oldValue := templateSnapshot(entry.Before)
+	newValue := templateSnapshot(entry.After)
+	version := 0
+	if entry.After != nil {
+		version = entry.After.Version
+	} else if entry.Before != nil {
+		version = entry.Before.Version
+	}
+
+	auditLog := &permission_template_audit_logs.PermissionTemplateAuditLog{
+		TemplateId:    entry.TemplateId,
+		OperationType: entry.OperationType,
+		OperatorId:    &operatorId,
+		Version:       version,
+		Changes:       marshalAuditJSON(diffTemplateSnapshots(oldValue, newValue)),
+		OldValue:      marshalAuditJSON(oldValue),
+		NewValue:      marshalAuditJSON(newValue),
+	}
+	if entry.Remark != "" {
+		auditLog.Remark = &entry.Remark
+	}
+
+	// 3. 写入审计日志
+	if _, err := svcCtx.PermissionTemplateAuditLogModel.Insert(ctx, auditLog); err != nil {
+		logx.WithContext(ctx).Errorf("记录权限模板审计日志失败: templateId=%s, operation=%s, error=%v", entry.TemplateId, entry.OperationType, err)
+	}
+}
+
+// currentOperatorID 获取当前操作人ID，缺失时回退为系统
+func currentOperatorID(ctx context.Context) string {
+	if userID, ok := ctx.Value(contextkeys.UserIDKey).(string); ok && userID != "" {
+		return userID
+	}
+	return errorx.SystemOperatorID
+}
+
+// templateSnapshot 将模板转换为审计快照（策略矩阵、高级权限点解析为对象）
+func templateSnapshot(template *permissiontemplatemodel.PermissionTemplate) map[string]interface{} {
+	if template == nil {
+		return nil
+	}
+	snapshot := map[string]interface{}{
+		"name":             template.Name,
+		"code":             template.Code,
+		"description":      stringValue(template.Description),
+		"status":           template.Status,
+		"scope_suggestion": stringValue(template.ScopeSuggestion),
+		"parent_id":        stringValue(template.ParentId),
+		"version":          template.Version,
+		"policy_matrix":    jsonMap(template.PolicyMatrix),
+		"policy_removals":  jsonMap(template.PolicyRemovals),
+		"advanced_perms":   jsonMap(template.AdvancedPerms),
+	}
+	// 统一为 JSON 类型，保证与数据库读出的快照比较结果一致
+	data, _ := json.Marshal(snapshot)
+	var normalized map[string]interface{}
+	_ = json.Unmarshal(data, &normalized)
+	return normalized
+}
+
+// diffTemplateSnapshots 比较新旧快照，policy_matrix/advanced_perms 按模块/权限点展开为 {old, new}
+func diffTemplateSnapshots(oldValue, newValue map[string]interface{}) map[string]interface{} {
+	changes := audit.DiffValues(withoutNestedFields(oldValue), withoutNestedFields(newValue))
+	for _, field := range nestedAuditFields {
+		oldField, _ := oldValue[field].(map[string]interface{})
+		newField, _ := newValue[field].(map[string]interface{})
+		if diff := audit.DiffValues(oldField, newField); diff != nil {
+			if changes == nil {
+				changes = make(map[string]interface{})
+			}
+			changes[field] = diff
+		}
+	}
+	return changes
+}
+
+// withoutNestedFields 复制快照并去掉按项比较的字段
+func withoutNestedFields(snapshot map[string]interface{}) map[string]interface{} {
+	if snapshot == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(snapshot))
+	for key, value := range snapshot {
+		result[key] = value
+	}
+	for _, field := range nestedAuditFields {
+		delete(result, field)
+	}
+	return result
+}
+
+// marshalAuditJSON 序列化审计字段，空值返回 nil（数据库存 NULL）
+func marshalAuditJSON(value map[string]interface{}) datatypes.JSON {
+	if value == nil {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	return datatypes.JSON(data)
+}
+
+// jsonMap 解析 JSON 对象，空值或解析失败返回空对象
+func jsonMap(data datatypes.JSON) map[string]interface{} {
+	result := make(map[string]interface{})
+	if len(data) > 0 {
+		_ = json.Unmarshal(data, &result)
+	}
+	return result
+}
+
+// stringValue 获取可选字符串的值
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,85 @@
+package permission_template
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	permissiontemplatedrafts "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_drafts"
+)
+
+// findWorkingDraft 查询模板的工作草稿，不存在时返回 nil
+func findWorkingDraft(ctx context.Context, svcCtx *svc.ServiceContext, templateId string) (*permissiontemplatedrafts.PermissionTemplateDraft, error) {
+	draft, err := svcCtx.PermissionTemplateDraftModel.FindOne(ctx, templateId)
+	if err != nil {
+		if errors.Is(err, permissiontemplatedrafts.ErrTemplateDraftNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return draft, nil
+}
+
+// saveWorkingDraft 将已发布模板的编辑内容保存为工作草稿（edited 为应用编辑后的模板）
+func saveWorkingDraft(ctx context.Context, svcCtx *svc.ServiceContext, edited *permissiontemplatemodel.PermissionTemplate) error {
+	operatorId := currentOperatorID(ctx)
+	now := time.Now()
+	return svcCtx.PermissionTemplateDraftModel.Upsert(ctx, &permissiontemplatedrafts.PermissionTemplateDraft{
+		TemplateId:      edited.Id,
+		Name:            edited.Name,
+		Code:            edited.Code,
+		Description:     edited.Description,
+		ScopeSuggestion: edited.ScopeSuggestion,
+		PolicyMatrix:    edited.PolicyMatrix,
+		AdvancedPerms:   edited.AdvancedPerms,
+		ParentId:        edited.ParentId,
+		PolicyRemovals:  edited.PolicyRemovals,
+		BaseVersion:     edited.Version,
+		CreatedBy:       operatorId,
+		CreatedAt:       now,
+		UpdatedBy:       &operatorId,
+		UpdatedAt:       now,
+	})
+}
+
+// applyDraft 返回应用工作草稿内容后的模板副本（状态和版本号保持不变）
+func applyDraft(template *permissiontemplatemodel.PermissionTemplate, draft *permissiontemplatedrafts.PermissionTemplateDraft) *permissiontemplatemodel.PermissionTemplate {
+	applied := *template
+	applied.Name = draft.Name
+	applied.Code = draft.Code
+	applied.Description = draft.Description
+	applied.ScopeSuggestion = draft.ScopeSuggestion
+	applied.PolicyMatrix = draft.PolicyMatrix
+	applied.AdvancedPerms = draft.AdvancedPerms
+	applied.ParentId = draft.ParentId
+	applied.PolicyRemovals = draft.PolicyRemovals
+	return &applied
+}
+
+// toPendingDraft 将工作草稿转换为响应类型
+func toPendingDraft(draft *permissiontemplatedrafts.PermissionTemplateDraft) *types.PermissionTemplateDraft {
+	pending := &types.PermissionTemplateDraft{
+		Name:            draft.Name,
+		Code:            draft.Code,
+		Description:     stringValue(draft.Description),
+		ScopeSuggestion: stringValue(draft.ScopeSuggestion),
+		ParentId:        stringValue(draft.ParentId),
+		BaseVersion:     draft.BaseVersion,
+		UpdatedBy:       stringValue(draft.UpdatedBy),
+		UpdatedAt:       draft.UpdatedAt.Format("2006-01-02 15:04:05.000"),
+	}
+	if len(draft.PolicyMatrix) > 0 {
+		_ = json.Unmarshal(draft.PolicyMatrix, &pending.PolicyMatrix)
+	}
+	if len(draft.AdvancedPerms) > 0 {
+		_ = json.Unmarshal(draft.AdvancedPerms, &pending.AdvancedPerms)
+	}
+	if len(draft.PolicyRemovals) > 0 {
+		_ = json.Unmarshal(draft.PolicyRemovals, &pending.PolicyRemovals)
+	}
+	return pending
+}
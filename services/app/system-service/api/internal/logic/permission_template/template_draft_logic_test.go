@@ -0,0 +1,171 @@
+package permission_template
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/config"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
+	permissiontemplatedrafts "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_drafts"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+// newPublishedTemplate 创建测试用的已发布模板（版本 2）
+func newPublishedTemplate() *permissiontemplatemodel.PermissionTemplate {
+	return &permissiontemplatemodel.PermissionTemplate{
+		Id:           "published-id",
+		Name:         "用户管理",
+		Code:         "user_admin",
+		Status:       permissiontemplatemodel.StatusPublished,
+		PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read"],"scope":"global"}}`),
+		Version:      2,
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// newDraftTestContext 创建工作草稿测试上下文
+func newDraftTestContext(t *testing.T, mockModel *MockPermissionTemplateModel, draftModel *MockPermissionTemplateDraftModel) (*svc.ServiceContext, *MockPermissionTemplateAuditLogModel, *MockPermissionTemplateVersionModel) {
+	auditModel := &MockPermissionTemplateAuditLogModel{}
+	versionModel := &MockPermissionTemplateVersionModel{}
+	return &svc.ServiceContext{
+		Config:                          config.Config{},
+		DB:                              newTestTxDB(t),
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: auditModel,
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  versionModel,
+		PermissionTemplateDraftModel:    draftModel,
+	}, auditModel, versionModel
+}
+
+func TestUpdatePermissionTemplate_PublishedSavesWorkingDraft(t *testing.T) {
+	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOne", mock.Anything, "published-id").Return(newPublishedTemplate(), nil)
+	draftModel := &MockPermissionTemplateDraftModel{}
+	svcCtx, auditModel, _ := newDraftTestContext(t, mockModel, draftModel)
+
+	resp, err := NewUpdatePermissionTemplateLogic(context.Background(), svcCtx).UpdatePermissionTemplate(&types.UpdatePermissionTemplateReq{
+		Id:   "published-id",
+		Name: "用户管理",
+		Code: "user_admin",
+		PolicyMatrix: map[string]types.PolicyMatrixEntry{
+			"user": {Actions: []string{"read", "delete"}, Scope: "global"},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.True(t, resp.HasPendingChanges)
+	mockModel.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+
+	draft := draftModel.Drafts["published-id"]
+	require.NotNil(t, draft)
+	assert.Equal(t, 2, draft.BaseVersion)
+	assert.JSONEq(t, `{"user":{"actions":["read","delete"],"scope":"global"}}`, string(draft.PolicyMatrix))
+	require.Len(t, auditModel.Logs, 1)
+	assert.Equal(t, permission_template_audit_logs.OperationUpdate, auditModel.Logs[0].OperationType)
+	assert.Contains(t, *auditModel.Logs[0].Remark, "工作草稿")
+}
+
+func TestPermissionTemplate_PendingChangesIndicators(t *testing.T) {
+	published := newPublishedTemplate()
+	other := &permissiontemplatemodel.PermissionTemplate{Id: "other-id", Code: "other", Status: permissiontemplatemodel.StatusDraft, Version: 1}
+	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOne", mock.Anything, "published-id").Return(published, nil)
+	mockModel.On("GetUsageStats", mock.Anything, "published-id").Return(&permissiontemplatemodel.UsageStats{}, nil)
+	mockModel.On("List", mock.Anything, mock.Anything).Return([]*permissiontemplatemodel.PermissionTemplate{published, other}, int64(2), nil)
+	draftModel := &MockPermissionTemplateDraftModel{Drafts: map[string]*permissiontemplatedrafts.PermissionTemplateDraft{
+		"published-id": {
+			TemplateId: "published-id", Name: "用户管理（修订）", Code: "user_admin", BaseVersion: 2,
+			PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read","update"],"scope":"global"}}`),
+		},
+	}}
+	svcCtx, _, _ := newDraftTestContext(t, mockModel, draftModel)
+	ctx := context.Background()
+
+	detail, err := NewGetPermissionTemplateLogic(ctx, svcCtx).GetPermissionTemplate(&types.GetPermissionTemplateReq{Id: "published-id"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"read"}, detail.Data.PolicyMatrix["user"].Actions, "详情返回已发布内容")
+	assert.True(t, detail.Data.HasPendingChanges)
+	require.NotNil(t, detail.Data.PendingDraft)
+	assert.Equal(t, "用户管理（修订）", detail.Data.PendingDraft.Name)
+	assert.Equal(t, []string{"read", "update"}, detail.Data.PendingDraft.PolicyMatrix["user"].Actions)
+
+	list, err := NewListPermissionTemplatesLogic(ctx, svcCtx).ListPermissionTemplates(&types.ListPermissionTemplatesReq{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, list.Data, 2)
+	assert.True(t, list.Data[0].HasPendingChanges)
+	assert.False(t, list.Data[1].HasPendingChanges)
+}
+
+func TestPublishPermissionTemplate_AppliesWorkingDraft(t *testing.T) {
+	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOne", mock.Anything, "published-id").Return(newPublishedTemplate(), nil)
+	mockModel.On("Update", mock.Anything, mock.Anything).Return(nil)
+	draftModel := &MockPermissionTemplateDraftModel{Drafts: map[string]*permissiontemplatedrafts.PermissionTemplateDraft{
+		"published-id": {
+			TemplateId: "published-id", Name: "用户管理", Code: "user_admin", BaseVersion: 2,
+			PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read","update"],"scope":"global"}}`),
+		},
+	}}
+	svcCtx, _, versionModel := newDraftTestContext(t, mockModel, draftModel)
+
+	resp, err := NewPublishPermissionTemplateLogic(context.Background(), svcCtx).PublishPermissionTemplate(&types.PublishPermissionTemplateReq{Id: "published-id"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Version)
+	mockModel.AssertCalled(t, "Update", mock.Anything, mock.MatchedBy(func(data *permissiontemplatemodel.PermissionTemplate) bool {
+		return data.Version == 3 && data.Status == permissiontemplatemodel.StatusPublished &&
+			string(data.PolicyMatrix) == `{"user":{"actions":["read","update"],"scope":"global"}}`
+	}))
+	mockModel.AssertNotCalled(t, "UpdateVersionWithStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	require.Len(t, versionModel.Versions, 1)
+	assert.Equal(t, 3, versionModel.Versions[0].Version)
+	assert.JSONEq(t, `{"user":{"actions":["read","update"],"scope":"global"}}`, string(versionModel.Versions[0].PolicyMatrix))
+	assert.Empty(t, draftModel.Drafts, "发布后删除工作草稿")
+}
+
+func TestPublishPermissionTemplate_PublishedWithoutDraft(t *testing.T) {
+	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOne", mock.Anything, "published-id").Return(newPublishedTemplate(), nil)
+	svcCtx, _, _ := newDraftTestContext(t, mockModel, &MockPermissionTemplateDraftModel{})
+
+	resp, err := NewPublishPermissionTemplateLogic(context.Background(), svcCtx).PublishPermissionTemplate(&types.PublishPermissionTemplateReq{Id: "published-id"})
+
+	assert.Equal(t, permissiontemplatemodel.ErrPermissionTemplateStatusTransitionInvalid, err)
+	assert.Nil(t, resp)
+	mockModel.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestDiscardPermissionTemplateDraft(t *testing.T) {
+	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOne", mock.Anything, "published-id").Return(newPublishedTemplate(), nil)
+	draftModel := &MockPermissionTemplateDraftModel{Drafts: map[string]*permissiontemplatedrafts.PermissionTemplateDraft{
+		"published-id": {
+			TemplateId: "published-id", Name: "用户管理", Code: "user_admin", BaseVersion: 2,
+			PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read","update"],"scope":"global"}}`),
+		},
+	}}
+	svcCtx, auditModel, _ := newDraftTestContext(t, mockModel, draftModel)
+	logic := NewDiscardPermissionTemplateDraftLogic(context.Background(), svcCtx)
+
+	resp, err := logic.DiscardPermissionTemplateDraft(&types.DiscardPermissionTemplateDraftReq{Id: "published-id"})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Empty(t, draftModel.Drafts)
+	require.Len(t, auditModel.Logs, 1)
+	assert.Equal(t, permission_template_audit_logs.OperationDiscardDraft, auditModel.Logs[0].OperationType)
+
+	// 再次放弃：没有工作草稿
+	resp, err = logic.DiscardPermissionTemplateDraft(&types.DiscardPermissionTemplateDraftReq{Id: "published-id"})
+	assert.ErrorIs(t, err, permissiontemplatedrafts.ErrTemplateDraftNotFound)
+	assert.Nil(t, resp)
+}
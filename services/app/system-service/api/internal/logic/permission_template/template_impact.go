@@ -0,0 +1,270 @@
+package permission_template
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	permissiontemplatedrafts "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_drafts"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 影响预览的操作类型
+const (
+	ImpactOperationPublish = "publish"
+	ImpactOperationDisable = "disable"
+)
+
+// unassignedDeptName 未分配主部门的用户汇总时使用的部门名称
+const unassignedDeptName = "未分配部门"
+
+// findPublishDraft 校验模板可发布并返回待发布的工作草稿：草稿模板返回 nil，已发布模板必须存在工作草稿
+func findPublishDraft(ctx context.Context, svcCtx *svc.ServiceContext, template *permissiontemplatemodel.PermissionTemplate) (*permissiontemplatedrafts.PermissionTemplateDraft, error) {
+	switch template.Status {
+	case permissiontemplatemodel.StatusDraft:
+		return nil, nil
+	case permissiontemplatemodel.StatusPublished:
+		draft, err := findWorkingDraft(ctx, svcCtx, template.Id)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("查询权限模板工作草稿失败: %v", err)
+			return nil, err
+		}
+		if draft == nil {
+			logx.WithContext(ctx).Errorf("已发布模板没有待发布的工作草稿: id=%s", template.Id)
+			return nil, permissiontemplatemodel.ErrPermissionTemplateStatusTransitionInvalid
+		}
+		return draft, nil
+	default:
+		logx.WithContext(ctx).Errorf("只有草稿或存在工作草稿的已发布模板可以发布，当前状态: %s", template.Status)
+		return nil, permissiontemplatemodel.ErrPermissionTemplateStatusTransitionInvalid
+	}
+}
+
+// publishedState 返回模板发布后的状态（应用工作草稿内容、版本号递增）
+func publishedState(template *permissiontemplatemodel.PermissionTemplate, draft *permissiontemplatedrafts.PermissionTemplateDraft) *permissiontemplatemodel.PermissionTemplate {
+	after := *template
+	if draft != nil {
+		after = *applyDraft(template, draft)
+	}
+	after.Version = template.Version + 1
+	after.Status = permissiontemplatemodel.StatusPublished
+	return &after
+}
+
+// disabledState 返回模板停用后的状态
+func disabledState(template *permissiontemplatemodel.PermissionTemplate) *permissiontemplatemodel.PermissionTemplate {
+	after := *template
+	after.Status = permissiontemplatemodel.StatusDisabled
+	return &after
+}
+
+// analyzeTemplateImpact 对比模板变更前后（template -> after）绑定用户的有效权限，按用户和主部门汇总新增、失去的模块动作
+// 权限仍可通过其他角色获得的模块动作不计入变化；失去权限的用户数超过阈值时生成确认令牌
+func analyzeTemplateImpact(ctx context.Context, svcCtx *svc.ServiceContext, template, after *permissiontemplatemodel.PermissionTemplate, operation string) (*types.PreviewPermissionTemplateImpactResp, error) {
+	// 1. 查询绑定了使用该模板及其子模板角色的用户（含 PermissionRole 直接引用模板编码的历史绑定）
+	descendants, err := descendantTemplates(ctx, svcCtx, template.Id)
+	if err != nil {
+		return nil, fmt.Errorf("查询子模板失败: %w", err)
+	}
+	roleIds := make([]string, 0)
+	permissionRoles := make([]string, 0, len(descendants)+1)
+	for _, tpl := range append([]*permissiontemplatemodel.PermissionTemplate{template}, descendants...) {
+		roleList, err := svcCtx.RoleModel.FindByTemplateId(ctx, tpl.Id)
+		if err != nil {
+			return nil, fmt.Errorf("查询模板关联角色失败: %w", err)
+		}
+		permissionRoles = append(permissionRoles, tpl.Code)
+		for _, role := range roleList {
+			roleIds = append(roleIds, role.Id)
+			permissionRoles = append(permissionRoles, role.Code)
+		}
+	}
+	userIds, err := svcCtx.RoleBindingModel.FindUserIdsByRoleRefs(ctx, roleIds, permissionRoles)
+	if err != nil {
+		return nil, fmt.Errorf("查询模板绑定用户失败: %w", err)
+	}
+
+	resp := &types.PreviewPermissionTemplateImpactResp{
+		TemplateId:     template.Id,
+		Operation:      operation,
+		FromVersion:    template.Version,
+		ToVersion:      after.Version,
+		BoundUserCount: len(userIds),
+		Departments:    make([]types.TemplateImpactDepartment, 0),
+		Users:          make([]types.TemplateImpactUser, 0),
+	}
+
+	// 2. 逐个用户对比变更前后的有效权限
+	deptNames := make(map[string]string)
+	for _, userId := range userIds {
+		beforeGrants, err := svcCtx.PermissionResolver.Grants(ctx, userId)
+		if err != nil {
+			return nil, err
+		}
+		afterGrants, err := svcCtx.PermissionResolver.GrantsWithOverride(ctx, userId, after)
+		if err != nil {
+			return nil, err
+		}
+		before, afterEffective := authz.Merge(beforeGrants), authz.Merge(afterGrants)
+		gained := uncoveredKeys(afterEffective, before)
+		lost := uncoveredKeys(before, afterEffective)
+		if len(gained) == 0 && len(lost) == 0 {
+			continue
+		}
+
+		impactUser := types.TemplateImpactUser{UserId: userId, Gained: gained, Lost: lost}
+		if user, err := svcCtx.UserModel.FindOne(ctx, userId); err == nil && user != nil {
+			impactUser.UserName = user.Name
+			impactUser.DeptId = stringValue(user.DeptId)
+		}
+		impactUser.DeptName = deptName(ctx, svcCtx, deptNames, impactUser.DeptId)
+		resp.Users = append(resp.Users, impactUser)
+		if len(lost) > 0 {
+			resp.LosingUserCount++
+		}
+	}
+	resp.AffectedUserCount = len(resp.Users)
+
+	// 3. 按主部门汇总
+	resp.Departments = aggregateImpactByDept(resp.Users)
+
+	// 4. 失去权限的用户数超过阈值时，执行操作需提交本次预览的确认令牌
+	threshold := svcCtx.Config.PermissionTemplate.ImpactAckThreshold
+	if threshold > 0 && resp.LosingUserCount > threshold {
+		resp.AckRequired = true
+		resp.AckToken = impactAckToken(svcCtx.Config.Auth.AccessSecret, after, resp)
+	}
+
+	return resp, nil
+}
+
+// checkImpactAck 失去权限的用户数超过阈值时校验确认令牌，未配置阈值时不做分析
+func checkImpactAck(ctx context.Context, svcCtx *svc.ServiceContext, template, after *permissiontemplatemodel.PermissionTemplate, operation, ackToken string) error {
+	if svcCtx.Config.PermissionTemplate.ImpactAckThreshold <= 0 {
+		return nil
+	}
+	impact, err := analyzeTemplateImpact(ctx, svcCtx, template, after, operation)
+	if err != nil {
+		return err
+	}
+	if !impact.AckRequired || hmac.Equal([]byte(ackToken), []byte(impact.AckToken)) {
+		return nil
+	}
+	logx.WithContext(ctx).Errorf("模板变更将导致 %d 个用户失去权限，缺少有效的确认令牌: id=%s, operation=%s",
+		impact.LosingUserCount, template.Id, operation)
+	return permissiontemplatemodel.ErrPermissionTemplateImpactAckRequired
+}
+
+// uncoveredKeys 返回 from 中存在而 to 不再允许的权限（模块:动作，升序）
+func uncoveredKeys(from, to *authz.EffectivePermissions) []string {
+	keys := make([]string, 0)
+	for module, perm := range from.Modules {
+		for _, action := range perm.Actions {
+			if !to.Allows(module, action) {
+				keys = append(keys, module+":"+action)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// deptName 查询部门名称（按部门ID缓存），未分配部门时返回默认名称
+func deptName(ctx context.Context, svcCtx *svc.ServiceContext, cache map[string]string, deptId string) string {
+	if deptId == "" {
+		return unassignedDeptName
+	}
+	if name, ok := cache[deptId]; ok {
+		return name
+	}
+	name := ""
+	if org, err := svcCtx.OrgModel.FindOne(ctx, deptId); err == nil && org != nil {
+		name = org.Name
+	}
+	cache[deptId] = name
+	return name
+}
+
+// aggregateImpactByDept 按主部门汇总用户权限变化，失去权限的用户多的部门在前
+func aggregateImpactByDept(users []types.TemplateImpactUser) []types.TemplateImpactDepartment {
+	type deptImpact struct {
+		dept   types.TemplateImpactDepartment
+		gained map[string]int
+		lost   map[string]int
+	}
+	impacts := make(map[string]*deptImpact)
+	for _, user := range users {
+		impact, ok := impacts[user.DeptId]
+		if !ok {
+			impact = &deptImpact{
+				dept:   types.TemplateImpactDepartment{DeptId: user.DeptId, DeptName: user.DeptName},
+				gained: make(map[string]int),
+				lost:   make(map[string]int),
+			}
+			impacts[user.DeptId] = impact
+		}
+		impact.dept.UserCount++
+		if len(user.Lost) > 0 {
+			impact.dept.LosingUserCount++
+		}
+		for _, key := range user.Gained {
+			impact.gained[key]++
+		}
+		for _, key := range user.Lost {
+			impact.lost[key]++
+		}
+	}
+
+	departments := make([]types.TemplateImpactDepartment, 0, len(impacts))
+	for _, impact := range impacts {
+		impact.dept.Gained = impactActions(impact.gained)
+		impact.dept.Lost = impactActions(impact.lost)
+		departments = append(departments, impact.dept)
+	}
+	sort.Slice(departments, func(i, j int) bool {
+		if departments[i].LosingUserCount != departments[j].LosingUserCount {
+			return departments[i].LosingUserCount > departments[j].LosingUserCount
+		}
+		return departments[i].DeptId < departments[j].DeptId
+	})
+	return departments
+}
+
+// impactActions 将 模块:动作 -> 用户数 转换为按权限标识排序的列表
+func impactActions(counts map[string]int) []types.TemplateImpactAction {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	actions := make([]types.TemplateImpactAction, 0, len(keys))
+	for _, key := range keys {
+		module, action, _ := authz.SplitPermissionKey(key)
+		actions = append(actions, types.TemplateImpactAction{Module: module, Action: action, UserCount: counts[key]})
+	}
+	return actions
+}
+
+// impactAckToken 生成影响预览确认令牌，绑定模板变更内容和失去权限的用户明细，内容或绑定变化后令牌失效
+func impactAckToken(secret string, after *permissiontemplatemodel.PermissionTemplate, impact *types.PreviewPermissionTemplateImpactResp) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s|%s|%d|%d|%s|", impact.TemplateId, impact.Operation, impact.FromVersion, impact.ToVersion, after.Status)
+	mac.Write(after.PolicyMatrix)
+	mac.Write([]byte("|"))
+	mac.Write(after.AdvancedPerms)
+	for _, user := range impact.Users {
+		if len(user.Lost) > 0 {
+			fmt.Fprintf(mac, "|%s=%s", user.UserId, strings.Join(user.Lost, ","))
+		}
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,206 @@
+package permission_template
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/config"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/testutil"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	permissiontemplatedrafts "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_drafts"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupImpactTest 创建影响预览测试上下文（SQLite）
+// editor 模板（版本 1）授予 user:read、user:delete，工作草稿改为 user:read、user:update；
+// 角色 editor_role 固定版本 1。用户：
+//   - user-1（研发部）通过 editor_role 绑定
+//   - user-2（研发部）通过历史绑定直接引用模板编码
+//   - user-3（市场部）通过 editor_role 绑定，另通过 viewer 模板获得 user:delete
+//   - user-4（未分配部门）通过 editor_role 绑定
+func setupImpactTest(t *testing.T, threshold int) *svc.ServiceContext {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&users.User{}, &organization.SysOrganization{}, &rolebindings.RoleBinding{}))
+
+	testutil.CreatePermissionTables(t, db)
+
+	now := time.Now()
+	for _, template := range []*permissiontemplatemodel.PermissionTemplate{
+		{Id: "tpl-editor", Name: "编辑", Code: "editor", Status: permissiontemplatemodel.StatusPublished,
+			PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read","delete"],"scope":"global"}}`), Version: 1, CreatedBy: "system", CreatedAt: now, UpdatedAt: now},
+		{Id: "tpl-viewer", Name: "查看", Code: "viewer", Status: permissiontemplatemodel.StatusPublished,
+			PolicyMatrix: datatypes.JSON(`{"user":{"actions":["delete"],"scope":"global"}}`), Version: 1, CreatedBy: "system", CreatedAt: now, UpdatedAt: now},
+	} {
+		require.NoError(t, db.Create(template).Error)
+	}
+	require.NoError(t, db.Create(&permissiontemplateversions.PermissionTemplateVersion{
+		Id: "ver-editor-1", TemplateId: "tpl-editor", Version: 1, Name: "编辑", Code: "editor",
+		PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read","delete"],"scope":"global"}}`),
+		PublishedBy:  "system", PublishedAt: now,
+	}).Error)
+	for _, role := range []*roles.Role{
+		{Id: "role-editor", Code: "editor_role", Name: "编辑角色", Scope: roles.ScopeGlobal, TemplateId: "tpl-editor", TemplateVersion: 1},
+		{Id: "role-viewer", Code: "viewer_role", Name: "查看角色", Scope: roles.ScopeGlobal, TemplateId: "tpl-viewer", TemplateVersion: 1},
+	} {
+		role.TemplateAppliedAt, role.CreatedBy, role.CreatedAt, role.UpdatedAt = now, "system", now, now
+		require.NoError(t, db.Create(role).Error)
+	}
+
+	for _, org := range []*organization.SysOrganization{
+		{Id: "dept-rd", Name: "研发部", Code: "rd"},
+		{Id: "dept-mkt", Name: "市场部", Code: "mkt"},
+	} {
+		org.CreatedAt, org.UpdatedAt = now.Format("2006-01-02 15:04:05"), now.Format("2006-01-02 15:04:05")
+		require.NoError(t, db.Create(org).Error)
+	}
+	rd, mkt := "dept-rd", "dept-mkt"
+	for _, user := range []*users.User{
+		{Id: "user-1", Name: "张三", Email: "u1@example.com", DeptId: &rd},
+		{Id: "user-2", Name: "李四", Email: "u2@example.com", DeptId: &rd},
+		{Id: "user-3", Name: "王五", Email: "u3@example.com", DeptId: &mkt},
+		{Id: "user-4", Name: "赵六", Email: "u4@example.com"},
+	} {
+		require.NoError(t, db.Create(user).Error)
+	}
+	editorRole, viewerRole, legacyCode := "role-editor", "role-viewer", "editor"
+	for _, binding := range []*rolebindings.RoleBinding{
+		{UserId: "user-1", OrgId: rd, RoleId: &editorRole},
+		{UserId: "user-2", OrgId: rd, PermissionRole: &legacyCode},
+		{UserId: "user-3", OrgId: mkt, RoleId: &editorRole},
+		{UserId: "user-3", OrgId: mkt, RoleId: &viewerRole},
+		{UserId: "user-4", OrgId: rd, RoleId: &editorRole},
+	} {
+		require.NoError(t, db.Create(binding).Error)
+	}
+
+	cfg := config.Config{}
+	cfg.Auth.AccessSecret = "test-secret"
+	cfg.PermissionTemplate.ImpactAckThreshold = threshold
+	roleBindingModel := rolebindings.NewModel(db)
+	roleModel := roles.NewModel(db)
+	templateModel := permissiontemplatemodel.NewModel(db)
+	versionModel := permissiontemplateversions.NewModel(db)
+	return &svc.ServiceContext{
+		Config:                          cfg,
+		DB:                              db,
+		UserModel:                       users.NewModel(db),
+		OrgModel:                        organization.NewModel(db),
+		RoleBindingModel:                roleBindingModel,
+		RoleModel:                       roleModel,
+		PermissionTemplateModel:         templateModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  versionModel,
+		PermissionTemplateDraftModel: &MockPermissionTemplateDraftModel{Drafts: map[string]*permissiontemplatedrafts.PermissionTemplateDraft{
+			"tpl-editor": {
+				TemplateId: "tpl-editor", Name: "编辑", Code: "editor", BaseVersion: 1,
+				PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read","update"],"scope":"global"}}`),
+			},
+		}},
+		PermissionResolver: authz.NewResolver(roleBindingModel, roleModel, templateModel, versionModel),
+	}
+}
+
+func TestPreviewPermissionTemplateImpact_Disable(t *testing.T) {
+	svcCtx := setupImpactTest(t, 0)
+
+	resp, err := NewPreviewPermissionTemplateImpactLogic(context.Background(), svcCtx).PreviewPermissionTemplateImpact(&types.PreviewPermissionTemplateImpactReq{
+		Id: "tpl-editor", Operation: ImpactOperationDisable,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, resp.BoundUserCount)
+	assert.Equal(t, 4, resp.AffectedUserCount)
+	assert.Equal(t, 4, resp.LosingUserCount)
+	assert.False(t, resp.AckRequired)
+	assert.Empty(t, resp.AckToken)
+
+	require.Len(t, resp.Users, 4)
+	assert.Equal(t, []string{"user:delete", "user:read"}, resp.Users[0].Lost)
+	assert.Equal(t, "张三", resp.Users[0].UserName)
+	assert.Equal(t, "研发部", resp.Users[0].DeptName)
+	assert.Equal(t, []string{"user:read"}, resp.Users[2].Lost, "user:delete 仍通过 viewer 模板获得")
+
+	require.Len(t, resp.Departments, 3)
+	assert.Equal(t, "dept-rd", resp.Departments[0].DeptId)
+	assert.Equal(t, 2, resp.Departments[0].LosingUserCount)
+	assert.Equal(t, []types.TemplateImpactAction{
+		{Module: "user", Action: "delete", UserCount: 2},
+		{Module: "user", Action: "read", UserCount: 2},
+	}, resp.Departments[0].Lost)
+	assert.Equal(t, unassignedDeptName, resp.Departments[1].DeptName)
+	assert.Equal(t, "市场部", resp.Departments[2].DeptName)
+}
+
+func TestPreviewPermissionTemplateImpact_PublishKeepsPinnedRoles(t *testing.T) {
+	svcCtx := setupImpactTest(t, 0)
+
+	resp, err := NewPreviewPermissionTemplateImpactLogic(context.Background(), svcCtx).PreviewPermissionTemplateImpact(&types.PreviewPermissionTemplateImpactReq{
+		Id: "tpl-editor", Operation: ImpactOperationPublish,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.FromVersion)
+	assert.Equal(t, 2, resp.ToVersion)
+	assert.Equal(t, 4, resp.BoundUserCount)
+	// 固定版本 1 的角色仍按快照授权，仅历史绑定随模板内容变化
+	require.Len(t, resp.Users, 1)
+	assert.Equal(t, "user-2", resp.Users[0].UserId)
+	assert.Equal(t, []string{"user:update"}, resp.Users[0].Gained)
+	assert.Equal(t, []string{"user:delete"}, resp.Users[0].Lost)
+	assert.Equal(t, 1, resp.LosingUserCount)
+}
+
+func TestDisablePermissionTemplate_RequiresImpactAck(t *testing.T) {
+	svcCtx := setupImpactTest(t, 2)
+	ctx := context.Background()
+
+	preview, err := NewPreviewPermissionTemplateImpactLogic(ctx, svcCtx).PreviewPermissionTemplateImpact(&types.PreviewPermissionTemplateImpactReq{
+		Id: "tpl-editor", Operation: ImpactOperationDisable,
+	})
+	require.NoError(t, err)
+	require.True(t, preview.AckRequired)
+	require.NotEmpty(t, preview.AckToken)
+
+	disableLogic := NewDisablePermissionTemplateLogic(ctx, svcCtx)
+	resp, err := disableLogic.DisablePermissionTemplate(&types.DisablePermissionTemplateReq{Id: "tpl-editor"})
+	assert.Equal(t, permissiontemplatemodel.ErrPermissionTemplateImpactAckRequired, err)
+	assert.Nil(t, resp)
+
+	resp, err = disableLogic.DisablePermissionTemplate(&types.DisablePermissionTemplateReq{Id: "tpl-editor", AckToken: "forged"})
+	assert.Equal(t, permissiontemplatemodel.ErrPermissionTemplateImpactAckRequired, err)
+	assert.Nil(t, resp)
+
+	resp, err = disableLogic.DisablePermissionTemplate(&types.DisablePermissionTemplateReq{Id: "tpl-editor", AckToken: preview.AckToken})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestPublishPermissionTemplate_ImpactBelowThreshold(t *testing.T) {
+	svcCtx := setupImpactTest(t, 1)
+
+	// 发布只影响 1 个用户，未超过阈值，无需确认令牌
+	resp, err := NewPublishPermissionTemplateLogic(context.Background(), svcCtx).PublishPermissionTemplate(&types.PublishPermissionTemplateReq{Id: "tpl-editor"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Version)
+}
@@ -0,0 +1,147 @@
+package permission_template
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// validateTemplateParent 校验父模板存在且继承关系无循环（templateId 为空表示新建模板）
+func validateTemplateParent(ctx context.Context, svcCtx *svc.ServiceContext, templateId, parentId string) error {
+	if parentId == "" {
+		return nil
+	}
+	if parentId == templateId {
+		return permissiontemplatemodel.ErrPermissionTemplateInheritanceCycle
+	}
+
+	// 1. 父模板必须存在
+	parent, err := svcCtx.PermissionTemplateModel.FindOne(ctx, parentId)
+	if err != nil {
+		if errors.Is(err, permissiontemplatemodel.ErrPermissionTemplateNotFound) {
+			return permissiontemplatemodel.ErrPermissionTemplateParentNotFound
+		}
+		return err
+	}
+
+	// 2. 沿父模板的继承链向上查找，出现当前模板或超过最大深度即为循环
+	current := parent
+	for depth := 1; current.ParentId != nil && *current.ParentId != ""; depth++ {
+		if *current.ParentId == templateId || depth >= authz.MaxInheritanceDepth {
+			return permissiontemplatemodel.ErrPermissionTemplateInheritanceCycle
+		}
+		current, err = svcCtx.PermissionTemplateModel.FindOne(ctx, *current.ParentId)
+		if err != nil {
+			if errors.Is(err, permissiontemplatemodel.ErrPermissionTemplateNotFound) {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePublishParent 发布前重新校验继承关系，父模板必须已发布（父模板可能在保存后被修改或停用）
+func validatePublishParent(ctx context.Context, svcCtx *svc.ServiceContext, template *permissiontemplatemodel.PermissionTemplate) error {
+	if template.ParentId == nil || *template.ParentId == "" {
+		return nil
+	}
+	if err := validateTemplateParent(ctx, svcCtx, template.Id, *template.ParentId); err != nil {
+		return err
+	}
+	parent, err := svcCtx.PermissionTemplateModel.FindOne(ctx, *template.ParentId)
+	if err != nil {
+		return err
+	}
+	if parent.Status != permissiontemplatemodel.StatusPublished {
+		return permissiontemplatemodel.ErrPermissionTemplateParentNotPublished
+	}
+	return nil
+}
+
+// effectivePolicyMatrix 返回模板继承父模板后的有效策略矩阵（未继承时即自身策略矩阵）
+func effectivePolicyMatrix(ctx context.Context, svcCtx *svc.ServiceContext, template *permissiontemplatemodel.PermissionTemplate) (map[string]types.PolicyMatrixEntry, error) {
+	matrix, err := authz.InheritedMatrix(ctx, svcCtx.PermissionTemplateModel, template, nil)
+	if err != nil {
+		return nil, err
+	}
+	effective := make(map[string]types.PolicyMatrixEntry, len(matrix))
+	for module, entry := range matrix {
+		effective[module] = types.PolicyMatrixEntry{Actions: entry.Actions, Scope: entry.Scope}
+	}
+	return effective, nil
+}
+
+// descendantTemplates 返回直接或间接继承指定模板的全部子模板（按层级广度优先）
+func descendantTemplates(ctx context.Context, svcCtx *svc.ServiceContext, templateId string) ([]*permissiontemplatemodel.PermissionTemplate, error) {
+	descendants := make([]*permissiontemplatemodel.PermissionTemplate, 0)
+	visited := map[string]struct{}{templateId: {}}
+	queue := []string{templateId}
+	for len(queue) > 0 {
+		children, err := svcCtx.PermissionTemplateModel.FindByParentId(ctx, queue[0])
+		if err != nil {
+			return nil, err
+		}
+		queue = queue[1:]
+		for _, child := range children {
+			if _, ok := visited[child.Id]; ok {
+				continue
+			}
+			visited[child.Id] = struct{}{}
+			descendants = append(descendants, child)
+			queue = append(queue, child.Id)
+		}
+	}
+	return descendants, nil
+}
+
+// toChildTemplates 将子模板转换为响应类型
+func toChildTemplates(templates []*permissiontemplatemodel.PermissionTemplate) []types.ChildTemplate {
+	children := make([]types.ChildTemplate, 0, len(templates))
+	for _, template := range templates {
+		children = append(children, types.ChildTemplate{
+			Id:       template.Id,
+			Code:     template.Code,
+			Name:     template.Name,
+			Status:   template.Status,
+			ParentId: stringValue(template.ParentId),
+		})
+	}
+	return children
+}
+
+// marshalPolicyRemovals 序列化策略移除项，为空时返回 nil
+func marshalPolicyRemovals(removals map[string][]string) ([]byte, error) {
+	if len(removals) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(removals)
+}
+
+// parsePolicyRemovals 解析策略移除项，解析失败时记录错误并返回 nil
+func parsePolicyRemovals(ctx context.Context, data []byte) map[string][]string {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+	var removals map[string][]string
+	if err := json.Unmarshal(data, &removals); err != nil {
+		logx.WithContext(ctx).Errorf("解析策略移除项失败: %v", err)
+		return nil
+	}
+	return removals
+}
+
+// optionalString 空字符串转为 nil
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
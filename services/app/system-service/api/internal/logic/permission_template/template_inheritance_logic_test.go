@@ -0,0 +1,124 @@
+package permission_template
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createChildTemplate 通过创建接口新建继承 parentId 的草稿模板
+func createChildTemplate(t *testing.T, svcCtx *svc.ServiceContext, code, parentId string) string {
+	resp, err := NewCreatePermissionTemplateLogic(context.Background(), svcCtx).CreatePermissionTemplate(&types.CreatePermissionTemplateReq{
+		Name:           code,
+		Code:           code,
+		PolicyMatrix:   map[string]types.PolicyMatrixEntry{"audit": {Actions: []string{"read"}, Scope: "global"}},
+		ParentId:       parentId,
+		PolicyRemovals: map[string][]string{"user": {"delete"}},
+	})
+	require.NoError(t, err)
+	return resp.Id
+}
+
+func TestCreatePermissionTemplate_RejectsMissingParent(t *testing.T) {
+	svcCtx := setupImpactTest(t, 0)
+
+	resp, err := NewCreatePermissionTemplateLogic(context.Background(), svcCtx).CreatePermissionTemplate(&types.CreatePermissionTemplateReq{
+		Name:         "子模板",
+		Code:         "child",
+		PolicyMatrix: map[string]types.PolicyMatrixEntry{"audit": {Actions: []string{"read"}}},
+		ParentId:     "tpl-missing",
+	})
+
+	assert.Equal(t, permissiontemplatemodel.ErrPermissionTemplateParentNotFound, err)
+	assert.Nil(t, resp)
+}
+
+func TestUpdatePermissionTemplate_RejectsInheritanceCycle(t *testing.T) {
+	svcCtx := setupImpactTest(t, 0)
+	childId := createChildTemplate(t, svcCtx, "child", "tpl-viewer")
+	grandchildId := createChildTemplate(t, svcCtx, "grandchild", childId)
+	updateLogic := NewUpdatePermissionTemplateLogic(context.Background(), svcCtx)
+
+	for _, parentId := range []string{"tpl-viewer", grandchildId} {
+		resp, err := updateLogic.UpdatePermissionTemplate(&types.UpdatePermissionTemplateReq{
+			Id:           "tpl-viewer",
+			Name:         "查看",
+			Code:         "viewer",
+			PolicyMatrix: map[string]types.PolicyMatrixEntry{"user": {Actions: []string{"delete"}, Scope: "global"}},
+			ParentId:     parentId,
+		})
+		assert.Equal(t, permissiontemplatemodel.ErrPermissionTemplateInheritanceCycle, err, parentId)
+		assert.Nil(t, resp)
+	}
+}
+
+func TestGetPermissionTemplate_ReturnsOwnAndEffectiveMatrix(t *testing.T) {
+	svcCtx := setupImpactTest(t, 0)
+	childId := createChildTemplate(t, svcCtx, "child", "tpl-editor")
+	getLogic := NewGetPermissionTemplateLogic(context.Background(), svcCtx)
+
+	resp, err := getLogic.GetPermissionTemplate(&types.GetPermissionTemplateReq{Id: childId})
+
+	require.NoError(t, err)
+	assert.Equal(t, "tpl-editor", resp.Data.ParentId)
+	assert.Equal(t, map[string][]string{"user": {"delete"}}, resp.Data.PolicyRemovals)
+	assert.Equal(t, map[string]types.PolicyMatrixEntry{
+		"audit": {Actions: []string{"read"}, Scope: "global"},
+	}, resp.Data.PolicyMatrix)
+	assert.Equal(t, map[string]types.PolicyMatrixEntry{
+		"audit": {Actions: []string{"read"}, Scope: "global"},
+		"user":  {Actions: []string{"read"}, Scope: "global"},
+	}, resp.Data.EffectivePolicyMatrix)
+
+	parent, err := getLogic.GetPermissionTemplate(&types.GetPermissionTemplateReq{Id: "tpl-editor"})
+	require.NoError(t, err)
+	require.Len(t, parent.Data.Children, 1)
+	assert.Equal(t, childId, parent.Data.Children[0].Id)
+}
+
+func TestPublishPermissionTemplate_InheritanceRules(t *testing.T) {
+	svcCtx := setupImpactTest(t, 0)
+	ctx := context.Background()
+	draftParentId := createChildTemplate(t, svcCtx, "draft_parent", "")
+	orphanId := createChildTemplate(t, svcCtx, "orphan", draftParentId)
+	childId := createChildTemplate(t, svcCtx, "child", "tpl-editor")
+	grandchildId := createChildTemplate(t, svcCtx, "grandchild", childId)
+	publishLogic := NewPublishPermissionTemplateLogic(ctx, svcCtx)
+
+	// 父模板未发布时子模板不能发布
+	resp, err := publishLogic.PublishPermissionTemplate(&types.PublishPermissionTemplateReq{Id: orphanId})
+	assert.Equal(t, permissiontemplatemodel.ErrPermissionTemplateParentNotPublished, err)
+	assert.Nil(t, resp)
+
+	// 发布子模板：版本快照保存合并父模板后的有效策略矩阵
+	_, err = publishLogic.PublishPermissionTemplate(&types.PublishPermissionTemplateReq{Id: childId})
+	require.NoError(t, err)
+	snapshot, err := svcCtx.PermissionTemplateVersionModel.FindOne(ctx, childId, 2)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"audit":{"actions":["read"],"scope":"global"},"user":{"actions":["read"],"scope":"global"}}`, string(snapshot.PolicyMatrix))
+
+	// 发布父模板：返回直接和间接继承的子模板
+	resp, err = publishLogic.PublishPermissionTemplate(&types.PublishPermissionTemplateReq{Id: "tpl-editor"})
+	require.NoError(t, err)
+	require.Len(t, resp.AffectedChildren, 2)
+	assert.Equal(t, childId, resp.AffectedChildren[0].Id)
+	assert.Equal(t, grandchildId, resp.AffectedChildren[1].Id)
+	assert.Equal(t, childId, resp.AffectedChildren[1].ParentId)
+}
+
+func TestDeletePermissionTemplate_RejectsTemplateWithChildren(t *testing.T) {
+	svcCtx := setupImpactTest(t, 0)
+	parentId := createChildTemplate(t, svcCtx, "parent", "")
+	createChildTemplate(t, svcCtx, "child", parentId)
+
+	resp, err := NewDeletePermissionTemplateLogic(context.Background(), svcCtx).DeletePermissionTemplate(&types.DeletePermissionTemplateReq{Id: parentId})
+
+	assert.Equal(t, permissiontemplatemodel.ErrPermissionTemplateHasChildren, err)
+	assert.Nil(t, resp)
+}
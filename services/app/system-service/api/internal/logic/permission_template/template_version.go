@@ -0,0 +1,70 @@
+package permission_template
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+)
+
+// newVersionSnapshot 构建模板已发布版本的不可变快照（template 为发布后的模板内容）
+// 继承父模板的模板保存合并后的有效策略矩阵，固定版本的角色不受父模板后续发布影响
+func newVersionSnapshot(ctx context.Context, svcCtx *svc.ServiceContext, template *permissiontemplatemodel.PermissionTemplate) (*permissiontemplateversions.PermissionTemplateVersion, error) {
+	policyMatrix := template.PolicyMatrix
+	if template.ParentId != nil && *template.ParentId != "" {
+		effective, err := effectivePolicyMatrix(ctx, svcCtx, template)
+		if err != nil {
+			return nil, err
+		}
+		if policyMatrix, err = json.Marshal(effective); err != nil {
+			return nil, err
+		}
+	}
+	return &permissiontemplateversions.PermissionTemplateVersion{
+		TemplateId:      template.Id,
+		Version:         template.Version,
+		Name:            template.Name,
+		Code:            template.Code,
+		Description:     template.Description,
+		ScopeSuggestion: template.ScopeSuggestion,
+		PolicyMatrix:    policyMatrix,
+		AdvancedPerms:   template.AdvancedPerms,
+		PublishedBy:     currentOperatorID(ctx),
+		PublishedAt:     time.Now(),
+	}, nil
+}
+
+// versionPolicy 解析版本快照的策略矩阵和高级权限点
+func versionPolicy(snapshot *permissiontemplateversions.PermissionTemplateVersion) (map[string]types.PolicyMatrixEntry, map[string]types.AdvancedPermEntry, error) {
+	policyMatrix := make(map[string]types.PolicyMatrixEntry)
+	if err := json.Unmarshal(snapshot.PolicyMatrix, &policyMatrix); err != nil {
+		return nil, nil, fmt.Errorf("解析版本快照策略矩阵失败: version=%d, %w", snapshot.Version, err)
+	}
+	advancedPerms := make(map[string]types.AdvancedPermEntry)
+	if len(snapshot.AdvancedPerms) > 0 && string(snapshot.AdvancedPerms) != "null" {
+		if err := json.Unmarshal(snapshot.AdvancedPerms, &advancedPerms); err != nil {
+			return nil, nil, fmt.Errorf("解析版本快照高级权限点失败: version=%d, %w", snapshot.Version, err)
+		}
+	}
+	return policyMatrix, advancedPerms, nil
+}
+
+// toPermissionTemplateVersion 将版本快照转换为响应类型（不含策略内容）
+func toPermissionTemplateVersion(snapshot *permissiontemplateversions.PermissionTemplateVersion, currentVersion int) types.PermissionTemplateVersion {
+	return types.PermissionTemplateVersion{
+		TemplateId:      snapshot.TemplateId,
+		Version:         snapshot.Version,
+		Name:            snapshot.Name,
+		Code:            snapshot.Code,
+		Description:     stringValue(snapshot.Description),
+		ScopeSuggestion: stringValue(snapshot.ScopeSuggestion),
+		IsCurrent:       snapshot.Version == currentVersion,
+		PublishedBy:     snapshot.PublishedBy,
+		PublishedAt:     snapshot.PublishedAt.Format("2006-01-02 15:04:05.000"),
+	}
+}
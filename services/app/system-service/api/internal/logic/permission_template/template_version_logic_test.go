@@ -0,0 +1,200 @@
+package permission_template
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/config"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+// newVersionTestContext 创建包含两个已发布版本快照的测试上下文（模板当前版本为 3）
+func newVersionTestContext() (*svc.ServiceContext, *MockPermissionTemplateModel, *MockPermissionTemplateVersionModel) {
+	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOne", mock.Anything, "tpl-1").Return(&permissiontemplatemodel.PermissionTemplate{
+		Id: "tpl-1", Code: "user_admin", Name: "用户管理", Status: permissiontemplatemodel.StatusPublished, Version: 3,
+	}, nil)
+	mockModel.On("FindOne", mock.Anything, mock.Anything).Return(nil, permissiontemplatemodel.ErrPermissionTemplateNotFound)
+
+	now := time.Now()
+	versionModel := &MockPermissionTemplateVersionModel{Versions: []*permissiontemplateversions.PermissionTemplateVersion{
+		{
+			Id: "ver-2", TemplateId: "tpl-1", Version: 2, Name: "用户管理", Code: "user_admin",
+			PolicyMatrix:  datatypes.JSON(`{"user":{"actions":["read","update"],"scope":"organization"},"menu":{"actions":["read"],"scope":"global"}}`),
+			AdvancedPerms: datatypes.JSON(`{"export":{"enabled":true},"approve":{"enabled":false}}`),
+			PublishedBy:   "admin", PublishedAt: now.Add(-time.Hour),
+		},
+		{
+			Id: "ver-3", TemplateId: "tpl-1", Version: 3, Name: "用户管理", Code: "user_admin",
+			PolicyMatrix:  datatypes.JSON(`{"user":{"actions":["read","delete"],"scope":"global"},"role":{"actions":["read"],"scope":"global"}}`),
+			AdvancedPerms: datatypes.JSON(`{"export":{"enabled":true},"approve":{"enabled":true}}`),
+			PublishedBy:   "admin", PublishedAt: now,
+		},
+	}}
+
+	svcCtx := &svc.ServiceContext{
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  versionModel,
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
+	}
+	return svcCtx, mockModel, versionModel
+}
+
+func TestPublishPermissionTemplate_SavesVersionSnapshot(t *testing.T) {
+	template := &permissiontemplatemodel.PermissionTemplate{
+		Id:           "draft-id",
+		Name:         "草稿模板",
+		Code:         "draft_template",
+		Status:       permissiontemplatemodel.StatusDraft,
+		PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read"],"scope":"global"}}`),
+		Version:      1,
+	}
+	mockModel := new(MockPermissionTemplateModel)
+	mockModel.On("FindOne", mock.Anything, "draft-id").Return(template, nil)
+	mockModel.On("UpdateVersionWithStatus", mock.Anything, "draft-id", 2, permissiontemplatemodel.StatusPublished).Return(nil)
+	versionModel := &MockPermissionTemplateVersionModel{}
+	svcCtx := &svc.ServiceContext{
+		Config:                          config.Config{},
+		DB:                              newTestTxDB(t),
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  versionModel,
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
+	}
+	ctx := context.WithValue(context.Background(), contextkeys.UserIDKey, "publisher-1")
+
+	resp, err := NewPublishPermissionTemplateLogic(ctx, svcCtx).PublishPermissionTemplate(&types.PublishPermissionTemplateReq{Id: "draft-id"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Version)
+	require.Len(t, versionModel.Versions, 1)
+	snapshot := versionModel.Versions[0]
+	assert.Equal(t, "draft-id", snapshot.TemplateId)
+	assert.Equal(t, 2, snapshot.Version)
+	assert.Equal(t, "publisher-1", snapshot.PublishedBy)
+	assert.JSONEq(t, `{"user":{"actions":["read"],"scope":"global"}}`, string(snapshot.PolicyMatrix))
+}
+
+func TestListPermissionTemplateVersions_MarksCurrentVersion(t *testing.T) {
+	svcCtx, _, _ := newVersionTestContext()
+
+	resp, err := NewListPermissionTemplateVersionsLogic(context.Background(), svcCtx).ListPermissionTemplateVersions(&types.ListPermissionTemplateVersionsReq{Id: "tpl-1"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 2)
+	assert.Equal(t, 3, resp.Data[0].Version)
+	assert.True(t, resp.Data[0].IsCurrent)
+	assert.Equal(t, 2, resp.Data[1].Version)
+	assert.False(t, resp.Data[1].IsCurrent)
+	assert.Nil(t, resp.Data[1].PolicyMatrix)
+}
+
+func TestGetPermissionTemplateVersion(t *testing.T) {
+	svcCtx, _, _ := newVersionTestContext()
+	logic := NewGetPermissionTemplateVersionLogic(context.Background(), svcCtx)
+
+	resp, err := logic.GetPermissionTemplateVersion(&types.GetPermissionTemplateVersionReq{Id: "tpl-1", Version: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Data.Version)
+	assert.False(t, resp.Data.IsCurrent)
+	assert.Equal(t, []string{"read", "update"}, resp.Data.PolicyMatrix["user"].Actions)
+	assert.True(t, resp.Data.AdvancedPerms["export"].Enabled)
+
+	resp, err = logic.GetPermissionTemplateVersion(&types.GetPermissionTemplateVersionReq{Id: "tpl-1", Version: 9})
+	assert.ErrorIs(t, err, permissiontemplateversions.ErrTemplateVersionNotFound)
+	assert.Nil(t, resp)
+}
+
+func TestDiffPermissionTemplateVersions(t *testing.T) {
+	svcCtx, _, _ := newVersionTestContext()
+
+	resp, err := NewDiffPermissionTemplateVersionsLogic(context.Background(), svcCtx).DiffPermissionTemplateVersions(&types.DiffPermissionTemplateVersionsReq{Id: "tpl-1", From: 2, To: 3})
+
+	require.NoError(t, err)
+	assert.Equal(t, []types.PolicyModuleDiff{
+		{Module: "menu", ChangeType: ChangeTypeRemoved, AddedActions: []string{}, RemovedActions: []string{"read"}, OldScope: "global"},
+		{Module: "role", ChangeType: ChangeTypeAdded, AddedActions: []string{"read"}, RemovedActions: []string{}, NewScope: "global"},
+		{Module: "user", ChangeType: ChangeTypeModified, AddedActions: []string{"delete"}, RemovedActions: []string{"update"}, OldScope: "organization", NewScope: "global"},
+	}, resp.Modules)
+	assert.Equal(t, []types.AdvancedPermDiff{
+		{Key: "approve", ChangeType: ChangeTypeModified, OldEnabled: false, NewEnabled: true},
+	}, resp.AdvancedPerms)
+}
+
+func TestRestorePermissionTemplateVersion_CreatesDraft(t *testing.T) {
+	svcCtx, mockModel, versionModel := newVersionTestContext()
+	mockModel.On("FindOneByCodeIncludingDeleted", mock.Anything, "user_admin_v2").Return(nil, permissiontemplatemodel.ErrPermissionTemplateNotFound)
+	mockModel.On("Insert", mock.Anything, mock.Anything).Return(&permissiontemplatemodel.PermissionTemplate{
+		Id: "restored-id", Code: "user_admin_v2", Status: permissiontemplatemodel.StatusDraft, Version: 1,
+	}, nil)
+	auditModel := &MockPermissionTemplateAuditLogModel{}
+	svcCtx.PermissionTemplateAuditLogModel = auditModel
+
+	resp, err := NewRestorePermissionTemplateVersionLogic(context.Background(), svcCtx).RestorePermissionTemplateVersion(&types.RestorePermissionTemplateVersionReq{
+		Id: "tpl-1", Version: 2, Name: "用户管理 v2", Code: "user_admin_v2",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "restored-id", resp.Id)
+	mockModel.AssertCalled(t, "Insert", mock.Anything, mock.MatchedBy(func(data *permissiontemplatemodel.PermissionTemplate) bool {
+		return data.Status == permissiontemplatemodel.StatusDraft && data.Version == 1 && data.Code == "user_admin_v2" &&
+			string(data.PolicyMatrix) == string(versionModel.Versions[0].PolicyMatrix)
+	}))
+	require.Len(t, auditModel.Logs, 1)
+	assert.Equal(t, permission_template_audit_logs.OperationRestore, auditModel.Logs[0].OperationType)
+	assert.Equal(t, "restored-id", auditModel.Logs[0].TemplateId)
+	assert.Contains(t, *auditModel.Logs[0].Remark, "版本 2")
+	assert.Len(t, versionModel.Versions, 2, "快照保持不变")
+}
+
+func TestRestorePermissionTemplateVersion_CodeExists(t *testing.T) {
+	svcCtx, mockModel, _ := newVersionTestContext()
+	mockModel.On("FindOneByCodeIncludingDeleted", mock.Anything, "user_admin").Return(&permissiontemplatemodel.PermissionTemplate{Id: "tpl-1"}, nil)
+
+	resp, err := NewRestorePermissionTemplateVersionLogic(context.Background(), svcCtx).RestorePermissionTemplateVersion(&types.RestorePermissionTemplateVersionReq{
+		Id: "tpl-1", Version: 2, Name: "用户管理", Code: "user_admin",
+	})
+
+	assert.ErrorIs(t, err, permissiontemplatemodel.ErrPermissionTemplateCodeExists)
+	assert.Nil(t, resp)
+	mockModel.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+}
+
+func TestPublishPermissionTemplate_RollsBackWhenSnapshotFails(t *testing.T) {
+	svcCtx := setupImpactTest(t, 0)
+	ctx := context.Background()
+	// 预先占用 v2 快照，使发布写入快照失败
+	_, err := svcCtx.PermissionTemplateVersionModel.Insert(ctx, &permissiontemplateversions.PermissionTemplateVersion{
+		TemplateId: "tpl-editor", Version: 2, Name: "编辑", Code: "editor",
+		PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read"],"scope":"global"}}`),
+		PublishedBy:  "system", PublishedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	_, err = NewPublishPermissionTemplateLogic(ctx, svcCtx).PublishPermissionTemplate(&types.PublishPermissionTemplateReq{Id: "tpl-editor"})
+	require.Error(t, err)
+
+	// 模板版本与工作草稿均保持不变
+	template, err := svcCtx.PermissionTemplateModel.FindOne(ctx, "tpl-editor")
+	require.NoError(t, err)
+	assert.Equal(t, 1, template.Version)
+	assert.JSONEq(t, `{"user":{"actions":["read","delete"],"scope":"global"}}`, string(template.PolicyMatrix))
+	draft, err := svcCtx.PermissionTemplateDraftModel.FindOne(ctx, "tpl-editor")
+	require.NoError(t, err)
+	assert.NotNil(t, draft)
+}
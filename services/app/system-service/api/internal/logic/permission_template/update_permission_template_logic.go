@@ -6,10 +6,12 @@ package permission_template
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -36,9 +38,9 @@ func (l *UpdatePermissionTemplateLogic) UpdatePermissionTemplate(req *types.Upda
 		return nil, err
 	}
 
-	// 2. 校验模板状态为草稿（只有草稿状态可以编辑）
-	if template.Status != permissiontemplatemodel.StatusDraft {
-		l.Errorf("只有草稿状态的模板可以编辑，当前状态: %s", template.Status)
+	// 2. 校验模板状态：草稿直接编辑，已发布模板的编辑保存为工作草稿，已停用模板不可编辑
+	if template.Status != permissiontemplatemodel.StatusDraft && template.Status != permissiontemplatemodel.StatusPublished {
+		l.Errorf("只有草稿或已发布状态的模板可以编辑，当前状态: %s", template.Status)
 		return nil, permissiontemplatemodel.ErrPermissionTemplateNotDraft
 	}
 
@@ -55,6 +57,18 @@ func (l *UpdatePermissionTemplateLogic) UpdatePermissionTemplate(req *types.Upda
 		}
 	}
 
+	// 3.1 校验策略矩阵符合权限目录
+	if err := validatePolicyMatrixAgainstCatalog(l.ctx, l.svcCtx, req.PolicyMatrix); err != nil {
+		l.Errorf("策略矩阵校验失败: %v", err)
+		return nil, err
+	}
+
+	// 3.2 校验父模板存在且继承关系无循环
+	if err := validateTemplateParent(l.ctx, l.svcCtx, template.Id, req.ParentId); err != nil {
+		l.Errorf("父模板校验失败: %v", err)
+		return nil, err
+	}
+
 	// 4. 序列化 JSON 字段
 	policyMatrixJSON, err := json.Marshal(req.PolicyMatrix)
 	if err != nil {
@@ -62,6 +76,12 @@ func (l *UpdatePermissionTemplateLogic) UpdatePermissionTemplate(req *types.Upda
 		return nil, err
 	}
 
+	policyRemovalsJSON, err := marshalPolicyRemovals(req.PolicyRemovals)
+	if err != nil {
+		l.Errorf("序列化策略移除项失败: %v", err)
+		return nil, err
+	}
+
 	var advancedPermsJSON []byte
 	if req.AdvancedPerms != nil {
 		advancedPermsJSON, err = json.Marshal(req.AdvancedPerms)
@@ -82,25 +102,64 @@ func (l *UpdatePermissionTemplateLogic) UpdatePermissionTemplate(req *types.Upda
 		scopeSuggestion = &req.ScopeSuggestion
 	}
 
-	// 6. 更新模板字段
+	// 6. 更新模板字段（保留更新前快照用于审计）
+	before := *template
 	template.Name = req.Name
 	template.Code = req.Code
 	template.Description = description
 	template.ScopeSuggestion = scopeSuggestion
 	template.PolicyMatrix = policyMatrixJSON
 	template.AdvancedPerms = advancedPermsJSON
+	template.ParentId = optionalString(req.ParentId)
+	template.PolicyRemovals = policyRemovalsJSON
 	// UpdatedBy 和 UpdatedAt 由 GORM 自动处理
 
-	// 6. 保存更新
+	// 7. 已发布模板：保存为工作草稿，发布前仍按已发布版本授权
+	if before.Status == permissiontemplatemodel.StatusPublished {
+		return l.saveWorkingDraft(&before, template)
+	}
+
+	// 8. 保存更新
 	err = l.svcCtx.PermissionTemplateModel.Update(l.ctx, template)
 	if err != nil {
 		l.Errorf("更新权限模板失败: %v", err)
 		return nil, err
 	}
 
+	// 9. 记录审计日志
+	recordTemplateAudit(l.ctx, l.svcCtx, &templateAuditEntry{
+		TemplateId:    template.Id,
+		OperationType: permission_template_audit_logs.OperationUpdate,
+		Before:        &before,
+		After:         template,
+	})
+
 	logx.Infof("更新权限模板成功: id=%s, code=%s, name=%s", template.Id, template.Code, template.Name)
 
 	return &types.UpdatePermissionTemplateResp{
 		Success: true,
 	}, nil
 }
+
+// saveWorkingDraft 保存已发布模板的工作草稿并记录审计日志
+func (l *UpdatePermissionTemplateLogic) saveWorkingDraft(published, edited *permissiontemplatemodel.PermissionTemplate) (*types.UpdatePermissionTemplateResp, error) {
+	if err := saveWorkingDraft(l.ctx, l.svcCtx, edited); err != nil {
+		l.Errorf("保存权限模板工作草稿失败: %v", err)
+		return nil, err
+	}
+
+	recordTemplateAudit(l.ctx, l.svcCtx, &templateAuditEntry{
+		TemplateId:    published.Id,
+		OperationType: permission_template_audit_logs.OperationUpdate,
+		Before:        published,
+		After:         edited,
+		Remark:        fmt.Sprintf("保存工作草稿，已发布版本 %d 继续生效", published.Version),
+	})
+
+	logx.Infof("保存权限模板工作草稿成功: id=%s, code=%s, baseVersion=%d", published.Id, edited.Code, published.Version)
+
+	return &types.UpdatePermissionTemplateResp{
+		Success:           true,
+		HasPendingChanges: true,
+	}, nil
+}
@@ -53,8 +53,12 @@ func TestUpdatePermissionTemplate_NormalUpdate(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -72,15 +76,15 @@ func TestUpdatePermissionTemplate_NormalUpdate(t *testing.T) {
 	mockModel.AssertExpectations(t)
 }
 
-func TestUpdatePermissionTemplate_NonDraftTemplate(t *testing.T) {
-	// 准备测试数据 - 已发布的模板
-	templateId := "published-template-id"
+func TestUpdatePermissionTemplate_DisabledTemplate(t *testing.T) {
+	// 准备测试数据 - 已停用的模板（已发布模板的编辑保存为工作草稿，见 template_draft_logic_test.go）
+	templateId := "disabled-template-id"
 	existingTemplate := &permissiontemplatemodel.PermissionTemplate{
 		Id:      templateId,
-		Name:    "已发布模板",
+		Name:    "已停用模板",
 		Code:    "published_code",
-		Status:  permissiontemplatemodel.StatusPublished, // 已发布状态
-		Version: 1,
+		Status:  permissiontemplatemodel.StatusDisabled, // 已停用状态
+		Version: 2,
 	}
 
 	req := &types.UpdatePermissionTemplateReq{
@@ -99,8 +103,12 @@ func TestUpdatePermissionTemplate_NonDraftTemplate(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -154,8 +162,12 @@ func TestUpdatePermissionTemplate_CodeConflict(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -191,8 +203,12 @@ func TestUpdatePermissionTemplate_TemplateNotFound(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -238,8 +254,12 @@ func TestUpdatePermissionTemplate_WithSameCode(t *testing.T) {
 
 	// 创建 ServiceContext
 	svcCtx := &svc.ServiceContext{
-		Config:                  config.Config{},
-		PermissionTemplateModel: mockModel,
+		Config:                          config.Config{},
+		PermissionTemplateModel:         mockModel,
+		PermissionTemplateAuditLogModel: &MockPermissionTemplateAuditLogModel{},
+		PermissionCatalogModel:          &MockPermissionCatalogModel{},
+		PermissionTemplateVersionModel:  &MockPermissionTemplateVersionModel{},
+		PermissionTemplateDraftModel:    &MockPermissionTemplateDraftModel{},
 	}
 
 	// 创建 Logic
@@ -0,0 +1,103 @@
+package permission_template
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+)
+
+// 策略差异变更类型
+const (
+	ChangeTypeAdded    = "added"
+	ChangeTypeRemoved  = "removed"
+	ChangeTypeModified = "modified"
+)
+
+// diffPolicyMatrix 按模块比较两份策略矩阵，返回按模块编码排序的差异（未变化的模块不返回）
+func diffPolicyMatrix(oldMatrix, newMatrix map[string]types.PolicyMatrixEntry) []types.PolicyModuleDiff {
+	diffs := make([]types.PolicyModuleDiff, 0)
+	for _, module := range unionKeys(oldMatrix, newMatrix) {
+		oldEntry, inOld := oldMatrix[module]
+		newEntry, inNew := newMatrix[module]
+		diff := types.PolicyModuleDiff{
+			Module:         module,
+			AddedActions:   subtractActions(newEntry.Actions, oldEntry.Actions),
+			RemovedActions: subtractActions(oldEntry.Actions, newEntry.Actions),
+			OldScope:       oldEntry.Scope,
+			NewScope:       newEntry.Scope,
+		}
+		switch {
+		case !inOld:
+			diff.ChangeType = ChangeTypeAdded
+		case !inNew:
+			diff.ChangeType = ChangeTypeRemoved
+		case len(diff.AddedActions) > 0 || len(diff.RemovedActions) > 0 || oldEntry.Scope != newEntry.Scope:
+			diff.ChangeType = ChangeTypeModified
+		default:
+			continue
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+// diffAdvancedPerms 按权限点比较两份高级权限点配置，返回按权限点键排序的差异（未变化的权限点不返回）
+func diffAdvancedPerms(oldPerms, newPerms map[string]types.AdvancedPermEntry) []types.AdvancedPermDiff {
+	diffs := make([]types.AdvancedPermDiff, 0)
+	for _, key := range unionKeys(oldPerms, newPerms) {
+		oldEntry, inOld := oldPerms[key]
+		newEntry, inNew := newPerms[key]
+		diff := types.AdvancedPermDiff{
+			Key:        key,
+			OldEnabled: oldEntry.Enabled,
+			NewEnabled: newEntry.Enabled,
+		}
+		switch {
+		case !inOld:
+			diff.ChangeType = ChangeTypeAdded
+		case !inNew:
+			diff.ChangeType = ChangeTypeRemoved
+		default:
+			diff.ConfigChanged = !reflect.DeepEqual(oldEntry.Config, newEntry.Config)
+			if oldEntry.Enabled == newEntry.Enabled && !diff.ConfigChanged {
+				continue
+			}
+			diff.ChangeType = ChangeTypeModified
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+// subtractActions 返回 a 中存在而 b 中不存在的动作（保持 a 的顺序）
+func subtractActions(a, b []string) []string {
+	exclude := make(map[string]struct{}, len(b))
+	for _, action := range b {
+		exclude[action] = struct{}{}
+	}
+	result := make([]string, 0)
+	for _, action := range a {
+		if _, ok := exclude[action]; !ok {
+			result = append(result, action)
+		}
+	}
+	return result
+}
+
+// unionKeys 返回两个 map 键的并集（升序）
+func unionKeys[V any](a, b map[string]V) []string {
+	set := make(map[string]struct{}, len(a)+len(b))
+	for key := range a {
+		set[key] = struct{}{}
+	}
+	for key := range b {
+		set[key] = struct{}{}
+	}
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,157 @@
+package role
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/testutil"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	rolemodel "github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupRoleTestSvc 创建测试数据库和服务上下文
+func setupRoleTestSvc(t *testing.T) *svc.ServiceContext {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(&organization.SysOrganization{}, &rolebindings.RoleBinding{})
+	require.NoError(t, err)
+
+	testutil.CreatePermissionTables(t, db)
+
+	return &svc.ServiceContext{
+		DB:                      db,
+		OrgModel:                organization.NewModel(db),
+		PermissionTemplateModel: permissiontemplatemodel.NewModel(db),
+		RoleModel:               rolemodel.NewModel(db),
+		RoleBindingModel:        rolebindings.NewModel(db),
+	}
+}
+
+// createTestTemplate 创建测试权限模板
+func createTestTemplate(t *testing.T, svcCtx *svc.ServiceContext, code, status string, version int) *permissiontemplatemodel.PermissionTemplate {
+	now := time.Now()
+	template := &permissiontemplatemodel.PermissionTemplate{
+		Id:           "tpl-" + code,
+		Name:         code,
+		Code:         code,
+		Status:       status,
+		PolicyMatrix: datatypes.JSON(`{"organization": {"actions": ["read"], "scope": "organization"}}`),
+		Version:      version,
+		CreatedBy:    "system",
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	require.NoError(t, svcCtx.DB.Create(template).Error)
+	return template
+}
+
+// TestCreateRole_PublishedTemplate_PinsCurrentVersion 测试创建角色时默认固定模板当前版本，并计入模板使用统计
+func TestCreateRole_PublishedTemplate_PinsCurrentVersion(t *testing.T) {
+	svcCtx := setupRoleTestSvc(t)
+	template := createTestTemplate(t, svcCtx, "org_viewer", permissiontemplatemodel.StatusPublished, 3)
+	ctx := context.WithValue(context.Background(), contextkeys.UserIDKey, "operator-1")
+
+	resp, err := NewCreateRoleLogic(ctx, svcCtx).CreateRole(&types.CreateRoleReq{
+		Code:       "dept_viewer",
+		Name:       "部门查看者",
+		Scope:      rolemodel.ScopeGlobal,
+		TemplateId: template.Id,
+	})
+	require.NoError(t, err)
+
+	// 验证角色固定模板当前版本
+	role, err := svcCtx.RoleModel.FindOne(ctx, resp.Id)
+	require.NoError(t, err)
+	assert.Equal(t, 3, role.TemplateVersion)
+	assert.Equal(t, "operator-1", role.CreatedBy)
+
+	// 验证模板使用统计
+	stats, err := svcCtx.PermissionTemplateModel.GetUsageStats(ctx, template.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.UsedByRoleCount)
+	require.NotNil(t, stats.LastAppliedAt)
+}
+
+// TestCreateRole_DraftTemplate_ReturnsError 测试未发布模板不能用于创建角色
+func TestCreateRole_DraftTemplate_ReturnsError(t *testing.T) {
+	svcCtx := setupRoleTestSvc(t)
+	template := createTestTemplate(t, svcCtx, "org_draft", permissiontemplatemodel.StatusDraft, 1)
+
+	resp, err := NewCreateRoleLogic(context.Background(), svcCtx).CreateRole(&types.CreateRoleReq{
+		Code:       "draft_role",
+		Name:       "草稿角色",
+		Scope:      rolemodel.ScopeGlobal,
+		TemplateId: template.Id,
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, permissiontemplatemodel.ErrPermissionTemplateDisabledNotUsable, err)
+}
+
+// TestCreateRole_VersionAheadOfTemplate_ReturnsError 测试固定版本号不能超过模板当前版本
+func TestCreateRole_VersionAheadOfTemplate_ReturnsError(t *testing.T) {
+	svcCtx := setupRoleTestSvc(t)
+	template := createTestTemplate(t, svcCtx, "org_viewer", permissiontemplatemodel.StatusPublished, 2)
+
+	resp, err := NewCreateRoleLogic(context.Background(), svcCtx).CreateRole(&types.CreateRoleReq{
+		Code:            "future_role",
+		Name:            "未来版本角色",
+		Scope:           rolemodel.ScopeGlobal,
+		TemplateId:      template.Id,
+		TemplateVersion: 3,
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, rolemodel.ErrRoleInvalidTemplateVersion, err)
+}
+
+// TestCreateRole_OrganizationScopeWithoutOrg_ReturnsError 测试组织范围角色必须指定组织
+func TestCreateRole_OrganizationScopeWithoutOrg_ReturnsError(t *testing.T) {
+	svcCtx := setupRoleTestSvc(t)
+	template := createTestTemplate(t, svcCtx, "org_viewer", permissiontemplatemodel.StatusPublished, 1)
+
+	resp, err := NewCreateRoleLogic(context.Background(), svcCtx).CreateRole(&types.CreateRoleReq{
+		Code:       "org_role",
+		Name:       "组织角色",
+		Scope:      rolemodel.ScopeOrganization,
+		TemplateId: template.Id,
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, rolemodel.ErrRoleOrgRequired, err)
+}
+
+// TestCreateRole_DuplicateCode_ReturnsError 测试角色编码重复（含已删除角色）
+func TestCreateRole_DuplicateCode_ReturnsError(t *testing.T) {
+	svcCtx := setupRoleTestSvc(t)
+	template := createTestTemplate(t, svcCtx, "org_viewer", permissiontemplatemodel.StatusPublished, 1)
+	ctx := context.Background()
+	req := &types.CreateRoleReq{
+		Code:       "dept_viewer",
+		Name:       "部门查看者",
+		Scope:      rolemodel.ScopeGlobal,
+		TemplateId: template.Id,
+	}
+
+	created, err := NewCreateRoleLogic(ctx, svcCtx).CreateRole(req)
+	require.NoError(t, err)
+	require.NoError(t, svcCtx.RoleModel.Delete(ctx, created.Id))
+
+	resp, err := NewCreateRoleLogic(ctx, svcCtx).CreateRole(req)
+	assert.Nil(t, resp)
+	assert.Equal(t, rolemodel.ErrRoleCodeExists, err)
+}
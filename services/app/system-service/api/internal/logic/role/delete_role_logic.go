@@ -0,0 +1,65 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package role
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	rolemodel "github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteRoleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDeleteRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteRoleLogic {
+	return &DeleteRoleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteRoleLogic) DeleteRole(req *types.DeleteRoleReq) (resp *types.DeleteRoleResp, err error) {
+	// 1. 查询角色
+	role, err := l.svcCtx.RoleModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询角色失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 校验角色未被绑定
+	count, err := l.svcCtx.RoleBindingModel.CountByRoleId(l.ctx, role.Id)
+	if err != nil {
+		l.Errorf("统计角色绑定数量失败: %v", err)
+		return nil, err
+	}
+	if count > 0 {
+		l.Errorf("角色正在被 %d 个绑定引用，无法删除: code=%s", count, role.Code)
+		return nil, rolemodel.ErrRoleInUse
+	}
+
+	// 3. 执行软删除
+	if err := l.svcCtx.RoleModel.Delete(l.ctx, role.Id); err != nil {
+		l.Errorf("删除角色失败: %v", err)
+		return nil, err
+	}
+
+	// 4. 使全部用户授权快照失效
+	if err := l.svcCtx.PermissionCache.InvalidateAll(l.ctx); err != nil {
+		l.Errorf("清除授权快照缓存失败: %v", err)
+	}
+
+	l.Infof("删除角色成功: id=%s, code=%s", role.Id, role.Code)
+
+	return &types.DeleteRoleResp{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package role
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	rolemodel "github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeleteRole_BoundRole_ReturnsError 测试已被绑定的角色不能删除，解绑后可删除
+func TestDeleteRole_BoundRole_ReturnsError(t *testing.T) {
+	svcCtx := setupRoleTestSvc(t)
+	template := createTestTemplate(t, svcCtx, "org_viewer", permissiontemplatemodel.StatusPublished, 1)
+	ctx := context.Background()
+
+	created, err := NewCreateRoleLogic(ctx, svcCtx).CreateRole(&types.CreateRoleReq{
+		Code:       "dept_viewer",
+		Name:       "部门查看者",
+		Scope:      rolemodel.ScopeGlobal,
+		TemplateId: template.Id,
+	})
+	require.NoError(t, err)
+
+	binding, err := svcCtx.RoleBindingModel.Insert(ctx, &rolebindings.RoleBinding{
+		UserId: "user-1",
+		OrgId:  "org-1",
+		RoleId: &created.Id,
+	})
+	require.NoError(t, err)
+
+	// 存在绑定时拒绝删除
+	detail, err := NewGetRoleLogic(ctx, svcCtx).GetRole(&types.GetRoleReq{Id: created.Id})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), detail.Data.BindingCount)
+	assert.Equal(t, template.Code, detail.Data.TemplateCode)
+
+	resp, err := NewDeleteRoleLogic(ctx, svcCtx).DeleteRole(&types.DeleteRoleReq{Id: created.Id})
+	assert.Nil(t, resp)
+	assert.Equal(t, rolemodel.ErrRoleInUse, err)
+
+	// 解绑后删除成功，模板使用统计归零
+	require.NoError(t, svcCtx.RoleBindingModel.Delete(ctx, binding.Id))
+	resp, err = NewDeleteRoleLogic(ctx, svcCtx).DeleteRole(&types.DeleteRoleReq{Id: created.Id})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	stats, err := svcCtx.PermissionTemplateModel.GetUsageStats(ctx, template.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stats.UsedByRoleCount)
+	assert.Nil(t, stats.LastAppliedAt)
+}
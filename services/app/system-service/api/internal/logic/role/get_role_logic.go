@@ -0,0 +1,79 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package role
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRoleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRoleLogic {
+	return &GetRoleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetRoleLogic) GetRole(req *types.GetRoleReq) (resp *types.GetRoleResp, err error) {
+	// 1. 查询角色
+	role, err := l.svcCtx.RoleModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询角色失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 转换基础字段
+	detail := types.RoleDetail{
+		Id:                role.Id,
+		Code:              role.Code,
+		Name:              role.Name,
+		Scope:             role.Scope,
+		TemplateId:        role.TemplateId,
+		TemplateVersion:   role.TemplateVersion,
+		TemplateAppliedAt: role.TemplateAppliedAt.Format(roleTimeLayout),
+		CreatedBy:         role.CreatedBy,
+		CreatedAt:         role.CreatedAt.Format(roleTimeLayout),
+		UpdatedAt:         role.UpdatedAt.Format(roleTimeLayout),
+	}
+	if role.Description != nil {
+		detail.Description = *role.Description
+	}
+	if role.OrgId != nil {
+		detail.OrgId = *role.OrgId
+	}
+	if role.UpdatedBy != nil {
+		detail.UpdatedBy = *role.UpdatedBy
+	}
+
+	// 3. 补充来源模板信息（模板已删除时留空）
+	if template, err := l.svcCtx.PermissionTemplateModel.FindOne(l.ctx, role.TemplateId); err == nil {
+		detail.TemplateCode = template.Code
+		detail.TemplateName = template.Name
+	} else {
+		l.Errorf("查询角色来源模板失败: role=%s, template=%s, %v", role.Id, role.TemplateId, err)
+	}
+
+	// 4. 统计引用该角色的绑定数量
+	count, err := l.svcCtx.RoleBindingModel.CountByRoleId(l.ctx, role.Id)
+	if err != nil {
+		l.Errorf("统计角色绑定数量失败: %v", err)
+		return nil, err
+	}
+	detail.BindingCount = count
+
+	return &types.GetRoleResp{
+		Data: detail,
+	}, nil
+}
@@ -0,0 +1,55 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package role
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	rolemodel "github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListRolesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListRolesLogic {
+	return &ListRolesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListRolesLogic) ListRoles(req *types.ListRolesReq) (resp *types.ListRolesResp, err error) {
+	// 1. 查询角色列表
+	list, total, err := l.svcCtx.RoleModel.List(l.ctx, &rolemodel.ListFilter{
+		Keyword:    req.Keyword,
+		Scope:      req.Scope,
+		OrgId:      req.OrgId,
+		TemplateId: req.TemplateId,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("查询角色列表失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 转换为响应类型
+	items := make([]types.RoleItem, 0, len(list))
+	for _, role := range list {
+		items = append(items, toRoleItem(role))
+	}
+
+	return &types.ListRolesResp{
+		Total: total,
+		Data:  items,
+	}, nil
+}
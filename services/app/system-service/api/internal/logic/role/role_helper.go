@@ -0,0 +1,73 @@
+package role
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplatemodel "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	rolemodel "github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+)
+
+// roleTimeLayout 角色时间字段输出格式（与权限模板保持一致）
+const roleTimeLayout = "2006-01-02 15:04:05.000"
+
+// currentOperatorID 获取当前操作人ID，缺失时回退为系统
+func currentOperatorID(ctx context.Context) string {
+	if userID, ok := ctx.Value(contextkeys.UserIDKey).(string); ok && userID != "" {
+		return userID
+	}
+	return errorx.SystemOperatorID
+}
+
+// resolveRoleTemplate 校验来源模板可用于角色，并确定固定的模板版本号
+// 仅已发布的模板可被角色引用；版本号为 0 时取模板当前版本，且不能超过当前版本
+func resolveRoleTemplate(ctx context.Context, svcCtx *svc.ServiceContext, templateId string, version int) (*permissiontemplatemodel.PermissionTemplate, int, error) {
+	template, err := svcCtx.PermissionTemplateModel.FindOne(ctx, templateId)
+	if err != nil {
+		return nil, 0, err
+	}
+	if template.Status != permissiontemplatemodel.StatusPublished {
+		return nil, 0, permissiontemplatemodel.ErrPermissionTemplateDisabledNotUsable
+	}
+	if version == 0 {
+		return template, template.Version, nil
+	}
+	if version > template.Version {
+		return nil, 0, rolemodel.ErrRoleInvalidTemplateVersion
+	}
+	return template, version, nil
+}
+
+// resolveRoleOrg 校验角色的组织范围，仅 scope=organization 时保留组织ID
+func resolveRoleOrg(ctx context.Context, svcCtx *svc.ServiceContext, scope, orgId string) (*string, error) {
+	if scope != rolemodel.ScopeOrganization {
+		return nil, nil
+	}
+	if orgId == "" {
+		return nil, rolemodel.ErrRoleOrgRequired
+	}
+	if _, err := svcCtx.OrgModel.FindOne(ctx, orgId); err != nil {
+		return nil, err
+	}
+	return &orgId, nil
+}
+
+// toRoleItem 将角色实体转换为列表项
+func toRoleItem(role *rolemodel.Role) types.RoleItem {
+	item := types.RoleItem{
+		Id:              role.Id,
+		Code:            role.Code,
+		Name:            role.Name,
+		Scope:           role.Scope,
+		TemplateId:      role.TemplateId,
+		TemplateVersion: role.TemplateVersion,
+		UpdatedAt:       role.UpdatedAt.Format(roleTimeLayout),
+	}
+	if role.OrgId != nil {
+		item.OrgId = *role.OrgId
+	}
+	return item
+}
@@ -0,0 +1,87 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package role
+
+import (
+	"context"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateRoleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdateRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateRoleLogic {
+	return &UpdateRoleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateRoleLogic) UpdateRole(req *types.UpdateRoleReq) (resp *types.UpdateRoleResp, err error) {
+	// 1. 查询角色
+	role, err := l.svcCtx.RoleModel.FindOne(l.ctx, req.Id)
+	if err != nil {
+		l.Errorf("查询角色失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 校验来源模板并确定固定版本号
+	template, version, err := resolveRoleTemplate(l.ctx, l.svcCtx, req.TemplateId, req.TemplateVersion)
+	if err != nil {
+		l.Errorf("校验来源模板失败: template_id=%s, %v", req.TemplateId, err)
+		return nil, err
+	}
+
+	// 3. 校验组织范围
+	orgId, err := resolveRoleOrg(l.ctx, l.svcCtx, req.Scope, req.OrgId)
+	if err != nil {
+		l.Errorf("校验角色组织范围失败: %v", err)
+		return nil, err
+	}
+
+	// 4. 来源模板或版本变化时记录重新应用时间
+	now := time.Now()
+	if role.TemplateId != template.Id || role.TemplateVersion != version {
+		role.TemplateAppliedAt = now
+	}
+
+	// 5. 更新角色（编码不可修改）
+	var description *string
+	if req.Description != "" {
+		description = &req.Description
+	}
+	operatorId := currentOperatorID(l.ctx)
+	role.Name = req.Name
+	role.Description = description
+	role.Scope = req.Scope
+	role.OrgId = orgId
+	role.TemplateId = template.Id
+	role.TemplateVersion = version
+	role.UpdatedBy = &operatorId
+	role.UpdatedAt = now
+	if err := l.svcCtx.RoleModel.Update(l.ctx, role); err != nil {
+		l.Errorf("更新角色失败: %v", err)
+		return nil, err
+	}
+
+	// 6. 使全部用户授权快照失效
+	if err := l.svcCtx.PermissionCache.InvalidateAll(l.ctx); err != nil {
+		l.Errorf("清除授权快照缓存失败: %v", err)
+	}
+
+	l.Infof("更新角色成功: id=%s, code=%s, template=%s@v%d", role.Id, role.Code, template.Code, version)
+
+	return &types.UpdateRoleResp{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,43 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/logic/user_management"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetMyPermissionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取当前用户的有效权限
+func NewGetMyPermissionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetMyPermissionsLogic {
+	return &GetMyPermissionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetMyPermissionsLogic) GetMyPermissions() (resp *types.GetEffectivePermissionsResp, err error) {
+	// 1. 从 JWT Token 中提取用户 ID
+	userID, ok := l.ctx.Value(contextkeys.UserIDKey).(string)
+	if !ok || userID == "" {
+		return nil, baseErrorx.New(errorx.ErrTokenInvalid, "Token 无效或已过期")
+	}
+
+	// 2. 复用用户管理的有效权限解析
+	return user_management.NewGetEffectivePermissionsLogic(l.ctx, l.svcCtx).GetEffectivePermissions(userID)
+}
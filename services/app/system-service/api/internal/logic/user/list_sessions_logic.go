@@ -0,0 +1,65 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListSessionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询当前用户的登录会话
+func NewListSessionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListSessionsLogic {
+	return &ListSessionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListSessionsLogic) ListSessions() (resp *types.ListSessionsResp, err error) {
+	// 1. 从 JWT Token 中提取用户 ID
+	userID, ok := l.ctx.Value(contextkeys.UserIDKey).(string)
+	if !ok || userID == "" {
+		return nil, baseErrorx.New(errorx.ErrTokenInvalid, "Token 无效或已过期")
+	}
+	currentSessionID, _ := l.ctx.Value(contextkeys.SessionIDKey).(string)
+
+	// 2. 查询会话列表
+	sessions, err := l.svcCtx.TokenStore.ListSessions(l.ctx, userID)
+	if err != nil {
+		l.Errorf("查询会话列表失败: userId=%s, error=%v", userID, err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+
+	// 3. 转换响应（不返回刷新令牌哈希）
+	list := make([]types.SessionInfo, 0, len(sessions))
+	for _, s := range sessions {
+		list = append(list, types.SessionInfo{
+			Id:           s.Id,
+			DeviceName:   s.DeviceName,
+			UserAgent:    s.UserAgent,
+			ClientIp:     s.ClientIP,
+			CreatedAt:    s.CreatedAt.Format(time.RFC3339),
+			LastActiveAt: s.LastActiveAt.Format(time.RFC3339),
+			ExpiresAt:    s.ExpiresAt.Format(time.RFC3339),
+			Current:      s.Id == currentSessionID,
+		})
+	}
+
+	return &types.ListSessionsResp{Sessions: list}, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
 	"github.com/zeromicro/go-zero/core/logx"
 	"golang.org/x/crypto/bcrypt"
@@ -120,11 +121,18 @@ func (l *LoginLogic) generateToken(userID, email string, rememberMe bool) (strin
 		expire = 86400 // 24小时
 	}
 
+	// 生成 Token ID（jti），用于退出登录时吊销单个 Token
+	jti, err := uuid.NewV7()
+	if err != nil {
+		return "", 0, err
+	}
+
 	// 创建 JWT Claims
 	now := time.Now()
 	claims := jwt.MapClaims{
 		"user_id": userID,
 		"email":   email,
+		"jti":     jti.String(),
 		"exp":     now.Add(time.Duration(expire) * time.Second).Unix(),
 		"iat":     now.Unix(),
 	}
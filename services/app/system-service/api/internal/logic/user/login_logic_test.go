@@ -90,7 +90,7 @@ func (m *MockUserModel) BatchUpdateStatus(ctx context.Context, userIds []string,
 	return successIds, errors, args.Error(2)
 }
 
-func (m *MockUserModel) GetStatistics(ctx context.Context) (*users.Statistics, error) {
+func (m *MockUserModel) GetStatistics(ctx context.Context, scope *users.DataScopeFilter) (*users.Statistics, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -5,6 +5,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
@@ -43,12 +44,30 @@ func (l *LogoutLogic) Logout() (resp *types.LogoutResp, err error) {
 		return nil, baseErrorx.New(errorx.ErrTokenInvalid, "Token 无效")
 	}
 
-	// 2. 将 Token 加入黑名单（可选，使用 Redis，MVP 阶段可简化）
-	// TODO: MVP 阶段简化处理，后续可添加 Redis 黑名单功能
-	// 目前仅返回成功响应，客户端删除本地 token 即可
+	// 2. 吊销当前 Token
+	// 优先按 jti 吊销单个 Token；旧版本签发的 Token 没有 jti，则吊销该用户此前签发的全部 Token
+	jti, _ := l.ctx.Value(contextkeys.TokenIDKey).(string)
+	expiresAt, _ := l.ctx.Value(contextkeys.TokenExpiresAtKey).(time.Time)
+	if jti != "" && !expiresAt.IsZero() {
+		err = l.svcCtx.TokenStore.RevokeToken(l.ctx, jti, expiresAt)
+	} else {
+		err = l.svcCtx.TokenStore.RevokeUser(l.ctx, userID, time.Now())
+	}
+	if err != nil {
+		l.Errorf("吊销 Token 失败: userId=%s, error=%v", userID, err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+
+	// 3. 吊销当前登录会话（刷新令牌随之失效）
+	if sessionID, _ := l.ctx.Value(contextkeys.SessionIDKey).(string); sessionID != "" {
+		if err := l.svcCtx.TokenStore.RevokeSession(l.ctx, userID, sessionID); err != nil {
+			l.Errorf("吊销会话失败: sessionId=%s, error=%v", sessionID, err)
+			return nil, baseErrorx.New(50000, "系统错误")
+		}
+	}
 	l.Infof("用户 %s 退出登录", userID)
 
-	// 3. 返回成功响应
+	// 4. 返回成功响应
 	return &types.LogoutResp{
 		Message: "退出登录成功",
 	}, nil
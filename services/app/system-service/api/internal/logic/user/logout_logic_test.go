@@ -3,18 +3,22 @@ package user
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/config"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/tokenstore"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 // setupLogoutTestLogic 创建测试用的 LogoutLogic
-func setupLogoutTestLogic(mockModel *MockUserModel) (*LogoutLogic, *svc.ServiceContext) {
+func setupLogoutTestLogic(t *testing.T, mockModel *MockUserModel) (*LogoutLogic, *svc.ServiceContext) {
 	cfg := config.Config{
 		Auth: struct {
 			AccessSecret string
@@ -26,8 +30,9 @@ func setupLogoutTestLogic(mockModel *MockUserModel) (*LogoutLogic, *svc.ServiceC
 	}
 
 	svcCtx := &svc.ServiceContext{
-		Config:    cfg,
-		UserModel: mockModel,
+		Config:     cfg,
+		UserModel:  mockModel,
+		TokenStore: tokenstore.NewStore(redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()}), 0),
 	}
 
 	logic := NewLogoutLogic(context.Background(), svcCtx)
@@ -37,7 +42,7 @@ func setupLogoutTestLogic(mockModel *MockUserModel) (*LogoutLogic, *svc.ServiceC
 // TestLogout_ValidToken_ReturnsSuccess 测试正常退出登录（AC-05）
 func TestLogout_ValidToken_ReturnsSuccess(t *testing.T) {
 	mockModel := new(MockUserModel)
-	logic, _ := setupLogoutTestLogic(mockModel)
+	logic, _ := setupLogoutTestLogic(t, mockModel)
 
 	// 准备测试数据
 	userID, _ := uuid.NewV7()
@@ -56,7 +61,7 @@ func TestLogout_ValidToken_ReturnsSuccess(t *testing.T) {
 // TestLogout_InvalidToken_ReturnsError 测试 Token 无效
 func TestLogout_InvalidToken_ReturnsError(t *testing.T) {
 	mockModel := new(MockUserModel)
-	logic, _ := setupLogoutTestLogic(mockModel)
+	logic, _ := setupLogoutTestLogic(t, mockModel)
 
 	// 创建不带 user_id 的 context（模拟 Token 无效）
 	logic.ctx = context.Background()
@@ -73,7 +78,7 @@ func TestLogout_InvalidToken_ReturnsError(t *testing.T) {
 // TestLogout_ExpiredToken_ReturnsError 测试 Token 过期
 func TestLogout_ExpiredToken_ReturnsError(t *testing.T) {
 	mockModel := new(MockUserModel)
-	logic, _ := setupLogoutTestLogic(mockModel)
+	logic, _ := setupLogoutTestLogic(t, mockModel)
 
 	// 创建带 nil user_id 的 context（模拟 Token 过期）
 	logic.ctx = context.WithValue(context.Background(), contextkeys.UserIDKey, nil)
@@ -90,7 +95,7 @@ func TestLogout_ExpiredToken_ReturnsError(t *testing.T) {
 // TestLogout_InvalidUserIDType_ReturnsError 测试无效的用户 ID 类型
 func TestLogout_InvalidUserIDType_ReturnsError(t *testing.T) {
 	mockModel := new(MockUserModel)
-	logic, _ := setupLogoutTestLogic(mockModel)
+	logic, _ := setupLogoutTestLogic(t, mockModel)
 
 	// 创建带无效类型 user_id 的 context
 	logic.ctx = context.WithValue(context.Background(), contextkeys.UserIDKey, 12345)
@@ -103,3 +108,30 @@ func TestLogout_InvalidUserIDType_ReturnsError(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.Contains(t, err.Error(), "Token 无效")
 }
+
+// TestLogout_WithTokenID_RevokesToken 测试退出登录后当前 Token 被吊销
+func TestLogout_WithTokenID_RevokesToken(t *testing.T) {
+	mockModel := new(MockUserModel)
+	logic, svcCtx := setupLogoutTestLogic(t, mockModel)
+
+	userID, _ := uuid.NewV7()
+	jti, _ := uuid.NewV7()
+	ctx := context.WithValue(context.Background(), contextkeys.UserIDKey, userID.String())
+	ctx = context.WithValue(ctx, contextkeys.TokenIDKey, jti.String())
+	ctx = context.WithValue(ctx, contextkeys.TokenExpiresAtKey, time.Now().Add(time.Hour))
+	logic.ctx = ctx
+
+	_, err := logic.Logout()
+	require.NoError(t, err)
+
+	// 当前 Token 已吊销
+	revoked, err := svcCtx.TokenStore.IsRevoked(context.Background(), userID.String(), jti.String(), "", time.Now())
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	// 同一用户的其他 Token 不受影响
+	otherJti, _ := uuid.NewV7()
+	revoked, err = svcCtx.TokenStore.IsRevoked(context.Background(), userID.String(), otherJti.String(), "", time.Now())
+	require.NoError(t, err)
+	assert.False(t, revoked)
+}
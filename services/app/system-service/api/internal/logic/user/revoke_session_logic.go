@@ -0,0 +1,60 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/tokenstore"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeSessionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 吊销登录会话
+func NewRevokeSessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeSessionLogic {
+	return &RevokeSessionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RevokeSessionLogic) RevokeSession(req *types.RevokeSessionReq) (resp *types.LogoutResp, err error) {
+	// 1. 从 JWT Token 中提取用户 ID
+	userID, ok := l.ctx.Value(contextkeys.UserIDKey).(string)
+	if !ok || userID == "" {
+		return nil, baseErrorx.New(errorx.ErrTokenInvalid, "Token 无效或已过期")
+	}
+
+	// 2. 校验会话归属（只能吊销自己的会话）
+	if _, err := l.svcCtx.TokenStore.FindSession(l.ctx, userID, req.Id); err != nil {
+		if errors.Is(err, tokenstore.ErrSessionNotFound) {
+			return nil, baseErrorx.New(errorx.ErrSessionNotFound, "会话不存在")
+		}
+		l.Errorf("查询会话失败: sessionId=%s, error=%v", req.Id, err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+
+	// 3. 吊销会话（刷新令牌家族及其签发的访问令牌全部失效）
+	if err := l.svcCtx.TokenStore.RevokeSession(l.ctx, userID, req.Id); err != nil {
+		l.Errorf("吊销会话失败: sessionId=%s, error=%v", req.Id, err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+
+	return &types.LogoutResp{
+		Message: "会话已吊销",
+	}, nil
+}
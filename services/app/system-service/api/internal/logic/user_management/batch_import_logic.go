@@ -5,17 +5,43 @@ package user_management
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/userdept"
+	auditlogs "github.com/DataSemanticHub/services/app/system-service/model/user/audit_logs"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
 
+	"github.com/google/uuid"
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
 	"github.com/zeromicro/go-zero/core/logx"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
+// MaxImportRows 单次导入的最大数据行数
+const MaxImportRows = 1000
+
 type BatchImportLogic struct {
 	logx.Logger
-	ctx    context.Context
-	svcCtx *svc.ServiceContext
+	ctx      context.Context
+	svcCtx   *svc.ServiceContext
+	progress func(progress int)
+}
+
+// importCandidate 通过校验、待写入的导入行
+type importCandidate struct {
+	Row          int
+	Req          types.CreateUserReq
+	RoleBindings []*rolebindings.RoleBinding // 校验阶段构建的角色绑定，写入时填充用户ID
 }
 
 // 批量导入用户
@@ -27,8 +53,325 @@ func NewBatchImportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Batch
 	}
 }
 
-func (l *BatchImportLogic) BatchImport(req *types.BatchImportReq) (resp *types.BatchImportResp, err error) {
-	// todo: add your logic here and delete this line
+// WithProgress 设置导入进度回调（0-100），供异步任务上报进度
+func (l *BatchImportLogic) WithProgress(fn func(progress int)) *BatchImportLogic {
+	l.progress = fn
+	return l
+}
+
+// reportProgress 按阶段上报进度：[base, base+span) 区间内按已处理行数折算
+func (l *BatchImportLogic) reportProgress(base, span, done, total int) {
+	if l.progress == nil || total == 0 {
+		return
+	}
+	l.progress(base + span*done/total)
+}
+
+// BatchImport 批量导入用户
+// 试运行模式仅校验并返回全部错误；正式导入时只要存在错误行则整体不写入，全部通过后在同一事务内写入
+func (l *BatchImportLogic) BatchImport(req *types.BatchImportReq, filename string, content []byte) (resp *types.BatchImportResp, err error) {
+	// 1. 解析导入文件
+	rows, err := parseImportFile(filename, content)
+	if err != nil {
+		return nil, baseErrorx.New(errorx.ErrUserManagementImportInvalidFile, err.Error())
+	}
+	if len(rows) > MaxImportRows {
+		return nil, baseErrorx.New(errorx.ErrUserManagementImportTooManyRows, fmt.Sprintf("单次最多导入 %d 行", MaxImportRows))
+	}
+
+	// 2. 逐行校验
+	candidates, importErrors, err := l.validateRows(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.BatchImportResp{
+		TotalRows:   len(rows),
+		FailedCount: len(rows) - len(candidates),
+		Errors:      importErrors,
+		UserIds:     []string{},
+	}
+
+	// 3. 试运行：仅返回校验结果
+	if req.DryRun {
+		resp.SuccessCount = len(candidates)
+		return resp, nil
+	}
+
+	// 4. 存在错误行时整体不写入
+	if len(importErrors) > 0 {
+		return resp, nil
+	}
+
+	// 5. 事务写入用户、主部门、角色绑定和审计日志
+	userIds, initialPasswords, err := l.importUsers(candidates)
+	if err != nil {
+		return nil, err
+	}
+	invalidatePermissionCache(l.ctx, l.svcCtx, userIds...)
+
+	resp.SuccessCount = len(userIds)
+	resp.UserIds = userIds
+	resp.InitialPasswords = initialPasswords
+	return resp, nil
+}
+
+// validateRows 逐行校验，返回通过校验的行和全部错误
+func (l *BatchImportLogic) validateRows(rows []importRow) ([]*importCandidate, []types.ImportError, error) {
+	creator := NewCreateUserLogic(l.ctx, l.svcCtx)
+
+	var (
+		candidates   []*importCandidate
+		importErrors []types.ImportError
+		emailRows    = make(map[string]int)
+		phoneRows    = make(map[string]int)
+		deptExists   = make(map[string]bool)
+	)
+	for i, row := range rows {
+		// 校验阶段占总进度的 50%
+		l.reportProgress(0, 50, i, len(rows))
+
+		createReq := types.CreateUserReq{
+			Name:            row.Fields[importColumnName],
+			Email:           strings.ToLower(row.Fields[importColumnEmail]),
+			Phone:           row.Fields[importColumnPhone],
+			DeptId:          row.Fields[importColumnDeptId],
+			AccountSource:   row.Fields[importColumnAccountSource],
+			InitialPassword: row.Fields[importColumnPassword],
+		}
+		if createReq.AccountSource == "" {
+			createReq.AccountSource = errorx.AccountSourceLocal
+		}
+
+		rowErrors := make([]types.ImportError, 0)
+		addError := func(field, reason string) {
+			rowErrors = append(rowErrors, types.ImportError{Row: row.Row, Field: field, Reason: reason})
+		}
+
+		// 1. 字段格式校验（与创建用户规则一致）
+		if fieldErr := checkCreateUserFields(&createReq); fieldErr != nil {
+			addError(fieldErr.Field, fieldErr.Err.Error())
+			importErrors = append(importErrors, rowErrors...)
+			continue
+		}
+		if createReq.InitialPassword != "" && createReq.AccountSource == errorx.AccountSourceLocal {
+			if err := creator.validatePassword(createReq.InitialPassword); err != nil {
+				addError(importColumnPassword, err.Error())
+			}
+		}
+
+		// 2. 邮箱唯一性（文件内及已有用户）
+		if firstRow, ok := emailRows[createReq.Email]; ok {
+			addError(importColumnEmail, fmt.Sprintf("邮箱与第 %d 行重复", firstRow))
+		} else {
+			emailRows[createReq.Email] = row.Row
+			existing, err := l.svcCtx.UserModel.FindOneByEmail(l.ctx, createReq.Email)
+			if err != nil && err != users.ErrUserNotFound {
+				l.Errorf("查询邮箱失败: %v", err)
+				return nil, nil, baseErrorx.New(50000, "系统错误")
+			}
+			if existing != nil {
+				addError(importColumnEmail, "邮箱已被使用")
+			}
+		}
+
+		// 3. 手机号唯一性（文件内及已有用户）
+		if createReq.Phone != "" {
+			if firstRow, ok := phoneRows[createReq.Phone]; ok {
+				addError(importColumnPhone, fmt.Sprintf("手机号与第 %d 行重复", firstRow))
+			} else {
+				phoneRows[createReq.Phone] = row.Row
+				existing, err := l.svcCtx.UserModel.FindOneByPhone(l.ctx, createReq.Phone)
+				if err != nil && err != users.ErrUserNotFound {
+					l.Errorf("查询手机号失败: %v", err)
+					return nil, nil, baseErrorx.New(50000, "系统错误")
+				}
+				if existing != nil {
+					addError(importColumnPhone, "手机号已被使用")
+				}
+			}
+		}
+
+		// 4. 部门存在性
+		exists, checked := deptExists[createReq.DeptId]
+		if !checked {
+			org, err := l.svcCtx.OrgModel.FindOne(l.ctx, createReq.DeptId)
+			exists = err == nil && org != nil
+			deptExists[createReq.DeptId] = exists
+		}
+		if !exists {
+			addError(importColumnDeptId, "部门不存在")
+		}
+
+		// 5. 角色绑定（试运行与正式导入结果一致）
+		if permissionRole := row.Fields[importColumnPermissionRole]; permissionRole != "" || row.Fields[importColumnPosition] != "" {
+			createReq.RoleBindings = []types.RoleBindingInput{{
+				OrgId:          createReq.DeptId,
+				Position:       row.Fields[importColumnPosition],
+				PermissionRole: permissionRole,
+			}}
+		}
+		roleBindings := make([]*rolebindings.RoleBinding, 0, len(createReq.RoleBindings))
+		for _, rbInput := range createReq.RoleBindings {
+			roleBinding, err := newRoleBinding(l.ctx, l.svcCtx, "", rbInput)
+			if err != nil {
+				addError(importColumnPermissionRole, fmt.Sprintf("角色绑定无效: %v", err))
+				break
+			}
+			roleBindings = append(roleBindings, roleBinding)
+		}
+
+		if len(rowErrors) > 0 {
+			importErrors = append(importErrors, rowErrors...)
+			continue
+		}
+		candidates = append(candidates, &importCandidate{Row: row.Row, Req: createReq, RoleBindings: roleBindings})
+	}
+
+	return candidates, importErrors, nil
+}
+
+// importUsers 在同一事务内写入全部用户，返回用户ID及系统生成的初始密码
+func (l *BatchImportLogic) importUsers(candidates []*importCandidate) ([]string, []types.ImportInitialPassword, error) {
+	creator := NewCreateUserLogic(l.ctx, l.svcCtx)
+
+	// 1. 获取当前操作人信息
+	operatorID, _ := l.ctx.Value(contextkeys.UserIDKey).(string)
+	operatorName := errorx.SystemOperatorName
+	var createdBy *string
+	if operatorID != "" {
+		createdBy = &operatorID
+		if operatorUser, err := l.svcCtx.UserModel.FindOne(l.ctx, operatorID); err == nil && operatorUser != nil {
+			operatorName = operatorUser.Name
+		}
+	} else {
+		operatorID = errorx.SystemOperatorID
+	}
+
+	// 2. 事务外完成密码加密，缩短事务时间
+	newUsers := make([]*users.User, 0, len(candidates))
+	var initialPasswords []types.ImportInitialPassword
+	for i, candidate := range candidates {
+		// 密码加密阶段占总进度的 40%
+		l.reportProgress(50, 40, i, len(candidates))
+
+		userID, err := uuid.NewV7()
+		if err != nil {
+			l.Errorf("生成用户ID失败: %v", err)
+			return nil, nil, baseErrorx.New(50000, "系统错误")
+		}
+
+		var passwordHash string
+		if candidate.Req.AccountSource == errorx.AccountSourceLocal {
+			password := candidate.Req.InitialPassword
+			if password == "" {
+				// 系统生成的初始密码随导入结果返回，否则用户无法登录
+				password = creator.generateInitialPassword()
+				initialPasswords = append(initialPasswords, types.ImportInitialPassword{
+					Row:             candidate.Row,
+					UserId:          userID.String(),
+					Email:           candidate.Req.Email,
+					InitialPassword: password,
+				})
+			}
+			hash, err := bcrypt.GenerateFromPassword([]byte(password), 10)
+			if err != nil {
+				l.Errorf("密码加密失败: %v", err)
+				return nil, nil, baseErrorx.New(50000, "系统错误")
+			}
+			passwordHash = string(hash)
+		}
+
+		deptId := candidate.Req.DeptId
+		user := &users.User{
+			Id:            userID.String(),
+			Name:          candidate.Req.Name,
+			Email:         candidate.Req.Email,
+			DeptId:        &deptId,
+			PasswordHash:  passwordHash,
+			Status:        0, // 未激活
+			AccountSource: candidate.Req.AccountSource,
+			CreatedBy:     createdBy,
+		}
+		if candidate.Req.Phone != "" {
+			phone := candidate.Req.Phone
+			user.Phone = &phone
+		}
+		newUsers = append(newUsers, user)
+	}
+
+	// 3. 事务写入
+	userIds := make([]string, 0, len(newUsers))
+	err := l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		userModel := l.svcCtx.UserModel.WithTx(tx)
+		userDeptModel := l.svcCtx.UserDeptModel.WithTx(tx)
+		roleBindingModel := l.svcCtx.RoleBindingModel.WithTx(tx)
+		auditLogModel := l.svcCtx.AuditLogModel.WithTx(tx)
+		now := time.Now()
+
+		for i, user := range newUsers {
+			candidate := candidates[i]
+
+			// 3.1 创建用户
+			if _, err := userModel.Insert(l.ctx, user); err != nil {
+				if err == users.ErrEmailExists {
+					return baseErrorx.New(errorx.ErrUserManagementEmailExists, fmt.Sprintf("第 %d 行邮箱已被使用", candidate.Row))
+				}
+				l.Errorf("创建用户失败: row=%d, error=%v", candidate.Row, err)
+				return baseErrorx.New(50000, "系统错误")
+			}
+
+			// 3.2 设置主部门
+			relationId, err := uuid.NewV7()
+			if err != nil {
+				return baseErrorx.New(50000, "系统错误")
+			}
+			if _, err := userDeptModel.Insert(l.ctx, &userdept.SysUserDept{
+				Id:        relationId.String(),
+				UserId:    user.Id,
+				DeptId:    candidate.Req.DeptId,
+				IsPrimary: 1,
+			}); err != nil {
+				l.Errorf("设置主部门失败: row=%d, error=%v", candidate.Row, err)
+				return baseErrorx.New(50000, "系统错误")
+			}
+
+			// 3.3 创建角色绑定（已在校验阶段构建）
+			for _, roleBinding := range candidate.RoleBindings {
+				roleBinding.UserId = user.Id
+				if _, err := roleBindingModel.Insert(l.ctx, roleBinding); err != nil {
+					l.Errorf("创建角色绑定失败: row=%d, error=%v", candidate.Row, err)
+					return baseErrorx.New(50000, "创建角色绑定失败")
+				}
+			}
+
+			// 3.4 记录审计日志
+			changes := map[string]interface{}{
+				"status":         map[string]interface{}{"new": 0},
+				"account_source": map[string]interface{}{"new": user.AccountSource},
+				"dept_id":        map[string]interface{}{"new": candidate.Req.DeptId},
+				"source":         "batch_import",
+			}
+			changesJSON, _ := json.Marshal(changes)
+			if _, err := auditLogModel.Insert(l.ctx, &auditlogs.AuditLog{
+				UserId:     user.Id,
+				Action:     "create",
+				Operator:   operatorName,
+				OperatorId: operatorID,
+				Changes:    datatypes.JSON(changesJSON),
+				Timestamp:  now,
+			}); err != nil {
+				l.Errorf("记录审计日志失败: %v", err)
+				// 审计日志失败不影响主流程，仅记录错误
+			}
+
+			userIds = append(userIds, user.Id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
 
-	return
+	return userIds, initialPasswords, nil
 }
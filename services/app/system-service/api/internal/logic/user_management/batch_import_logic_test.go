@@ -0,0 +1,157 @@
+package user_management
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/userdept"
+	auditlogs "github.com/DataSemanticHub/services/app/system-service/model/user/audit_logs"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const importTestDeptId = "dept-1"
+
+// setupBatchImportTest 创建测试用的 BatchImportLogic（SQLite）
+func setupBatchImportTest(t *testing.T) (*BatchImportLogic, *gorm.DB) {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(
+		&users.User{},
+		&organization.SysOrganization{},
+		&userdept.SysUserDept{},
+		&rolebindings.RoleBinding{},
+		&auditlogs.AuditLog{},
+	))
+	require.NoError(t, db.Exec("INSERT INTO sys_organization (id, parent_id, name, code, ancestors) VALUES (?, '0', '研发部', 'rd', '0')", importTestDeptId).Error)
+
+	svcCtx := &svc.ServiceContext{
+		DB:               db,
+		UserModel:        users.NewModel(db),
+		OrgModel:         organization.NewModel(db),
+		UserDeptModel:    userdept.NewModel(db),
+		RoleBindingModel: rolebindings.NewModel(db),
+		AuditLogModel:    auditlogs.NewModel(db),
+	}
+	return NewBatchImportLogic(context.Background(), svcCtx), db
+}
+
+// TestBatchImport_DryRun_ReturnsAllErrorsWithoutWriting 测试试运行返回全部错误且不写入
+func TestBatchImport_DryRun_ReturnsAllErrorsWithoutWriting(t *testing.T) {
+	logic, db := setupBatchImportTest(t)
+
+	csv := "姓名,邮箱,手机号,部门ID\n" +
+		"张三,zhangsan@example.com,13800000001,dept-1\n" +
+		"李四,ZhangSan@example.com,1380000,dept-1\n" +
+		"王五,wangwu@example.com,,dept-404\n" +
+		",nobody@example.com,,dept-1\n"
+
+	resp, err := logic.BatchImport(&types.BatchImportReq{DryRun: true}, "users.csv", []byte(csv))
+	require.NoError(t, err)
+	assert.Equal(t, 4, resp.TotalRows)
+	assert.Equal(t, 1, resp.SuccessCount)
+	assert.Equal(t, 3, resp.FailedCount)
+
+	assert.Contains(t, resp.Errors, types.ImportError{Row: 3, Field: "phone", Reason: "手机号格式不正确"})
+	assert.Contains(t, resp.Errors, types.ImportError{Row: 4, Field: "dept_id", Reason: "部门不存在"})
+	assert.Contains(t, resp.Errors, types.ImportError{Row: 5, Field: "name", Reason: "姓名不能为空"})
+
+	var count int64
+	require.NoError(t, db.Model(&users.User{}).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+}
+
+// TestBatchImport_DuplicateEmailInFile_ReportsRow 测试文件内邮箱重复
+func TestBatchImport_DuplicateEmailInFile_ReportsRow(t *testing.T) {
+	logic, _ := setupBatchImportTest(t)
+
+	csv := "name,email,dept_id\n" +
+		"张三,dup@example.com,dept-1\n" +
+		"李四,DUP@example.com,dept-1\n"
+
+	resp, err := logic.BatchImport(&types.BatchImportReq{DryRun: true}, "users.csv", []byte(csv))
+	require.NoError(t, err)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, types.ImportError{Row: 3, Field: "email", Reason: "邮箱与第 2 行重复"}, resp.Errors[0])
+}
+
+// TestBatchImport_Commit_CreatesUsersDeptsAndBindings 测试正式导入写入用户、主部门和角色绑定
+func TestBatchImport_Commit_CreatesUsersDeptsAndBindings(t *testing.T) {
+	logic, db := setupBatchImportTest(t)
+
+	file := excelize.NewFile()
+	defer file.Close()
+	sheet := file.GetSheetName(0)
+	require.NoError(t, file.SetSheetRow(sheet, "A1", &[]interface{}{"姓名", "邮箱", "部门ID", "岗位", "权限角色"}))
+	require.NoError(t, file.SetSheetRow(sheet, "A2", &[]interface{}{"张三", "zhangsan@example.com", "dept-1", "工程师", "org_viewer"}))
+	require.NoError(t, file.SetSheetRow(sheet, "A3", &[]interface{}{"李四", "lisi@example.com", "dept-1"}))
+	buf, err := file.WriteToBuffer()
+	require.NoError(t, err)
+
+	resp, err := logic.BatchImport(&types.BatchImportReq{}, "users.xlsx", buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.SuccessCount)
+	require.Len(t, resp.UserIds, 2)
+
+	var deptCount, bindingCount int64
+	require.NoError(t, db.Model(&userdept.SysUserDept{}).Where("dept_id = ? AND is_primary = 1", importTestDeptId).Count(&deptCount).Error)
+	assert.Equal(t, int64(2), deptCount)
+	require.NoError(t, db.Model(&rolebindings.RoleBinding{}).Where("user_id = ?", resp.UserIds[0]).Count(&bindingCount).Error)
+	assert.Equal(t, int64(1), bindingCount)
+
+	// 未填写初始密码时返回系统生成的密码
+	require.Len(t, resp.InitialPasswords, 2)
+	generated := resp.InitialPasswords[0]
+	assert.Equal(t, 2, generated.Row)
+	assert.Equal(t, resp.UserIds[0], generated.UserId)
+	assert.Equal(t, "zhangsan@example.com", generated.Email)
+	var user users.User
+	require.NoError(t, db.Where("id = ?", generated.UserId).First(&user).Error)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(generated.InitialPassword)))
+}
+
+// TestBatchImport_CommitWithErrors_WritesNothing 测试正式导入存在错误行时整体不写入
+func TestBatchImport_CommitWithErrors_WritesNothing(t *testing.T) {
+	logic, db := setupBatchImportTest(t)
+
+	csv := "name,email,dept_id\n" +
+		"张三,zhangsan@example.com,dept-1\n" +
+		"李四,invalid-email,dept-1\n"
+
+	resp, err := logic.BatchImport(&types.BatchImportReq{}, "users.csv", []byte(csv))
+	require.NoError(t, err)
+	assert.Equal(t, 0, resp.SuccessCount)
+	assert.Equal(t, 1, resp.FailedCount)
+	assert.Empty(t, resp.UserIds)
+
+	var count int64
+	require.NoError(t, db.Model(&users.User{}).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+}
+
+// TestBatchImport_UnsupportedFormat_ReturnsError 测试不支持的文件格式
+func TestBatchImport_UnsupportedFormat_ReturnsError(t *testing.T) {
+	logic, _ := setupBatchImportTest(t)
+
+	_, err := logic.BatchImport(&types.BatchImportReq{DryRun: true}, "users.txt", []byte("name,email"))
+	require.Error(t, err)
+	codeErr, ok := err.(*baseErrorx.CodeError)
+	require.True(t, ok)
+	assert.Equal(t, errorx.ErrUserManagementImportInvalidFile, codeErr.Code)
+}
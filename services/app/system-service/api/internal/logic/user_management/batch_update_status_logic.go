@@ -204,7 +204,21 @@ func (l *BatchUpdateStatusLogic) BatchUpdateStatus(req *types.BatchUpdateStatusR
 		// 审计日志失败不影响主流程，仅记录错误
 	}
 
-	// 10. 返回响应
+	// 10. 停用/锁定/归档的用户强制下线（吊销其已签发的全部 Token）
+	if req.Status != 1 {
+		now := time.Now()
+		for _, userId := range successIds {
+			if err := l.svcCtx.TokenStore.RevokeUser(l.ctx, userId, now); err != nil {
+				l.Errorf("强制下线失败: userId=%s, error=%v", userId, err)
+				// 强制下线失败不影响状态更新结果，仅记录错误
+			}
+		}
+	}
+
+	// 11. 状态变更后使相关用户的授权快照失效
+	invalidatePermissionCache(l.ctx, l.svcCtx, successIds...)
+
+	// 12. 返回响应
 	return &types.BatchUpdateStatusResp{
 		SuccessCount: len(successIds),
 		FailedCount:  len(errors),
@@ -7,11 +7,14 @@ import (
 
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/config"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/tokenstore"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	auditlogs "github.com/DataSemanticHub/services/app/system-service/model/user/audit_logs"
 	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -90,7 +93,7 @@ func (m *MockUserModelForBatchUpdate) BatchUpdateStatus(ctx context.Context, use
 	return successIds, errors, args.Error(2)
 }
 
-func (m *MockUserModelForBatchUpdate) GetStatistics(ctx context.Context) (*users.Statistics, error) {
+func (m *MockUserModelForBatchUpdate) GetStatistics(ctx context.Context, scope *users.DataScopeFilter) (*users.Statistics, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -154,13 +157,14 @@ func (m *MockAuditLogModelForBatchUpdate) WithTx(tx interface{}) auditlogs.Model
 }
 
 // setupTestLogicForBatchUpdate 创建测试用的 Logic 实例
-func setupTestLogicForBatchUpdate() (*BatchUpdateStatusLogic, *MockUserModelForBatchUpdate, *MockAuditLogModelForBatchUpdate) {
+func setupTestLogicForBatchUpdate(t *testing.T) (*BatchUpdateStatusLogic, *MockUserModelForBatchUpdate, *MockAuditLogModelForBatchUpdate) {
 	mockUserModel := new(MockUserModelForBatchUpdate)
 	mockAuditLogModel := new(MockAuditLogModelForBatchUpdate)
 	svcCtx := &svc.ServiceContext{
 		Config:        config.Config{},
 		UserModel:     mockUserModel,
 		AuditLogModel: mockAuditLogModel,
+		TokenStore:    tokenstore.NewStore(redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()}), 0),
 	}
 	logic := NewBatchUpdateStatusLogic(context.Background(), svcCtx)
 	return logic, mockUserModel, mockAuditLogModel
@@ -168,7 +172,7 @@ func setupTestLogicForBatchUpdate() (*BatchUpdateStatusLogic, *MockUserModelForB
 
 // TestBatchUpdateStatus_ValidInput_UpdatesAllUsers 测试正常批量更新场景
 func TestBatchUpdateStatus_ValidInput_UpdatesAllUsers(t *testing.T) {
-	logic, mockUserModel, mockAuditLogModel := setupTestLogicForBatchUpdate()
+	logic, mockUserModel, mockAuditLogModel := setupTestLogicForBatchUpdate(t)
 
 	// 准备测试数据
 	user1ID, _ := uuid.NewV7()
@@ -223,7 +227,7 @@ func TestBatchUpdateStatus_ValidInput_UpdatesAllUsers(t *testing.T) {
 
 // TestBatchUpdateStatus_PartialFailure_ReturnsMixedResults 测试部分失败场景
 func TestBatchUpdateStatus_PartialFailure_ReturnsMixedResults(t *testing.T) {
-	logic, mockUserModel, mockAuditLogModel := setupTestLogicForBatchUpdate()
+	logic, mockUserModel, mockAuditLogModel := setupTestLogicForBatchUpdate(t)
 
 	// 准备测试数据
 	user1ID, _ := uuid.NewV7()
@@ -273,7 +277,7 @@ func TestBatchUpdateStatus_PartialFailure_ReturnsMixedResults(t *testing.T) {
 
 // TestBatchUpdateStatus_SelfOperation_ReturnsError 测试自我操作限制
 func TestBatchUpdateStatus_SelfOperation_ReturnsError(t *testing.T) {
-	logic, mockUserModel, _ := setupTestLogicForBatchUpdate()
+	logic, mockUserModel, _ := setupTestLogicForBatchUpdate(t)
 
 	// 准备测试数据
 	operatorID, _ := uuid.NewV7()
@@ -311,7 +315,7 @@ func TestBatchUpdateStatus_SelfOperation_ReturnsError(t *testing.T) {
 
 // TestBatchUpdateStatus_LockStatus_RequiresReason 测试锁定原因必填验证
 func TestBatchUpdateStatus_LockStatus_RequiresReason(t *testing.T) {
-	logic, mockUserModel, _ := setupTestLogicForBatchUpdate()
+	logic, mockUserModel, _ := setupTestLogicForBatchUpdate(t)
 
 	// 准备测试数据
 	userID, _ := uuid.NewV7()
@@ -345,7 +349,7 @@ func TestBatchUpdateStatus_LockStatus_RequiresReason(t *testing.T) {
 
 // TestBatchUpdateStatus_LockStatus_WithReason_Succeeds 测试锁定状态提供原因时成功
 func TestBatchUpdateStatus_LockStatus_WithReason_Succeeds(t *testing.T) {
-	logic, mockUserModel, mockAuditLogModel := setupTestLogicForBatchUpdate()
+	logic, mockUserModel, mockAuditLogModel := setupTestLogicForBatchUpdate(t)
 
 	// 准备测试数据
 	userID, _ := uuid.NewV7()
@@ -397,7 +401,7 @@ func TestBatchUpdateStatus_LockStatus_WithReason_Succeeds(t *testing.T) {
 
 // TestBatchUpdateStatus_InvalidParams_ReturnsError 测试参数校验场景
 func TestBatchUpdateStatus_InvalidParams_ReturnsError(t *testing.T) {
-	logic, _, _ := setupTestLogicForBatchUpdate()
+	logic, _, _ := setupTestLogicForBatchUpdate(t)
 
 	testCases := []struct {
 		name string
@@ -421,7 +425,7 @@ func TestBatchUpdateStatus_InvalidParams_ReturnsError(t *testing.T) {
 
 // TestBatchUpdateStatus_AuditLog_RecordsChanges 测试审计日志记录
 func TestBatchUpdateStatus_AuditLog_RecordsChanges(t *testing.T) {
-	logic, mockUserModel, mockAuditLogModel := setupTestLogicForBatchUpdate()
+	logic, mockUserModel, mockAuditLogModel := setupTestLogicForBatchUpdate(t)
 
 	// 准备测试数据
 	userID, _ := uuid.NewV7()
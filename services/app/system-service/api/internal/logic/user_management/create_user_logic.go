@@ -138,19 +138,12 @@ func (l *CreateUserLogic) CreateUser(req *types.CreateUserReq) (resp *types.Crea
 		// 9.2 创建角色绑定
 		roleBindingModel := l.svcCtx.RoleBindingModel.WithTx(tx)
 		for _, rbInput := range req.RoleBindings {
-			roleBinding := &rolebindings.RoleBinding{
-				UserId: createdUser.Id,
-				OrgId:  rbInput.OrgId,
-			}
-			if rbInput.Position != "" {
-				position := strings.TrimSpace(rbInput.Position)
-				roleBinding.Position = &position
-			}
-			if rbInput.PermissionRole != "" {
-				permissionRole := strings.TrimSpace(rbInput.PermissionRole)
-				roleBinding.PermissionRole = &permissionRole
+			roleBinding, err := newRoleBinding(l.ctx, l.svcCtx, createdUser.Id, rbInput)
+			if err != nil {
+				l.Errorf("校验角色绑定失败: %v", err)
+				return err
 			}
-			_, err := roleBindingModel.Insert(l.ctx, roleBinding)
+			_, err = roleBindingModel.Insert(l.ctx, roleBinding)
 			if err != nil {
 				l.Errorf("创建角色绑定失败: %v", err)
 				return baseErrorx.New(50000, "创建角色绑定失败")
@@ -196,6 +189,7 @@ func (l *CreateUserLogic) CreateUser(req *types.CreateUserReq) (resp *types.Crea
 	if err != nil {
 		return nil, err
 	}
+	invalidatePermissionCache(l.ctx, l.svcCtx, userID.String())
 
 	// 10. 发送邀请邮件（Mock实现，后续接入邮件服务）
 	if req.SendInvitation {
@@ -216,37 +210,92 @@ func (l *CreateUserLogic) CreateUser(req *types.CreateUserReq) (resp *types.Crea
 
 // validateCreateUser 校验创建用户参数
 func (l *CreateUserLogic) validateCreateUser(req *types.CreateUserReq) error {
+	if fieldErr := checkCreateUserFields(req); fieldErr != nil {
+		return fieldErr.Err
+	}
+	return nil
+}
+
+// userFieldError 用户字段校验错误（记录出错字段，供批量导入逐行返回）
+type userFieldError struct {
+	Field string
+	Err   error
+}
+
+var (
+	emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
+	phoneRegex = regexp.MustCompile(`^1[3-9]\d{9}$`)
+)
+
+// checkCreateUserFields 校验创建用户字段（创建用户与批量导入共用）
+func checkCreateUserFields(req *types.CreateUserReq) *userFieldError {
 	// 必填字段校验
 	if strings.TrimSpace(req.Name) == "" {
-		return baseErrorx.New(20001, "姓名不能为空")
+		return &userFieldError{Field: "name", Err: baseErrorx.New(20001, "姓名不能为空")}
 	}
 	if strings.TrimSpace(req.Email) == "" {
-		return baseErrorx.New(20001, "邮箱不能为空")
+		return &userFieldError{Field: "email", Err: baseErrorx.New(20001, "邮箱不能为空")}
 	}
 	if strings.TrimSpace(req.DeptId) == "" {
-		return baseErrorx.New(20001, "部门ID不能为空")
+		return &userFieldError{Field: "dept_id", Err: baseErrorx.New(20001, "部门ID不能为空")}
 	}
 	if req.AccountSource != "local" && req.AccountSource != "sso" {
-		return baseErrorx.New(20002, "账号来源必须是 local 或 sso")
+		return &userFieldError{Field: "account_source", Err: baseErrorx.New(20002, "账号来源必须是 local 或 sso")}
 	}
 
 	// 邮箱格式校验
-	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
 	if !emailRegex.MatchString(req.Email) {
-		return baseErrorx.New(20002, "邮箱格式不正确")
+		return &userFieldError{Field: "email", Err: baseErrorx.New(20002, "邮箱格式不正确")}
 	}
 
 	// 手机号格式校验（如果提供）
 	if req.Phone != "" {
-		phoneRegex := regexp.MustCompile(`^1[3-9]\d{9}$`)
 		if !phoneRegex.MatchString(req.Phone) {
-			return baseErrorx.New(20002, "手机号格式不正确")
+			return &userFieldError{Field: "phone", Err: baseErrorx.New(20002, "手机号格式不正确")}
 		}
 	}
 
 	return nil
 }
 
+// newRoleBinding 根据输入构建角色绑定（创建、更新用户与批量导入共用）
+// 指定 RoleId 时校验角色存在，并在未填写 PermissionRole 时回填角色编码；仅填写 PermissionRole 时按原方式保存
+func newRoleBinding(ctx context.Context, svcCtx *svc.ServiceContext, userId string, input types.RoleBindingInput) (*rolebindings.RoleBinding, error) {
+	roleBinding := &rolebindings.RoleBinding{
+		UserId: userId,
+		OrgId:  input.OrgId,
+	}
+	if input.Position != "" {
+		position := strings.TrimSpace(input.Position)
+		roleBinding.Position = &position
+	}
+	if input.PermissionRole != "" {
+		permissionRole := strings.TrimSpace(input.PermissionRole)
+		roleBinding.PermissionRole = &permissionRole
+	}
+	if input.RoleId != "" {
+		role, err := svcCtx.RoleModel.FindOne(ctx, input.RoleId)
+		if err != nil {
+			return nil, err
+		}
+		roleBinding.RoleId = &role.Id
+		if roleBinding.PermissionRole == nil {
+			roleBinding.PermissionRole = &role.Code
+		}
+	}
+	return roleBinding, nil
+}
+
+// invalidatePermissionCache 用户或其角色绑定变更提交后使授权快照失效
+// 失效失败不影响主流程，仅记录错误（快照随缓存时长自然过期）
+func invalidatePermissionCache(ctx context.Context, svcCtx *svc.ServiceContext, userIds ...string) {
+	for _, userId := range userIds {
+		if err := svcCtx.PermissionCache.InvalidateUser(ctx, userId); err != nil {
+			logx.WithContext(ctx).Errorf("清除用户授权快照缓存失败: userId=%s, error=%v", userId, err)
+		}
+	}
+}
+
 // validatePassword 校验密码复杂度（至少8位，包含字母和数字）
 func (l *CreateUserLogic) validatePassword(password string) error {
 	if len(password) < 8 || len(password) > 128 {
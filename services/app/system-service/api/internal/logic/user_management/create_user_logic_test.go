@@ -90,7 +90,7 @@ func (m *MockUserModelForCreate) BatchUpdateStatus(ctx context.Context, userIds
 	return successIds, errors, args.Error(2)
 }
 
-func (m *MockUserModelForCreate) GetStatistics(ctx context.Context) (*users.Statistics, error) {
+func (m *MockUserModelForCreate) GetStatistics(ctx context.Context, scope *users.DataScopeFilter) (*users.Statistics, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -137,6 +137,19 @@ func (m *MockRoleBindingModelForCreate) FindByUserId(ctx context.Context, userId
 	return args.Get(0).([]*rolebindings.RoleBinding), args.Error(1)
 }
 
+func (m *MockRoleBindingModelForCreate) CountByRoleId(ctx context.Context, roleId string) (int64, error) {
+	args := m.Called(ctx, roleId)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRoleBindingModelForCreate) FindUserIdsByRoleRefs(ctx context.Context, roleIds []string, permissionRoles []string) ([]string, error) {
+	args := m.Called(ctx, roleIds, permissionRoles)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (m *MockRoleBindingModelForCreate) FindOne(ctx context.Context, id int64) (*rolebindings.RoleBinding, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -155,6 +168,14 @@ func (m *MockRoleBindingModelForCreate) Delete(ctx context.Context, id int64) er
 	return args.Error(0)
 }
 
+func (m *MockRoleBindingModelForCreate) FindByUserIds(ctx context.Context, userIds []string) ([]*rolebindings.RoleBinding, error) {
+	args := m.Called(ctx, userIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*rolebindings.RoleBinding), args.Error(1)
+}
+
 func (m *MockRoleBindingModelForCreate) DeleteByUserId(ctx context.Context, userId string) error {
 	args := m.Called(ctx, userId)
 	return args.Error(0)
@@ -166,7 +166,10 @@ func (l *DeleteUserLogic) DeleteUser(userId string, req *types.DeleteUserReq) (r
 		return nil, err
 	}
 
-	// 8. 返回响应
+	// 8. 归档或删除后使该用户的授权快照失效
+	invalidatePermissionCache(l.ctx, l.svcCtx, userId)
+
+	// 9. 返回响应
 	return &types.DeleteUserResp{
 		Archived:           !req.Force, // 如果不是强制删除，则为归档
 		ImpactsTransferred: impactsTransferred,
@@ -5,19 +5,88 @@ package user_management
 
 import (
 	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
 
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/datascope"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
 
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/xuri/excelize/v2"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+// 导出格式
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+)
+
+// exportBatchSize 每批从数据库读取的用户数
+const exportBatchSize = 500
+
+// exportStatusLabels 用户状态显示名称
+var exportStatusLabels = map[int8]string{
+	0: "未激活",
+	1: "启用",
+	2: "停用",
+	3: "锁定",
+	4: "归档",
+}
+
+// exportColumn 导出列定义
+type exportColumn struct {
+	Key    string
+	Header string
+	Value  func(row *exportUserRow) string
+}
+
+// exportColumns 可导出的列（顺序即默认导出顺序）
+var exportColumns = []exportColumn{
+	{Key: "id", Header: "用户ID", Value: func(r *exportUserRow) string { return r.User.Id }},
+	{Key: "name", Header: "姓名", Value: func(r *exportUserRow) string { return r.User.Name }},
+	{Key: "email", Header: "邮箱", Value: func(r *exportUserRow) string { return r.User.Email }},
+	{Key: "phone", Header: "手机号", Value: func(r *exportUserRow) string { return stringValue(r.User.Phone) }},
+	{Key: "status", Header: "状态", Value: func(r *exportUserRow) string { return exportStatusLabels[r.User.Status] }},
+	{Key: "account_source", Header: "账号来源", Value: func(r *exportUserRow) string { return r.User.AccountSource }},
+	{Key: "primary_dept", Header: "主部门", Value: func(r *exportUserRow) string { return r.PrimaryDept }},
+	{Key: "aux_depts", Header: "辅助部门", Value: func(r *exportUserRow) string { return strings.Join(r.AuxDepts, ";") }},
+	{Key: "roles", Header: "权限角色", Value: func(r *exportUserRow) string { return strings.Join(r.Roles, ";") }},
+	{Key: "last_login_at", Header: "最后登录时间", Value: func(r *exportUserRow) string { return formatExportTime(r.User.LastLoginAt) }},
+	{Key: "created_at", Header: "创建时间", Value: func(r *exportUserRow) string { return formatExportTime(&r.User.CreatedAt) }},
+}
+
+// exportUserRow 导出行数据
+type exportUserRow struct {
+	User        *users.User
+	PrimaryDept string
+	AuxDepts    []string
+	Roles       []string
+}
+
 type ExportUsersLogic struct {
 	logx.Logger
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
 }
 
+// UserExport 已校验的导出任务，由 handler 设置响应头后写出
+type UserExport struct {
+	Filename    string
+	ContentType string
+	OnProgress  func(progress int) // 写出进度回调（0-100，可选），供异步任务上报进度
+
+	logic   *ExportUsersLogic
+	format  string
+	columns []exportColumn
+	findReq *users.FindListReq
+}
+
 // 导出用户数据
 func NewExportUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportUsersLogic {
 	return &ExportUsersLogic{
@@ -27,8 +96,328 @@ func NewExportUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Expor
 	}
 }
 
-func (l *ExportUsersLogic) ExportUsers(req *types.ListUsersReq) (resp *types.EmptyResp, err error) {
-	// todo: add your logic here and delete this line
+// ExportUsers 校验导出参数并创建导出任务（筛选条件与用户列表一致）
+func (l *ExportUsersLogic) ExportUsers(req *types.ExportUsersReq) (*UserExport, error) {
+	// 1. 校验导出格式
+	format := strings.ToLower(strings.TrimSpace(req.Format))
+	if format == "" {
+		format = ExportFormatCSV
+	}
+	var contentType string
+	switch format {
+	case ExportFormatCSV:
+		contentType = "text/csv; charset=utf-8"
+	case ExportFormatXLSX:
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return nil, baseErrorx.New(20002, "导出格式必须是 csv 或 xlsx")
+	}
+
+	// 2. 解析导出列
+	columns, err := parseExportColumns(req.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 解析调用方的数据范围（异步导出任务以提交人身份执行）
+	scope, err := l.svcCtx.DataScope.Resolve(l.ctx, datascope.ModuleUser)
+	if err != nil {
+		l.Errorf("解析数据范围失败: %v", err)
+		return nil, err
+	}
+
+	// 4. 构建 Model 层查询请求（筛选条件与 ListUsersLogic 保持一致）
+	// 导出按 created_at、id 游标分批读取，只支持按创建时间排序，SortField 不生效
+	findReq := &users.FindListReq{
+		PageSize:       exportBatchSize,
+		Keyword:        req.Keyword,
+		DeptId:         req.DeptId,
+		AccountSource:  req.AccountSource,
+		PermissionRole: req.PermissionRole,
+		SortField:      "created_at",
+		SortOrder:      req.SortOrder,
+		Scope:          scope.UserFilter(),
+	}
+	if req.Status > 0 {
+		status := req.Status
+		findReq.Status = &status
+	}
+
+	return &UserExport{
+		Filename:    fmt.Sprintf("users_%s.%s", time.Now().Format("20060102150405"), format),
+		ContentType: contentType,
+		logic:       l,
+		format:      format,
+		columns:     columns,
+		findReq:     findReq,
+	}, nil
+}
+
+// Write 分批查询并写出导出文件
+func (e *UserExport) Write(w io.Writer) error {
+	if e.format == ExportFormatXLSX {
+		return e.writeXLSX(w)
+	}
+	return e.writeCSV(w)
+}
+
+// writeCSV 以 CSV 格式写出（带 UTF-8 BOM，便于 Excel 识别中文）
+func (e *UserExport) writeCSV(w io.Writer) error {
+	if _, err := io.WriteString(w, "\xef\xbb\xbf"); err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.Write(e.headers()); err != nil {
+		return err
+	}
+
+	err := e.logic.eachBatch(e.findReq, func(rows []*exportUserRow) error {
+		for _, row := range rows {
+			if err := writer.Write(e.values(row)); err != nil {
+				return err
+			}
+		}
+		// 每批写完立即刷出，避免在内存中累积
+		writer.Flush()
+		return writer.Error()
+	}, e.OnProgress)
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeXLSX 以 XLSX 格式写出（使用流式写入，行数据不驻留内存）
+func (e *UserExport) writeXLSX(w io.Writer) error {
+	file := excelize.NewFile()
+	defer file.Close()
+
+	sheet := file.GetSheetName(0)
+	stream, err := file.NewStreamWriter(sheet)
+	if err != nil {
+		return err
+	}
+
+	rowIndex := 1
+	writeRow := func(values []string) error {
+		cells := make([]interface{}, len(values))
+		for i, v := range values {
+			cells[i] = v
+		}
+		cell, err := excelize.CoordinatesToCellName(1, rowIndex)
+		if err != nil {
+			return err
+		}
+		rowIndex++
+		return stream.SetRow(cell, cells)
+	}
+
+	if err := writeRow(e.headers()); err != nil {
+		return err
+	}
+	err = e.logic.eachBatch(e.findReq, func(rows []*exportUserRow) error {
+		for _, row := range rows {
+			if err := writeRow(e.values(row)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, e.OnProgress)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Flush(); err != nil {
+		return err
+	}
+	return file.Write(w)
+}
+
+// headers 导出表头
+func (e *UserExport) headers() []string {
+	headers := make([]string, len(e.columns))
+	for i, column := range e.columns {
+		headers[i] = column.Header
+	}
+	return headers
+}
+
+// values 导出行的单元格值
+func (e *UserExport) values(row *exportUserRow) []string {
+	values := make([]string, len(e.columns))
+	for i, column := range e.columns {
+		values[i] = column.Value(row)
+	}
+	return values
+}
+
+// eachBatch 按 created_at、id 游标分批查询用户并补全部门、角色信息，progress 不为空时每批处理完成后上报进度
+func (l *ExportUsersLogic) eachBatch(findReq *users.FindListReq, fn func(rows []*exportUserRow) error, progress func(progress int)) error {
+	deptNames := make(map[string]string)
+	done := 0
+	var total int64
+	var cursor *users.Cursor
+
+	for {
+		// 1. 查询一批用户（首批同时获取总数，后续批次从上一批最后一条继续）
+		batchReq := *findReq
+		batchReq.Page = 1
+		batchReq.Cursor = cursor
+		userList, count, err := l.svcCtx.UserModel.FindList(l.ctx, &batchReq)
+		if err != nil {
+			l.Errorf("查询导出用户失败: done=%d, error=%v", done, err)
+			return err
+		}
+		if cursor == nil {
+			total = count
+		}
+		if len(userList) == 0 {
+			return nil
+		}
+
+		// 2. 补全部门和角色信息
+		rows, err := l.buildRows(userList, deptNames)
+		if err != nil {
+			return err
+		}
+		if err := fn(rows); err != nil {
+			return err
+		}
+		done += len(rows)
+		if progress != nil && total > 0 {
+			// 文件尚未写完，最多上报 99
+			progress(min(done*100/int(total), 99))
+		}
+
+		if len(userList) < findReq.PageSize {
+			return nil
+		}
+		last := userList[len(userList)-1]
+		cursor = &users.Cursor{CreatedAt: last.CreatedAt, Id: last.Id}
+	}
+}
+
+// buildRows 批量查询部门关联和角色绑定，组装导出行
+func (l *ExportUsersLogic) buildRows(userList []*users.User, deptNames map[string]string) ([]*exportUserRow, error) {
+	userIds := make([]string, 0, len(userList))
+	rows := make([]*exportUserRow, 0, len(userList))
+	rowByUser := make(map[string]*exportUserRow, len(userList))
+	for _, user := range userList {
+		row := &exportUserRow{User: user}
+		userIds = append(userIds, user.Id)
+		rows = append(rows, row)
+		rowByUser[user.Id] = row
+	}
+
+	// 1. 部门关联（主部门、辅助部门）
+	relations, err := l.svcCtx.UserDeptModel.FindByUserIds(l.ctx, userIds)
+	if err != nil {
+		l.Errorf("查询用户部门关联失败: %v", err)
+		return nil, err
+	}
+	for _, relation := range relations {
+		row := rowByUser[relation.UserId]
+		name := l.deptName(relation.DeptId, deptNames)
+		if relation.IsPrimary == 1 {
+			row.PrimaryDept = name
+		} else {
+			row.AuxDepts = append(row.AuxDepts, name)
+		}
+	}
+	for _, row := range rows {
+		// 未写入关联表的历史数据，回退到用户表中的部门
+		if row.PrimaryDept == "" && row.User.DeptId != nil && *row.User.DeptId != "" {
+			row.PrimaryDept = l.deptName(*row.User.DeptId, deptNames)
+		}
+	}
+
+	// 2. 角色绑定
+	bindings, err := l.svcCtx.RoleBindingModel.FindByUserIds(l.ctx, userIds)
+	if err != nil {
+		l.Errorf("查询用户角色绑定失败: %v", err)
+		return nil, err
+	}
+	for _, binding := range bindings {
+		if binding.PermissionRole == nil || *binding.PermissionRole == "" {
+			continue
+		}
+		row := rowByUser[binding.UserId]
+		if !containsString(row.Roles, *binding.PermissionRole) {
+			row.Roles = append(row.Roles, *binding.PermissionRole)
+		}
+	}
+
+	return rows, nil
+}
+
+// deptName 查询部门名称（导出过程中缓存）；部门不存在时返回部门 ID
+func (l *ExportUsersLogic) deptName(deptId string, cache map[string]string) string {
+	if name, ok := cache[deptId]; ok {
+		return name
+	}
+	name := deptId
+	if org, err := l.svcCtx.OrgModel.FindOne(l.ctx, deptId); err == nil && org != nil {
+		name = org.Name
+	}
+	cache[deptId] = name
+	return name
+}
+
+// parseExportColumns 解析导出列参数（逗号分隔），为空时导出全部列
+func parseExportColumns(param string) ([]exportColumn, error) {
+	if strings.TrimSpace(param) == "" {
+		return exportColumns, nil
+	}
+
+	columnByKey := make(map[string]exportColumn, len(exportColumns))
+	for _, column := range exportColumns {
+		columnByKey[column.Key] = column
+	}
+
+	var columns []exportColumn
+	selected := make(map[string]bool)
+	for _, key := range strings.Split(param, ",") {
+		key = strings.TrimSpace(key)
+		if key == "" || selected[key] {
+			continue
+		}
+		column, ok := columnByKey[key]
+		if !ok {
+			return nil, baseErrorx.New(20002, fmt.Sprintf("不支持的导出列: %s", key))
+		}
+		selected[key] = true
+		columns = append(columns, column)
+	}
+	if len(columns) == 0 {
+		return exportColumns, nil
+	}
+	return columns, nil
+}
+
+// formatExportTime 格式化导出时间
+func formatExportTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
 
-	return
+// stringValue 取字符串指针的值
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// containsString 判断切片是否包含指定字符串
+func containsString(list []string, target string) bool {
+	for _, item := range list {
+		if item == target {
+			return true
+		}
+	}
+	return false
 }
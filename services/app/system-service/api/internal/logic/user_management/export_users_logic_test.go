@@ -0,0 +1,90 @@
+package user_management
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/userdept"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"gorm.io/gorm"
+)
+
+// seedExportTestData 准备导出测试数据：张三（主部门 + 辅助部门 + 角色）、李四（停用）
+func seedExportTestData(t *testing.T, db *gorm.DB) {
+	require.NoError(t, db.Exec("INSERT INTO sys_organization (id, parent_id, name, code, ancestors) VALUES ('dept-2', '0', '产品部', 'pd', '0')").Error)
+
+	deptId := importTestDeptId
+	require.NoError(t, db.Create(&users.User{Id: "u-1", Name: "张三", Email: "zhangsan@example.com", DeptId: &deptId, Status: 1, AccountSource: "local"}).Error)
+	require.NoError(t, db.Create(&users.User{Id: "u-2", Name: "李四", Email: "lisi@example.com", DeptId: &deptId, Status: 2, AccountSource: "sso"}).Error)
+
+	require.NoError(t, db.Create(&userdept.SysUserDept{Id: "ud-1", UserId: "u-1", DeptId: importTestDeptId, IsPrimary: 1}).Error)
+	require.NoError(t, db.Create(&userdept.SysUserDept{Id: "ud-2", UserId: "u-1", DeptId: "dept-2", IsPrimary: 0}).Error)
+
+	role := "org_viewer"
+	require.NoError(t, db.Create(&rolebindings.RoleBinding{UserId: "u-1", OrgId: importTestDeptId, PermissionRole: &role}).Error)
+}
+
+// TestExportUsers_CSV_SelectedColumnsWithDeptsAndRoles 测试 CSV 导出指定列并包含部门、角色
+func TestExportUsers_CSV_SelectedColumnsWithDeptsAndRoles(t *testing.T) {
+	importLogic, db := setupBatchImportTest(t)
+	seedExportTestData(t, db)
+	logic := NewExportUsersLogic(context.Background(), importLogic.svcCtx)
+
+	export, err := logic.ExportUsers(&types.ExportUsersReq{
+		Format:    "csv",
+		Columns:   "name,primary_dept,aux_depts,roles",
+		SortField: "name",
+		SortOrder: "asc",
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(export.Filename, ".csv"))
+
+	var buf bytes.Buffer
+	require.NoError(t, export.Write(&buf))
+
+	records, err := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(buf.Bytes(), []byte("\xef\xbb\xbf")))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, []string{"姓名", "主部门", "辅助部门", "权限角色"}, records[0])
+	assert.Contains(t, records, []string{"张三", "研发部", "产品部", "org_viewer"})
+	// 未写入关联表时回退到用户表中的部门
+	assert.Contains(t, records, []string{"李四", "研发部", "", ""})
+}
+
+// TestExportUsers_XLSX_AppliesFilters 测试 XLSX 导出应用列表筛选条件
+func TestExportUsers_XLSX_AppliesFilters(t *testing.T) {
+	importLogic, db := setupBatchImportTest(t)
+	seedExportTestData(t, db)
+	logic := NewExportUsersLogic(context.Background(), importLogic.svcCtx)
+
+	export, err := logic.ExportUsers(&types.ExportUsersReq{Format: "xlsx", Status: 2, Columns: "email,status"})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, export.Write(&buf))
+
+	file, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer file.Close()
+	rows, err := file.GetRows(file.GetSheetName(0))
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"邮箱", "状态"}, {"lisi@example.com", "停用"}}, rows)
+}
+
+// TestExportUsers_UnknownColumn_ReturnsError 测试不支持的导出列
+func TestExportUsers_UnknownColumn_ReturnsError(t *testing.T) {
+	logic := NewExportUsersLogic(context.Background(), nil)
+
+	_, err := logic.ExportUsers(&types.ExportUsersReq{Format: "csv", Columns: "name,password"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "password")
+}
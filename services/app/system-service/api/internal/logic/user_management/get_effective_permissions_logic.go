@@ -0,0 +1,146 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user_management
+
+import (
+	"context"
+	"sort"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEffectivePermissionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询用户有效权限
+func NewGetEffectivePermissionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEffectivePermissionsLogic {
+	return &GetEffectivePermissionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetEffectivePermissionsLogic) GetEffectivePermissions(userId string) (resp *types.GetEffectivePermissionsResp, err error) {
+	// 1. 参数校验
+	if userId == "" {
+		return nil, baseErrorx.New(errorx.ErrUserManagementUserNotFound, "用户ID不能为空")
+	}
+
+	// 2. 校验用户存在
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, userId)
+	if err != nil || user == nil {
+		l.Errorf("查询用户信息失败: userId=%s, error=%v", userId, err)
+		return nil, baseErrorx.New(errorx.ErrUserManagementUserNotFound, "用户不存在")
+	}
+
+	// 3. 合并用户全部角色绑定（主部门与辅助部门）的已发布模板权限
+	effective, err := l.svcCtx.PermissionResolver.Effective(l.ctx, userId)
+	if err != nil {
+		l.Errorf("解析用户有效权限失败: userId=%s, error=%v", userId, err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+
+	// 4. 计算前端应启用的菜单/按钮权限标识
+	permissionKeys, err := l.grantedPermissionKeys(effective)
+	if err != nil {
+		l.Errorf("查询菜单权限标识失败: %v", err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+
+	// 5. 查询用户所属部门（查询失败不影响主流程）
+	departments := make([]types.UserDepartment, 0)
+	userDepts, err := l.svcCtx.UserDeptModel.FindByUserId(l.ctx, userId)
+	if err != nil {
+		l.Errorf("查询用户部门失败: userId=%s, error=%v", userId, err)
+	}
+	for _, ud := range userDepts {
+		departments = append(departments, types.UserDepartment{
+			DeptId:    ud.DeptId,
+			IsPrimary: ud.IsPrimary == 1,
+		})
+	}
+
+	// 6. 组装响应
+	return &types.GetEffectivePermissionsResp{
+		UserId:         userId,
+		Modules:        convertModulePermissions(effective.Modules),
+		AdvancedPerms:  effective.AdvancedPerms,
+		PermissionKeys: permissionKeys,
+		Departments:    departments,
+		Sources:        convertPermissionSources(effective.Grants),
+	}, nil
+}
+
+// grantedPermissionKeys 返回已启用且已绑定权限的菜单中，用户有权访问的权限标识（去重排序）
+func (l *GetEffectivePermissionsLogic) grantedPermissionKeys(effective *authz.EffectivePermissions) ([]string, error) {
+	enabled := true
+	menuList, err := l.svcCtx.MenuModel.FindTree(l.ctx, &menus.FindTreeReq{
+		Enabled:        &enabled,
+		PermissionBind: "bound",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{})
+	keys := make([]string, 0)
+	for _, menu := range menuList {
+		if menu.PermissionKey == nil || *menu.PermissionKey == "" {
+			continue
+		}
+		key := *menu.PermissionKey
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		if effective.AllowsKey(key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// convertModulePermissions 将合并后的模块权限转换为 API 类型
+func convertModulePermissions(modules map[string]*authz.ModulePermission) map[string]types.ModulePermission {
+	result := make(map[string]types.ModulePermission, len(modules))
+	for module, perm := range modules {
+		result[module] = types.ModulePermission{
+			Actions: perm.Actions,
+			Scopes:  perm.Scopes,
+			OrgIds:  perm.OrgIds,
+		}
+	}
+	return result
+}
+
+// convertPermissionSources 将授权来源转换为 API 类型
+func convertPermissionSources(grants []*authz.Grant) []types.PermissionSource {
+	sources := make([]types.PermissionSource, 0, len(grants))
+	for _, grant := range grants {
+		source := types.PermissionSource{
+			OrgId:           grant.Binding.OrgId,
+			TemplateId:      grant.Template.Id,
+			TemplateCode:    grant.Template.Code,
+			TemplateVersion: grant.Version,
+		}
+		if grant.Role != nil {
+			source.RoleId = grant.Role.Id
+			source.RoleCode = grant.Role.Code
+		}
+		sources = append(sources, source)
+	}
+	return sources
+}
@@ -0,0 +1,154 @@
+package user_management
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/testutil"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/userdept"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupEffectivePermissionsTest 创建测试用的 GetEffectivePermissionsLogic（SQLite）
+func setupEffectivePermissionsTest(t *testing.T) (*GetEffectivePermissionsLogic, *gorm.DB) {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(
+		&users.User{},
+		&userdept.SysUserDept{},
+		&rolebindings.RoleBinding{},
+	))
+
+	testutil.CreatePermissionTables(t, db)
+
+	// menus 使用 MySQL 专有默认值，SQLite 下手动建表
+	for _, ddl := range []string{
+		`CREATE TABLE IF NOT EXISTS menus (
+			id TEXT PRIMARY KEY, name TEXT NOT NULL, code TEXT NOT NULL, type TEXT NOT NULL,
+			group_id TEXT, parent_id TEXT, path TEXT, route_name TEXT, component_key TEXT,
+			external_url TEXT, open_mode TEXT, permission_key TEXT, icon TEXT,
+			visible INTEGER NOT NULL DEFAULT 1, enabled INTEGER NOT NULL DEFAULT 1,
+			"order" INTEGER NOT NULL DEFAULT 0, show_in_nav INTEGER NOT NULL DEFAULT 1,
+			cacheable INTEGER NOT NULL DEFAULT 0, created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			created_by TEXT, updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, updated_by TEXT,
+			deleted_at DATETIME
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	roleBindingModel := rolebindings.NewModel(db)
+	roleModel := roles.NewModel(db)
+	permissionTemplateModel := permissiontemplates.NewModel(db)
+	svcCtx := &svc.ServiceContext{
+		DB:                      db,
+		UserModel:               users.NewModel(db),
+		UserDeptModel:           userdept.NewModel(db),
+		RoleBindingModel:        roleBindingModel,
+		RoleModel:               roleModel,
+		PermissionTemplateModel: permissionTemplateModel,
+		MenuModel:               menus.NewModel(db),
+		PermissionResolver:      authz.NewResolver(roleBindingModel, roleModel, permissionTemplateModel, permissiontemplateversions.NewModel(db)),
+	}
+	return NewGetEffectivePermissionsLogic(context.Background(), svcCtx), db
+}
+
+// createEffectiveTestMenu 创建测试菜单
+func createEffectiveTestMenu(t *testing.T, db *gorm.DB, code, menuType, permissionKey string, enabled bool) {
+	menu := &menus.Menu{
+		Id:            "menu-" + code,
+		Name:          code,
+		Code:          code,
+		Type:          menuType,
+		PermissionKey: &permissionKey,
+		Visible:       true,
+		Enabled:       true,
+	}
+	require.NoError(t, db.Create(menu).Error)
+	if !enabled {
+		require.NoError(t, db.Model(menu).Update("enabled", false).Error)
+	}
+}
+
+// TestGetEffectivePermissions_MergesDepartmentsAndMenuKeys 测试合并主/辅部门绑定并计算菜单权限标识
+func TestGetEffectivePermissions_MergesDepartmentsAndMenuKeys(t *testing.T) {
+	logic, db := setupEffectivePermissionsTest(t)
+	now := time.Now()
+	userId := "user-1"
+
+	require.NoError(t, db.Create(&users.User{Id: userId, Name: "张三", Email: "zhangsan@example.com", Status: 1, AccountSource: "local"}).Error)
+	require.NoError(t, db.Create(&userdept.SysUserDept{Id: "ud-1", UserId: userId, DeptId: "dept-primary", IsPrimary: 1}).Error)
+	require.NoError(t, db.Create(&userdept.SysUserDept{Id: "ud-2", UserId: userId, DeptId: "dept-aux", IsPrimary: 0}).Error)
+
+	for _, tpl := range []*permissiontemplates.PermissionTemplate{
+		{Id: "tpl-viewer", Code: "viewer", Name: "viewer", Status: permissiontemplates.StatusPublished, Version: 2,
+			PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read"],"scope":"organization"}}`)},
+		{Id: "tpl-menu", Code: "menu_admin", Name: "menu_admin", Status: permissiontemplates.StatusPublished, Version: 1,
+			PolicyMatrix:  datatypes.JSON(`{"menu":{"actions":["*"],"scope":"global"}}`),
+			AdvancedPerms: datatypes.JSON(`{"audit:export":{"enabled":true}}`)},
+	} {
+		tpl.CreatedBy, tpl.CreatedAt, tpl.UpdatedAt = "system", now, now
+		require.NoError(t, db.Create(tpl).Error)
+	}
+	require.NoError(t, db.Create(&roles.Role{Id: "role-viewer", Code: "dept_viewer", Name: "部门查看", Scope: roles.ScopeOrganization,
+		TemplateId: "tpl-viewer", TemplateVersion: 2, TemplateAppliedAt: now, CreatedBy: "system", CreatedAt: now, UpdatedAt: now}).Error)
+
+	roleId, legacyRole := "role-viewer", "menu_admin"
+	require.NoError(t, db.Create(&rolebindings.RoleBinding{UserId: userId, OrgId: "dept-primary", RoleId: &roleId}).Error)
+	require.NoError(t, db.Create(&rolebindings.RoleBinding{UserId: userId, OrgId: "dept-aux", PermissionRole: &legacyRole}).Error)
+
+	createEffectiveTestMenu(t, db, "user_list", "page", "menu:user_list", true)
+	createEffectiveTestMenu(t, db, "user_delete", "button", "user:delete", true)
+	createEffectiveTestMenu(t, db, "audit_export", "button", "audit:export", true)
+	createEffectiveTestMenu(t, db, "legacy", "page", "menu:legacy", false)
+
+	resp, err := logic.GetEffectivePermissions(userId)
+
+	require.NoError(t, err)
+	assert.Equal(t, userId, resp.UserId)
+	require.Contains(t, resp.Modules, "user")
+	assert.Equal(t, []string{"read"}, resp.Modules["user"].Actions)
+	assert.Equal(t, []string{"organization"}, resp.Modules["user"].Scopes)
+	assert.Equal(t, []string{"dept-primary"}, resp.Modules["user"].OrgIds)
+	assert.Equal(t, []string{"*"}, resp.Modules["menu"].Actions)
+	assert.Equal(t, []string{"audit:export"}, resp.AdvancedPerms)
+	assert.Equal(t, []string{"audit:export", "menu:user_list"}, resp.PermissionKeys)
+	assert.Len(t, resp.Departments, 2)
+	require.Len(t, resp.Sources, 2)
+	assert.Equal(t, "dept_viewer", resp.Sources[0].RoleCode)
+	assert.Equal(t, 2, resp.Sources[0].TemplateVersion)
+	assert.Equal(t, "menu_admin", resp.Sources[1].TemplateCode)
+}
+
+// TestGetEffectivePermissions_UserNotFound 测试用户不存在
+func TestGetEffectivePermissions_UserNotFound(t *testing.T) {
+	logic, _ := setupEffectivePermissionsTest(t)
+
+	resp, err := logic.GetEffectivePermissions("missing-user")
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	codeErr, ok := err.(*baseErrorx.CodeError)
+	require.True(t, ok)
+	assert.Equal(t, errorx.ErrUserManagementUserNotFound, codeErr.Code)
+}
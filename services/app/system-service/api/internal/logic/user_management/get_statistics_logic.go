@@ -6,6 +6,7 @@ package user_management
 import (
 	"context"
 
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/datascope"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 
@@ -29,8 +30,15 @@ func NewGetStatisticsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Get
 }
 
 func (l *GetStatisticsLogic) GetStatistics() (resp *types.GetStatisticsResp, err error) {
-	// 1. 调用 Model.GetStatistics 获取统计数据
-	stats, err := l.svcCtx.UserModel.GetStatistics(l.ctx)
+	// 1. 解析调用方的数据范围
+	scope, err := l.svcCtx.DataScope.Resolve(l.ctx, datascope.ModuleUser)
+	if err != nil {
+		l.Errorf("解析数据范围失败: %v", err)
+		return nil, err
+	}
+
+	// 1.1 调用 Model.GetStatistics 获取数据范围内的统计数据
+	stats, err := l.svcCtx.UserModel.GetStatistics(l.ctx, scope.UserFilter())
 	if err != nil {
 		l.Errorf("获取统计数据失败: %v", err)
 		return nil, baseErrorx.New(50000, "获取统计数据失败")
@@ -87,7 +87,7 @@ func (m *MockUserModelForGetStatistics) BatchUpdateStatus(ctx context.Context, u
 	return successIds, errors, args.Error(2)
 }
 
-func (m *MockUserModelForGetStatistics) GetStatistics(ctx context.Context) (*users.Statistics, error) {
+func (m *MockUserModelForGetStatistics) GetStatistics(ctx context.Context, scope *users.DataScopeFilter) (*users.Statistics, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
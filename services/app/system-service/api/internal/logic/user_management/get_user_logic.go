@@ -119,6 +119,9 @@ func (l *GetUserLogic) convertRoleBindingsToResponse(roleBindings []*rolebinding
 		if rb.Position != nil {
 			roleBinding.Position = *rb.Position
 		}
+		if rb.RoleId != nil {
+			roleBinding.RoleId = *rb.RoleId
+		}
 		if rb.PermissionRole != nil {
 			roleBinding.PermissionRole = *rb.PermissionRole
 		}
@@ -93,7 +93,7 @@ func (m *MockUserModel) BatchUpdateStatus(ctx context.Context, userIds []string,
 	return successIds, errors, args.Error(2)
 }
 
-func (m *MockUserModel) GetStatistics(ctx context.Context) (*users.Statistics, error) {
+func (m *MockUserModel) GetStatistics(ctx context.Context, scope *users.DataScopeFilter) (*users.Statistics, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -140,6 +140,19 @@ func (m *MockRoleBindingModel) FindByUserId(ctx context.Context, userId string)
 	return args.Get(0).([]*rolebindings.RoleBinding), args.Error(1)
 }
 
+func (m *MockRoleBindingModel) CountByRoleId(ctx context.Context, roleId string) (int64, error) {
+	args := m.Called(ctx, roleId)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRoleBindingModel) FindUserIdsByRoleRefs(ctx context.Context, roleIds []string, permissionRoles []string) ([]string, error) {
+	args := m.Called(ctx, roleIds, permissionRoles)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (m *MockRoleBindingModel) FindOne(ctx context.Context, id int64) (*rolebindings.RoleBinding, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -158,6 +171,14 @@ func (m *MockRoleBindingModel) Delete(ctx context.Context, id int64) error {
 	return args.Error(0)
 }
 
+func (m *MockRoleBindingModel) FindByUserIds(ctx context.Context, userIds []string) ([]*rolebindings.RoleBinding, error) {
+	args := m.Called(ctx, userIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*rolebindings.RoleBinding), args.Error(1)
+}
+
 func (m *MockRoleBindingModel) DeleteByUserId(ctx context.Context, userId string) error {
 	args := m.Called(ctx, userId)
 	return args.Error(0)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/datascope"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
@@ -41,7 +42,14 @@ func (l *ListUsersLogic) ListUsers(req *types.ListUsersReq) (resp *types.ListUse
 		req.PageSize = 100
 	}
 
-	// 2. 构建 Model 层查询请求
+	// 2. 解析调用方的数据范围
+	scope, err := l.svcCtx.DataScope.Resolve(l.ctx, datascope.ModuleUser)
+	if err != nil {
+		l.Errorf("解析数据范围失败: %v", err)
+		return nil, err
+	}
+
+	// 2.1 构建 Model 层查询请求
 	findReq := &users.FindListReq{
 		Page:           req.Page,
 		PageSize:       req.PageSize,
@@ -51,6 +59,7 @@ func (l *ListUsersLogic) ListUsers(req *types.ListUsersReq) (resp *types.ListUse
 		PermissionRole: req.PermissionRole,
 		SortField:      req.SortField,
 		SortOrder:      req.SortOrder,
+		Scope:          scope.UserFilter(),
 	}
 
 	// 处理 Status 筛选
@@ -90,7 +90,7 @@ func (m *MockUserModelForList) BatchUpdateStatus(ctx context.Context, userIds []
 	return successIds, errors, args.Error(2)
 }
 
-func (m *MockUserModelForList) GetStatistics(ctx context.Context) (*users.Statistics, error) {
+func (m *MockUserModelForList) GetStatistics(ctx context.Context, scope *users.DataScopeFilter) (*users.Statistics, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -92,7 +92,7 @@ func (m *MockUserModelForResetPassword) BatchUpdateStatus(ctx context.Context, u
 	return successIds, errors, args.Error(2)
 }
 
-func (m *MockUserModelForResetPassword) GetStatistics(ctx context.Context) (*users.Statistics, error) {
+func (m *MockUserModelForResetPassword) GetStatistics(ctx context.Context, scope *users.DataScopeFilter) (*users.Statistics, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
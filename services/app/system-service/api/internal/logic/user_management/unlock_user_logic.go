@@ -132,6 +132,11 @@ func (l *UnlockUserLogic) UnlockUser(userId string, req *types.UnlockUserReq) (r
 		return nil, err
 	}
 
-	// 6. 返回响应
+	// 6. 清除登录失败计数，避免解锁后再次失败立即被重新锁定
+	if err := l.svcCtx.TokenStore.ResetLoginFailures(l.ctx, user.Email); err != nil {
+		l.Errorf("清除登录失败次数失败: userId=%s, error=%v", userId, err)
+	}
+
+	// 7. 返回响应
 	return &types.EmptyResp{}, nil
 }
@@ -110,7 +110,7 @@ func (m *MockUserModelForUnlock) Trans(ctx context.Context, fn func(ctx context.
 	return args.Error(0)
 }
 
-func (m *MockUserModelForUnlock) GetStatistics(ctx context.Context) (*users.Statistics, error) {
+func (m *MockUserModelForUnlock) GetStatistics(ctx context.Context, scope *users.DataScopeFilter) (*users.Statistics, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
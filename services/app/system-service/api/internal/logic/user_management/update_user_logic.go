@@ -15,7 +15,6 @@ import (
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 	auditlogs "github.com/DataSemanticHub/services/app/system-service/model/user/audit_logs"
-	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
 	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
 
 	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
@@ -190,19 +189,12 @@ func (l *UpdateUserLogic) UpdateUser(userId string, req *types.UpdateUserReq) (r
 
 			// 创建新的角色绑定
 			for _, rbInput := range req.RoleBindings {
-				roleBinding := &rolebindings.RoleBinding{
-					UserId: userId,
-					OrgId:  rbInput.OrgId,
-				}
-				if rbInput.Position != "" {
-					position := strings.TrimSpace(rbInput.Position)
-					roleBinding.Position = &position
-				}
-				if rbInput.PermissionRole != "" {
-					permissionRole := strings.TrimSpace(rbInput.PermissionRole)
-					roleBinding.PermissionRole = &permissionRole
+				roleBinding, err := newRoleBinding(l.ctx, l.svcCtx, userId, rbInput)
+				if err != nil {
+					l.Errorf("校验角色绑定失败: %v", err)
+					return err
 				}
-				_, err := roleBindingModel.Insert(l.ctx, roleBinding)
+				_, err = roleBindingModel.Insert(l.ctx, roleBinding)
 				if err != nil {
 					l.Errorf("创建角色绑定失败: %v", err)
 					return baseErrorx.New(50000, "创建角色绑定失败")
@@ -254,6 +246,11 @@ func (l *UpdateUserLogic) UpdateUser(userId string, req *types.UpdateUserReq) (r
 		return nil, err
 	}
 
-	// 7. 返回响应
+	// 7. 角色绑定变更后使该用户的授权快照失效
+	if req.RoleBindings != nil {
+		invalidatePermissionCache(l.ctx, l.svcCtx, userId)
+	}
+
+	// 8. 返回响应
 	return &types.EmptyResp{}, nil
 }
@@ -6,17 +6,28 @@ import (
 	"testing"
 	"time"
 
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/config"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/testutil"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
 	auditlogs "github.com/DataSemanticHub/services/app/system-service/model/user/audit_logs"
 	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
 	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 )
 
 // MockUserModelForUpdate 是 users.Model 的 mock 实现
@@ -110,7 +121,7 @@ func (m *MockUserModelForUpdate) Trans(ctx context.Context, fn func(ctx context.
 	return args.Error(0)
 }
 
-func (m *MockUserModelForUpdate) GetStatistics(ctx context.Context) (*users.Statistics, error) {
+func (m *MockUserModelForUpdate) GetStatistics(ctx context.Context, scope *users.DataScopeFilter) (*users.Statistics, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -139,6 +150,19 @@ func (m *MockRoleBindingModelForUpdate) FindByUserId(ctx context.Context, userId
 	return args.Get(0).([]*rolebindings.RoleBinding), args.Error(1)
 }
 
+func (m *MockRoleBindingModelForUpdate) CountByRoleId(ctx context.Context, roleId string) (int64, error) {
+	args := m.Called(ctx, roleId)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRoleBindingModelForUpdate) FindUserIdsByRoleRefs(ctx context.Context, roleIds []string, permissionRoles []string) ([]string, error) {
+	args := m.Called(ctx, roleIds, permissionRoles)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (m *MockRoleBindingModelForUpdate) FindOne(ctx context.Context, id int64) (*rolebindings.RoleBinding, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -157,6 +181,14 @@ func (m *MockRoleBindingModelForUpdate) Delete(ctx context.Context, id int64) er
 	return args.Error(0)
 }
 
+func (m *MockRoleBindingModelForUpdate) FindByUserIds(ctx context.Context, userIds []string) ([]*rolebindings.RoleBinding, error) {
+	args := m.Called(ctx, userIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*rolebindings.RoleBinding), args.Error(1)
+}
+
 func (m *MockRoleBindingModelForUpdate) DeleteByUserId(ctx context.Context, userId string) error {
 	args := m.Called(ctx, userId)
 	return args.Error(0)
@@ -530,3 +562,79 @@ func TestUpdateUser_NoChanges_NoAuditLog(t *testing.T) {
 
 	mockUserModel.AssertExpectations(t)
 }
+
+// setupPermissionCacheTest 创建带授权快照缓存的测试上下文（SQLite + miniredis）
+// user-1 通过 viewer 模板拥有 user:read 权限
+func setupPermissionCacheTest(t *testing.T) *svc.ServiceContext {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&users.User{}, &rolebindings.RoleBinding{}, &auditlogs.AuditLog{}))
+	testutil.CreatePermissionTables(t, db)
+
+	now := time.Now()
+	require.NoError(t, db.Create(&users.User{Id: "user-1", Name: "张三", Email: "zhangsan@example.com", Status: 1, AccountSource: "local"}).Error)
+	require.NoError(t, db.Create(&permissiontemplates.PermissionTemplate{
+		Id: "tpl-viewer", Name: "viewer", Code: "viewer", Status: permissiontemplates.StatusPublished,
+		PolicyMatrix: datatypes.JSON(`{"user":{"actions":["read"],"scope":"global"}}`),
+		Version:      1, CreatedBy: "system", CreatedAt: now, UpdatedAt: now,
+	}).Error)
+	viewer := "viewer"
+	require.NoError(t, db.Create(&rolebindings.RoleBinding{UserId: "user-1", OrgId: "dept-1", PermissionRole: &viewer}).Error)
+
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	cache := authz.NewCache(rdb, time.Minute)
+	resolver := authz.NewResolver(rolebindings.NewModel(db), roles.NewModel(db), permissiontemplates.NewModel(db), permissiontemplateversions.NewModel(db))
+	return &svc.ServiceContext{
+		DB:               db,
+		UserModel:        users.NewModel(db),
+		RoleBindingModel: rolebindings.NewModel(db),
+		RoleModel:        roles.NewModel(db),
+		AuditLogModel:    auditlogs.NewModel(db),
+		PermissionCache:  cache,
+		DecisionPoint:    authz.NewDecisionPoint(resolver, cache),
+	}
+}
+
+// TestUpdateUser_RoleBindingChange_InvalidatesCachedDecision 测试角色绑定变更后授权判定不再命中旧快照
+func TestUpdateUser_RoleBindingChange_InvalidatesCachedDecision(t *testing.T) {
+	svcCtx := setupPermissionCacheTest(t)
+	ctx := context.Background()
+
+	// 1. 判定结果写入缓存
+	decision, err := svcCtx.DecisionPoint.Decide(ctx, "user-1", "user", "read")
+	require.NoError(t, err)
+	require.True(t, decision.Allowed)
+
+	// 2. 移除全部角色绑定
+	_, err = NewUpdateUserLogic(ctx, svcCtx).UpdateUser("user-1", &types.UpdateUserReq{RoleBindings: []types.RoleBindingInput{}})
+	require.NoError(t, err)
+
+	// 3. 判定随绑定变更翻转
+	decision, err = svcCtx.DecisionPoint.Decide(ctx, "user-1", "user", "read")
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+}
+
+// TestDeleteUser_InvalidatesCachedSnapshot 测试删除（归档）用户后清除其授权快照缓存
+func TestDeleteUser_InvalidatesCachedSnapshot(t *testing.T) {
+	svcCtx := setupPermissionCacheTest(t)
+	ctx := context.Background()
+
+	_, err := svcCtx.DecisionPoint.Snapshot(ctx, "user-1")
+	require.NoError(t, err)
+	key, err := svcCtx.PermissionCache.Key(ctx, "user-1")
+	require.NoError(t, err)
+	cached, err := svcCtx.PermissionCache.Get(ctx, key)
+	require.NoError(t, err)
+	require.NotNil(t, cached)
+
+	_, err = NewDeleteUserLogic(ctx, svcCtx).DeleteUser("user-1", &types.DeleteUserReq{})
+	require.NoError(t, err)
+
+	cached, err = svcCtx.PermissionCache.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Nil(t, cached)
+}
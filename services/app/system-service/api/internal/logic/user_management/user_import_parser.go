@@ -0,0 +1,165 @@
+package user_management
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// 导入文件列（表头支持中文名称或字段名）
+const (
+	importColumnName           = "name"
+	importColumnEmail          = "email"
+	importColumnPhone          = "phone"
+	importColumnDeptId         = "dept_id"
+	importColumnAccountSource  = "account_source"
+	importColumnPosition       = "position"
+	importColumnPermissionRole = "permission_role"
+	importColumnPassword       = "initial_password"
+)
+
+// importHeaderAliases 表头别名 -> 列字段名
+var importHeaderAliases = map[string]string{
+	"姓名":                       importColumnName,
+	importColumnName:           importColumnName,
+	"邮箱":                       importColumnEmail,
+	importColumnEmail:          importColumnEmail,
+	"手机号":                      importColumnPhone,
+	importColumnPhone:          importColumnPhone,
+	"部门id":                     importColumnDeptId,
+	importColumnDeptId:         importColumnDeptId,
+	"账号来源":                     importColumnAccountSource,
+	importColumnAccountSource:  importColumnAccountSource,
+	"岗位":                       importColumnPosition,
+	importColumnPosition:       importColumnPosition,
+	"权限角色":                     importColumnPermissionRole,
+	importColumnPermissionRole: importColumnPermissionRole,
+	"初始密码":                     importColumnPassword,
+	importColumnPassword:       importColumnPassword,
+}
+
+// importRequiredColumns 导入文件必须包含的列
+var importRequiredColumns = []string{importColumnName, importColumnEmail, importColumnDeptId}
+
+var (
+	errImportUnsupportedFormat = errors.New("仅支持 CSV 或 XLSX 文件")
+	errImportEmptyFile         = errors.New("导入文件没有数据行")
+)
+
+// importRow 导入文件中的一行数据
+type importRow struct {
+	Row    int               // 文件中的行号（表头为第 1 行）
+	Fields map[string]string // 列字段名 -> 单元格内容（已去除首尾空白）
+}
+
+// parseImportFile 根据文件扩展名解析 CSV/XLSX 导入文件
+func parseImportFile(filename string, content []byte) ([]importRow, error) {
+	var records [][]string
+	var err error
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		records, err = readCSVRecords(content)
+	case ".xlsx":
+		records, err = readXLSXRecords(content)
+	default:
+		return nil, errImportUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buildImportRows(records)
+}
+
+// readCSVRecords 读取 CSV 记录（兼容 UTF-8 BOM）
+func readCSVRecords(content []byte) ([][]string, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var records [][]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("解析 CSV 文件失败: %w", err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// readXLSXRecords 读取 XLSX 第一个工作表的记录
+func readXLSXRecords(content []byte) ([][]string, error) {
+	file, err := excelize.OpenReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("解析 XLSX 文件失败: %w", err)
+	}
+	defer file.Close()
+
+	sheets := file.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, errImportEmptyFile
+	}
+	records, err := file.GetRows(sheets[0])
+	if err != nil {
+		return nil, fmt.Errorf("读取 XLSX 工作表失败: %w", err)
+	}
+	return records, nil
+}
+
+// buildImportRows 根据表头将记录转换为导入行，跳过空行
+func buildImportRows(records [][]string) ([]importRow, error) {
+	if len(records) == 0 {
+		return nil, errImportEmptyFile
+	}
+
+	// 1. 解析表头
+	columns := make([]string, len(records[0]))
+	present := make(map[string]bool, len(records[0]))
+	for i, header := range records[0] {
+		key := strings.ToLower(strings.TrimSpace(header))
+		if column, ok := importHeaderAliases[key]; ok {
+			columns[i] = column
+			present[column] = true
+		}
+	}
+	for _, column := range importRequiredColumns {
+		if !present[column] {
+			return nil, fmt.Errorf("导入文件缺少必填列: %s", column)
+		}
+	}
+
+	// 2. 解析数据行
+	rows := make([]importRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		fields := make(map[string]string, len(columns))
+		empty := true
+		for j, value := range record {
+			if j >= len(columns) || columns[j] == "" {
+				continue
+			}
+			value = strings.TrimSpace(value)
+			if value != "" {
+				empty = false
+			}
+			fields[columns[j]] = value
+		}
+		if empty {
+			continue
+		}
+		rows = append(rows, importRow{Row: i + 2, Fields: fields})
+	}
+	if len(rows) == 0 {
+		return nil, errImportEmptyFile
+	}
+	return rows, nil
+}
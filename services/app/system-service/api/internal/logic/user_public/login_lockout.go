@@ -0,0 +1,162 @@
+package user_public
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	auditlogs "github.com/DataSemanticHub/services/app/system-service/model/user/audit_logs"
+	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
+
+	"gorm.io/datatypes"
+)
+
+// 审计日志动作（与用户管理模块保持一致）
+const (
+	auditActionLock   = "lock"
+	auditActionUnlock = "unlock"
+)
+
+// ipLoginBlocked 判断来源 IP 是否因失败次数过多被暂时禁止登录
+func (l *LoginLogic) ipLoginBlocked(clientIP string) bool {
+	lockCfg := l.svcCtx.Config.LoginLock
+	if !lockCfg.Enabled || lockCfg.MaxIPFailures <= 0 || clientIP == "" {
+		return false
+	}
+
+	count, err := l.svcCtx.TokenStore.IPLoginFailures(l.ctx, clientIP)
+	if err != nil {
+		// 计数不可用时不阻断登录
+		l.Errorf("查询 IP 登录失败次数失败: ip=%s, error=%v", clientIP, err)
+		return false
+	}
+	return count >= lockCfg.MaxIPFailures
+}
+
+// recordLoginFailure 记录登录失败；账号失败次数达到阈值时自动锁定
+// user 为空（账号不存在）时仅统计来源 IP，返回账号是否在本次被锁定
+func (l *LoginLogic) recordLoginFailure(user *users.User, clientIP string) bool {
+	lockCfg := l.svcCtx.Config.LoginLock
+	if !lockCfg.Enabled {
+		return false
+	}
+
+	email := ""
+	if user != nil {
+		email = user.Email
+	}
+	failures, err := l.svcCtx.TokenStore.RecordLoginFailure(l.ctx, email, clientIP, time.Duration(lockCfg.Window)*time.Second)
+	if err != nil {
+		l.Errorf("记录登录失败次数失败: %v", err)
+		return false
+	}
+
+	// 仅对未激活、启用状态的账号自动锁定（已锁定、停用、归档的账号不重复处理）
+	if user == nil || (user.Status != 0 && user.Status != 1) {
+		return false
+	}
+	if lockCfg.MaxAccountFailures <= 0 || failures.Account < lockCfg.MaxAccountFailures {
+		return false
+	}
+
+	return l.autoLock(user, failures.Account) == nil
+}
+
+// autoLock 自动锁定账号（锁定人为系统），吊销已签发的 Token 并记录审计日志
+func (l *LoginLogic) autoLock(user *users.User, failures int64) error {
+	lockReason := fmt.Sprintf("连续登录失败 %d 次，系统自动锁定", failures)
+	lockBy := errorx.SystemOperatorID
+	if err := l.svcCtx.UserModel.UpdateStatus(l.ctx, user.Id, 3, &lockReason, &lockBy); err != nil {
+		l.Errorf("自动锁定用户失败: userId=%s, error=%v", user.Id, err)
+		return err
+	}
+
+	now := time.Now()
+	if err := l.svcCtx.TokenStore.RevokeUser(l.ctx, user.Id, now); err != nil {
+		l.Errorf("吊销被锁定用户的 Token 失败: userId=%s, error=%v", user.Id, err)
+	}
+
+	l.writeLockAudit(user.Id, auditActionLock, map[string]interface{}{
+		"status": map[string]interface{}{
+			"old": user.Status,
+			"new": 3,
+		},
+		"lock_reason": lockReason,
+		"lock_by":     lockBy,
+		"failures":    failures,
+	}, now)
+
+	user.Status = 3
+	user.LockReason = &lockReason
+	user.LockBy = &lockBy
+	user.LockTime = &now
+	return nil
+}
+
+// autoUnlockIfCooledDown 系统自动锁定的账号超过冷却时间后自动解锁
+// 仅处理锁定人为系统的账号，管理员手动锁定的账号仍需手动解锁
+func (l *LoginLogic) autoUnlockIfCooledDown(user *users.User) {
+	lockCfg := l.svcCtx.Config.LoginLock
+	if !lockCfg.Enabled || lockCfg.Cooldown <= 0 || user.Status != 3 {
+		return
+	}
+	if user.LockBy == nil || *user.LockBy != errorx.SystemOperatorID || user.LockTime == nil {
+		return
+	}
+
+	now := time.Now()
+	if now.Before(user.LockTime.Add(time.Duration(lockCfg.Cooldown) * time.Second)) {
+		return
+	}
+
+	if err := l.svcCtx.UserModel.UpdateStatus(l.ctx, user.Id, 1, nil, nil); err != nil {
+		l.Errorf("自动解锁用户失败: userId=%s, error=%v", user.Id, err)
+		return
+	}
+	if err := l.svcCtx.TokenStore.ResetLoginFailures(l.ctx, user.Email); err != nil {
+		l.Errorf("清除登录失败次数失败: userId=%s, error=%v", user.Id, err)
+	}
+
+	l.writeLockAudit(user.Id, auditActionUnlock, map[string]interface{}{
+		"status": map[string]interface{}{
+			"old": 3,
+			"new": 1,
+		},
+		"lock_reason": map[string]interface{}{
+			"old": user.LockReason,
+			"new": nil,
+		},
+		"lock_time": map[string]interface{}{
+			"old": user.LockTime,
+			"new": nil,
+		},
+		"lock_by": map[string]interface{}{
+			"old": user.LockBy,
+			"new": nil,
+		},
+		"reason": "冷却时间已过，系统自动解锁",
+	}, now)
+
+	user.Status = 1
+	user.LockReason = nil
+	user.LockTime = nil
+	user.LockBy = nil
+}
+
+// writeLockAudit 记录锁定/解锁审计日志（操作人为系统）
+func (l *LoginLogic) writeLockAudit(userId, action string, changes map[string]interface{}, at time.Time) {
+	changesJSON, _ := json.Marshal(changes)
+	auditLog := &auditlogs.AuditLog{
+		UserId:     userId,
+		Action:     action,
+		Operator:   errorx.SystemOperatorName,
+		OperatorId: errorx.SystemOperatorID,
+		Changes:    datatypes.JSON(changesJSON),
+		Timestamp:  at,
+	}
+	if _, err := l.svcCtx.AuditLogModel.Insert(l.ctx, auditLog); err != nil {
+		// 审计日志失败不影响主流程，仅记录错误
+		l.Errorf("记录审计日志失败: %v", err)
+	}
+}
@@ -8,11 +8,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/tokenstore"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
 
-	"github.com/golang-jwt/jwt/v4"
 	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
 	"github.com/zeromicro/go-zero/core/logx"
 	"golang.org/x/crypto/bcrypt"
@@ -39,22 +40,35 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 		return nil, err
 	}
 
-	// 2. 邮箱转小写查询用户
+	// 2. 来源 IP 失败次数过多时暂时禁止登录
+	clientIP, _ := l.ctx.Value(contextkeys.ClientIPKey).(string)
+	if l.ipLoginBlocked(clientIP) {
+		return nil, baseErrorx.New(errorx.ErrTooManyLoginAttempts, "登录失败次数过多，请稍后再试")
+	}
+
+	// 3. 邮箱转小写查询用户
 	email := strings.ToLower(strings.TrimSpace(req.Email))
 	user, err := l.svcCtx.UserModel.FindOneByEmail(l.ctx, email)
 	if err != nil || user == nil {
-		// 3. 统一错误提示：无论用户是否存在，统一返回"用户名或密码错误"
+		// 统一错误提示：无论用户是否存在，统一返回"用户名或密码错误"
+		l.recordLoginFailure(nil, clientIP)
 		return nil, baseErrorx.New(errorx.ErrPasswordIncorrect, "用户名或密码错误")
 	}
 
-	// 4. 验证密码（bcrypt.CompareHashAndPassword）
+	// 4. 系统自动锁定的账号超过冷却时间后自动解锁
+	l.autoUnlockIfCooledDown(user)
+
+	// 5. 验证密码（bcrypt.CompareHashAndPassword），失败计数达到阈值时自动锁定
 	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password))
 	if err != nil {
+		if l.recordLoginFailure(user, clientIP) {
+			return nil, baseErrorx.New(errorx.ErrAccountLocked, "登录失败次数过多，账号已锁定")
+		}
 		// 统一错误提示
 		return nil, baseErrorx.New(errorx.ErrPasswordIncorrect, "用户名或密码错误")
 	}
 
-	// 5. 检查用户状态并处理首次登录自动激活
+	// 6. 检查用户状态并处理首次登录自动激活
 	if user.Status == 0 {
 		// 未激活状态：首次登录时自动激活（更新状态为"启用"）
 		user.Status = 1
@@ -62,29 +76,53 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 			l.Errorf("激活用户失败: %v", err)
 			return nil, baseErrorx.New(50000, "系统错误")
 		}
+	} else if user.Status == 3 {
+		return nil, baseErrorx.New(errorx.ErrAccountLocked, "账号已锁定，请稍后再试或联系管理员")
 	} else if user.Status != 1 {
-		// 其他非启用状态（停用、锁定、归档）：不允许登录
+		// 其他非启用状态（停用、归档）：不允许登录
 		return nil, baseErrorx.New(errorx.ErrUserDisabled, "用户已被禁用")
 	}
 
-	// 6. 根据 RememberMe 生成 Token（普通24小时，记住我7天）
-	token, expiresIn, err := l.generateToken(user.Id, user.Email, req.RememberMe)
+	// 7. 登录成功，清除账号失败计数
+	if err := l.svcCtx.TokenStore.ResetLoginFailures(l.ctx, user.Email); err != nil {
+		l.Errorf("清除登录失败次数失败: %v", err)
+	}
+
+	// 8. 创建登录会话并签发刷新令牌（一个设备一个会话，记住我延长刷新令牌有效期）
+	userAgent, _ := l.ctx.Value(contextkeys.UserAgentKey).(string)
+	session := &tokenstore.Session{
+		UserId:     user.Id,
+		Email:      user.Email,
+		DeviceName: strings.TrimSpace(req.DeviceName),
+		UserAgent:  userAgent,
+		ClientIP:   clientIP,
+		RememberMe: req.RememberMe,
+	}
+	refreshToken, err := l.svcCtx.TokenStore.CreateSession(l.ctx, session, refreshTokenTTL(l.svcCtx, req.RememberMe))
+	if err != nil {
+		l.Errorf("创建登录会话失败: %v", err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+
+	// 9. 生成访问令牌（有效期取 Auth.AccessExpire）
+	token, expiresIn, err := generateAccessToken(l.svcCtx, user.Id, user.Email, session.Id)
 	if err != nil {
 		l.Errorf("生成Token失败: %v", err)
 		return nil, baseErrorx.New(50000, "系统错误")
 	}
 
-	// 7. 更新最后登录时间
+	// 10. 更新最后登录时间
 	now := time.Now()
 	if err := l.svcCtx.UserModel.UpdateLastLoginAt(l.ctx, user.Id, now); err != nil {
 		l.Errorf("更新最后登录时间失败: %v", err)
 		// 不影响登录流程，仅记录错误
 	}
 
-	// 8. 返回 Token 和用户信息
+	// 11. 返回 Token 和用户信息
 	return &types.LoginResp{
-		Token:     token,
-		ExpiresIn: expiresIn,
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresIn:    expiresIn,
 		UserInfo: types.UserInfo{
 			Id:           user.Id,
 			FirstName:    user.FirstName,
@@ -109,32 +147,3 @@ func (l *LoginLogic) validateLogin(req *types.LoginReq) error {
 
 	return nil
 }
-
-// generateToken 生成 JWT Token
-func (l *LoginLogic) generateToken(userID, email string, rememberMe bool) (string, int64, error) {
-	// 根据 rememberMe 设置 Token 有效期
-	var expire int64
-	if rememberMe {
-		expire = 604800 // 7天
-	} else {
-		expire = 86400 // 24小时
-	}
-
-	// 创建 JWT Claims
-	now := time.Now()
-	claims := jwt.MapClaims{
-		"user_id": userID,
-		"email":   email,
-		"exp":     now.Add(time.Duration(expire) * time.Second).Unix(),
-		"iat":     now.Unix(),
-	}
-
-	// 生成 Token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(l.svcCtx.Config.Auth.AccessSecret))
-	if err != nil {
-		return "", 0, err
-	}
-
-	return tokenString, expire, nil
-}
@@ -0,0 +1,190 @@
+package user_public
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/config"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/tokenstore"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+	auditlogs "github.com/DataSemanticHub/services/app/system-service/model/user/audit_logs"
+	"github.com/DataSemanticHub/services/app/system-service/model/user/users"
+
+	"github.com/alicebob/miniredis/v2"
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const testPassword = "Passw0rd!"
+
+// setupLoginTest 创建测试用的 ServiceContext（SQLite + miniredis）
+func setupLoginTest(t *testing.T, cooldown int64) (*svc.ServiceContext, *gorm.DB) {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&users.User{}, &auditlogs.AuditLog{}))
+
+	var cfg config.Config
+	cfg.Auth.AccessSecret = "test-secret-key-for-jwt-token-generation"
+	cfg.Auth.AccessExpire = 7200
+	cfg.RefreshToken.Expire = 3600
+	cfg.LoginLock.Enabled = true
+	cfg.LoginLock.MaxAccountFailures = 3
+	cfg.LoginLock.MaxIPFailures = 5
+	cfg.LoginLock.Window = 900
+	cfg.LoginLock.Cooldown = cooldown
+
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	return &svc.ServiceContext{
+		Config:        cfg,
+		DB:            db,
+		UserModel:     users.NewModel(db),
+		AuditLogModel: auditlogs.NewModel(db),
+		TokenStore:    tokenstore.NewStore(rdb, 0),
+	}, db
+}
+
+// createLoginTestUser 创建启用状态的测试用户
+func createLoginTestUser(t *testing.T, svcCtx *svc.ServiceContext, email string) *users.User {
+	hash, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	require.NoError(t, err)
+	user, err := svcCtx.UserModel.Insert(context.Background(), &users.User{
+		Id:           "user-" + email,
+		FirstName:    "Test",
+		LastName:     "User",
+		Email:        email,
+		PasswordHash: string(hash),
+		Status:       1,
+	})
+	require.NoError(t, err)
+	return user
+}
+
+// login 以指定来源 IP 执行登录
+func login(svcCtx *svc.ServiceContext, ip, email, password string) (*types.LoginResp, error) {
+	ctx := context.WithValue(context.Background(), contextkeys.ClientIPKey, ip)
+	return NewLoginLogic(ctx, svcCtx).Login(&types.LoginReq{Email: email, Password: password})
+}
+
+// errorCode 提取错误码
+func errorCode(t *testing.T, err error) int {
+	codeErr, ok := err.(*baseErrorx.CodeError)
+	require.True(t, ok, "unexpected error: %v", err)
+	return codeErr.Code
+}
+
+// TestLogin_RepeatedFailures_LocksAccount 测试账号连续失败达到阈值后自动锁定
+func TestLogin_RepeatedFailures_LocksAccount(t *testing.T) {
+	svcCtx, db := setupLoginTest(t, 0)
+	user := createLoginTestUser(t, svcCtx, "lock@example.com")
+
+	for i := 0; i < 2; i++ {
+		_, err := login(svcCtx, "10.0.0.1", user.Email, "wrong")
+		assert.Equal(t, errorx.ErrPasswordIncorrect, errorCode(t, err))
+	}
+	_, err := login(svcCtx, "10.0.0.1", user.Email, "wrong")
+	assert.Equal(t, errorx.ErrAccountLocked, errorCode(t, err))
+
+	locked, err := svcCtx.UserModel.FindOne(context.Background(), user.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int8(3), locked.Status)
+	require.NotNil(t, locked.LockBy)
+	assert.Equal(t, errorx.SystemOperatorID, *locked.LockBy)
+
+	// 锁定后即使密码正确也不允许登录
+	_, err = login(svcCtx, "10.0.0.2", user.Email, testPassword)
+	assert.Equal(t, errorx.ErrAccountLocked, errorCode(t, err))
+
+	var logs []auditlogs.AuditLog
+	require.NoError(t, db.Where("user_id = ?", user.Id).Find(&logs).Error)
+	require.Len(t, logs, 1)
+	assert.Equal(t, "lock", logs[0].Action)
+	assert.Equal(t, errorx.SystemOperatorID, logs[0].OperatorId)
+}
+
+// TestLogin_Success_ResetsFailures 测试登录成功后清除账号失败计数
+func TestLogin_Success_ResetsFailures(t *testing.T) {
+	svcCtx, _ := setupLoginTest(t, 0)
+	user := createLoginTestUser(t, svcCtx, "reset@example.com")
+
+	for i := 0; i < 2; i++ {
+		_, err := login(svcCtx, "10.0.0.1", user.Email, "wrong")
+		require.Error(t, err)
+	}
+	resp, err := login(svcCtx, "10.0.0.1", user.Email, testPassword)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Token)
+	assert.Equal(t, int64(7200), resp.ExpiresIn)
+
+	// 计数已清零，再失败两次不会锁定
+	for i := 0; i < 2; i++ {
+		_, err := login(svcCtx, "10.0.0.1", user.Email, "wrong")
+		assert.Equal(t, errorx.ErrPasswordIncorrect, errorCode(t, err))
+	}
+}
+
+// TestLogin_IPFailures_BlocksIP 测试来源 IP 失败次数过多时暂时禁止登录
+func TestLogin_IPFailures_BlocksIP(t *testing.T) {
+	svcCtx, _ := setupLoginTest(t, 0)
+	user := createLoginTestUser(t, svcCtx, "ip@example.com")
+
+	for i := 0; i < 5; i++ {
+		_, err := login(svcCtx, "10.0.0.9", "nobody@example.com", "wrong")
+		assert.Equal(t, errorx.ErrPasswordIncorrect, errorCode(t, err))
+	}
+
+	_, err := login(svcCtx, "10.0.0.9", user.Email, testPassword)
+	assert.Equal(t, errorx.ErrTooManyLoginAttempts, errorCode(t, err))
+
+	// 其他 IP 不受影响
+	_, err = login(svcCtx, "10.0.0.10", user.Email, testPassword)
+	require.NoError(t, err)
+}
+
+// TestLogin_CooldownElapsed_AutoUnlocks 测试系统锁定超过冷却时间后自动解锁
+func TestLogin_CooldownElapsed_AutoUnlocks(t *testing.T) {
+	svcCtx, db := setupLoginTest(t, 60)
+	user := createLoginTestUser(t, svcCtx, "cool@example.com")
+
+	lockReason := "连续登录失败 3 次，系统自动锁定"
+	lockBy := errorx.SystemOperatorID
+	require.NoError(t, svcCtx.UserModel.UpdateStatus(context.Background(), user.Id, 3, &lockReason, &lockBy))
+	require.NoError(t, db.Model(&users.User{}).Where("id = ?", user.Id).
+		Update("lock_time", time.Now().Add(-2*time.Minute)).Error)
+
+	resp, err := login(svcCtx, "10.0.0.1", user.Email, testPassword)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Token)
+
+	var logs []auditlogs.AuditLog
+	require.NoError(t, db.Where("user_id = ?", user.Id).Find(&logs).Error)
+	require.Len(t, logs, 1)
+	assert.Equal(t, "unlock", logs[0].Action)
+}
+
+// TestLogin_ManualLock_NotAutoUnlocked 测试管理员手动锁定的账号不会自动解锁
+func TestLogin_ManualLock_NotAutoUnlocked(t *testing.T) {
+	svcCtx, db := setupLoginTest(t, 60)
+	user := createLoginTestUser(t, svcCtx, "manual@example.com")
+
+	lockReason := "安全审查"
+	lockBy := "admin-1"
+	require.NoError(t, svcCtx.UserModel.UpdateStatus(context.Background(), user.Id, 3, &lockReason, &lockBy))
+	require.NoError(t, db.Model(&users.User{}).Where("id = ?", user.Id).
+		Update("lock_time", time.Now().Add(-2*time.Minute)).Error)
+
+	_, err := login(svcCtx, "10.0.0.1", user.Email, testPassword)
+	assert.Equal(t, errorx.ErrAccountLocked, errorCode(t, err))
+}
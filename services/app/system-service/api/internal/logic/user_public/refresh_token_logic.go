@@ -0,0 +1,78 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user_public
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/tokenstore"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/types"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RefreshTokenLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 刷新访问令牌
+func NewRefreshTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefreshTokenLogic {
+	return &RefreshTokenLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RefreshTokenLogic) RefreshToken(req *types.RefreshTokenReq) (resp *types.RefreshTokenResp, err error) {
+	// 1. 参数校验
+	refreshToken := strings.TrimSpace(req.RefreshToken)
+	if refreshToken == "" {
+		return nil, baseErrorx.New(20001, "刷新令牌不能为空")
+	}
+
+	// 2. 轮换刷新令牌（旧令牌作废，重复使用将吊销整个会话；有效期沿用会话创建时的设置）
+	session, newRefreshToken, err := l.svcCtx.TokenStore.RotateRefreshToken(l.ctx, refreshToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, tokenstore.ErrRefreshTokenInvalid):
+			return nil, baseErrorx.New(errorx.ErrRefreshTokenInvalid, "刷新令牌无效或已过期")
+		case errors.Is(err, tokenstore.ErrRefreshTokenReused):
+			l.Infof("检测到刷新令牌重复使用，已吊销对应会话")
+			return nil, baseErrorx.New(errorx.ErrRefreshTokenReused, "刷新令牌已失效，请重新登录")
+		default:
+			l.Errorf("轮换刷新令牌失败: %v", err)
+			return nil, baseErrorx.New(50000, "系统错误")
+		}
+	}
+
+	// 3. 检查用户状态（停用、锁定、归档的用户不允许续期）
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, session.UserId)
+	if err != nil || user == nil || user.Status != 1 {
+		if revokeErr := l.svcCtx.TokenStore.RevokeSession(l.ctx, session.UserId, session.Id); revokeErr != nil {
+			l.Errorf("吊销会话失败: sessionId=%s, error=%v", session.Id, revokeErr)
+		}
+		return nil, baseErrorx.New(errorx.ErrUserDisabled, "用户已被禁用")
+	}
+
+	// 4. 签发新的访问令牌
+	token, expiresIn, err := generateAccessToken(l.svcCtx, user.Id, user.Email, session.Id)
+	if err != nil {
+		l.Errorf("生成Token失败: %v", err)
+		return nil, baseErrorx.New(50000, "系统错误")
+	}
+
+	return &types.RefreshTokenResp{
+		Token:        token,
+		RefreshToken: newRefreshToken,
+		ExpiresIn:    expiresIn,
+	}, nil
+}
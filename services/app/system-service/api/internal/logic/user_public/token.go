@@ -0,0 +1,67 @@
+package user_public
+
+import (
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/svc"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultAccessTokenExpire 访问令牌默认有效期（2小时）
+	defaultAccessTokenExpire int64 = 7200
+	// defaultRefreshTokenExpire 普通登录刷新令牌默认有效期（1天）
+	defaultRefreshTokenExpire int64 = 86400
+	// defaultRefreshTokenExpireRememberMe 记住我刷新令牌默认有效期（30天）
+	defaultRefreshTokenExpireRememberMe int64 = 2592000
+)
+
+// generateAccessToken 生成 JWT 访问令牌，有效期取 Auth.AccessExpire（记住我只延长刷新令牌）
+// sid 为登录会话 ID，吊销会话时该会话签发的访问令牌一并失效
+func generateAccessToken(svcCtx *svc.ServiceContext, userID, email, sessionID string) (string, int64, error) {
+	expire := svcCtx.Config.Auth.AccessExpire
+	if expire <= 0 {
+		expire = defaultAccessTokenExpire
+	}
+
+	// 生成 Token ID（jti），用于退出登录时吊销单个 Token
+	jti, err := uuid.NewV7()
+	if err != nil {
+		return "", 0, err
+	}
+
+	// 创建 JWT Claims
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"user_id": userID,
+		"email":   email,
+		"jti":     jti.String(),
+		"sid":     sessionID,
+		"exp":     now.Add(time.Duration(expire) * time.Second).Unix(),
+		"iat":     now.Unix(),
+		"iat_ms":  now.UnixMilli(), // 毫秒精度签发时间，区分同一秒内吊销前后签发的 Token
+	}
+
+	// 生成 Token
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString([]byte(svcCtx.Config.Auth.AccessSecret))
+	if err != nil {
+		return "", 0, err
+	}
+
+	return tokenString, expire, nil
+}
+
+// refreshTokenTTL 刷新令牌有效期，记住我使用更长的有效期
+func refreshTokenTTL(svcCtx *svc.ServiceContext, rememberMe bool) time.Duration {
+	expire, fallback := svcCtx.Config.RefreshToken.Expire, defaultRefreshTokenExpire
+	if rememberMe {
+		expire, fallback = svcCtx.Config.RefreshToken.RememberMeExpire, defaultRefreshTokenExpireRememberMe
+	}
+	if expire <= 0 {
+		expire = fallback
+	}
+	return time.Duration(expire) * time.Second
+}
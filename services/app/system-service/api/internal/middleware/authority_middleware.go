@@ -3,20 +3,83 @@
 
 package middleware
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
 
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/tokenstore"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AuthorityMiddleware 认证 + 鉴权中间件
+// 解析 JWT 注入当前用户 ID，再按路由权限声明校验用户的权限模板
 type AuthorityMiddleware struct {
+	accessSecret string
+	store        *tokenstore.Store
+	checker      *PermissionChecker
+	routes       *RoutePermissionTable
 }
 
-func NewAuthorityMiddleware() *AuthorityMiddleware {
-	return &AuthorityMiddleware{}
+func NewAuthorityMiddleware(accessSecret string, store *tokenstore.Store, checker *PermissionChecker, routes *RoutePermissionTable) *AuthorityMiddleware {
+	return &AuthorityMiddleware{
+		accessSecret: accessSecret,
+		store:        store,
+		checker:      checker,
+		routes:       routes,
+	}
 }
 
 func (m *AuthorityMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// TODO generate middleware implement function, delete after code implementation
+		// 1. 解析 JWT，获取当前用户 ID
+		claims, err := parseTokenFromRequest(r, m.accessSecret)
+		if err != nil {
+			logx.WithContext(r.Context()).Infof("Token 校验失败: %v", err)
+			httpx.ErrorCtx(r.Context(), w, baseErrorx.New(errorx.ErrTokenInvalid, "Token 无效或已过期"))
+			return
+		}
+
+		// 2. 校验 Token 是否已吊销
+		if err := checkRevoked(r, m.store, claims); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 3. 注入用户 ID，供后续 Logic 使用
+		r = r.WithContext(withTokenClaims(r.Context(), claims))
+
+		// 4. 校验路由权限
+		if err := authorize(r, m.checker, m.routes, claims.UserId); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
 
-		// Passthrough to next handler if need
 		next(w, r)
 	}
 }
+
+// authorize 根据路由权限声明校验用户权限
+func authorize(r *http.Request, checker *PermissionChecker, routes *RoutePermissionTable, userId string) error {
+	ctx := r.Context()
+
+	rule, ok := routes.Match(r.Method, r.URL.Path)
+	if !ok {
+		logx.WithContext(ctx).Errorf("路由未声明所需权限: %s %s", r.Method, r.URL.Path)
+		return baseErrorx.New(errorx.ErrPermissionRuleNotDeclared, "路由未声明所需权限")
+	}
+
+	allowed, err := checker.Allowed(ctx, userId, rule.Module, rule.Action)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("权限校验失败: userId=%s, %v", userId, err)
+		return baseErrorx.New(50000, "系统错误")
+	}
+	if !allowed {
+		return baseErrorx.New(errorx.ErrForbidden, fmt.Sprintf("无访问权限: %s:%s", rule.Module, rule.Action))
+	}
+
+	return nil
+}
@@ -0,0 +1,294 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/testutil"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/tokenstore"
+	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
+	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/jinguoxing/idrm-go-base/response"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const testAccessSecret = "test-access-secret"
+
+// setupAuthorityTestDB 创建测试数据库
+func setupAuthorityTestDB(t *testing.T) *gorm.DB {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(&rolebindings.RoleBinding{})
+	require.NoError(t, err)
+
+	testutil.CreatePermissionTables(t, db)
+
+	return db
+}
+
+// createTestTemplate 创建测试权限模板
+func createTestTemplate(t *testing.T, db *gorm.DB, code, status, policyMatrix string) {
+	now := time.Now()
+	template := &permissiontemplates.PermissionTemplate{
+		Id:           "tpl-" + code,
+		Name:         code,
+		Code:         code,
+		Status:       status,
+		PolicyMatrix: datatypes.JSON(policyMatrix),
+		Version:      1,
+		CreatedBy:    "system",
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	require.NoError(t, db.Create(template).Error)
+}
+
+// createTestBinding 创建测试角色绑定
+func createTestBinding(t *testing.T, db *gorm.DB, userId, role string) {
+	binding := &rolebindings.RoleBinding{
+		UserId:         userId,
+		OrgId:          "org-1",
+		PermissionRole: &role,
+	}
+	require.NoError(t, db.Create(binding).Error)
+}
+
+// createTestRole 创建引用指定模板的测试角色
+func createTestRole(t *testing.T, db *gorm.DB, code, templateCode string) string {
+	now := time.Now()
+	role := &roles.Role{
+		Id:                "role-" + code,
+		Code:              code,
+		Name:              code,
+		Scope:             roles.ScopeGlobal,
+		TemplateId:        "tpl-" + templateCode,
+		TemplateVersion:   1,
+		TemplateAppliedAt: now,
+		CreatedBy:         "system",
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	require.NoError(t, db.Create(role).Error)
+	return role.Id
+}
+
+// signTestToken 签发测试 Token
+func signTestToken(t *testing.T, userId string) string {
+	claims := jwt.MapClaims{
+		"user_id": userId,
+		"email":   userId + "@example.com",
+		"exp":     time.Now().Add(time.Hour).Unix(),
+		"iat":     time.Now().Unix(),
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testAccessSecret))
+	require.NoError(t, err)
+	return token
+}
+
+// newTestAuthority 创建测试用 Authority 中间件
+func newTestAuthority(t *testing.T, db *gorm.DB) *AuthorityMiddleware {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	checker := NewPermissionChecker(authz.NewResolver(rolebindings.NewModel(db), roles.NewModel(db), permissiontemplates.NewModel(db), permissiontemplateversions.NewModel(db)))
+	return NewAuthorityMiddleware(testAccessSecret, tokenstore.NewStore(rdb, 0), checker, NewRoutePermissionTable(DefaultRoutePermissions))
+}
+
+// serveAuthority 执行中间件，返回响应错误码（0 表示放行）和下游拿到的用户 ID
+func serveAuthority(t *testing.T, m *AuthorityMiddleware, method, path, token string) (int, string) {
+	response.InitErrorHandler()
+
+	var gotUserId string
+	next := func(w http.ResponseWriter, r *http.Request) {
+		gotUserId, _ = r.Context().Value(contextkeys.UserIDKey).(string)
+		w.WriteHeader(http.StatusOK)
+	}
+
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	m.Handle(next)(rec, req)
+
+	if rec.Body.Len() == 0 {
+		return 0, gotUserId
+	}
+	var body struct {
+		Code int `json:"code"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body.Code, gotUserId
+}
+
+// TestAuthority_MissingToken_ReturnsTokenInvalid 测试缺少 Token 时拒绝访问
+func TestAuthority_MissingToken_ReturnsTokenInvalid(t *testing.T) {
+	db := setupAuthorityTestDB(t)
+	m := newTestAuthority(t, db)
+
+	code, _ := serveAuthority(t, m, http.MethodGet, "/api/v1/system/organization/tree", "")
+	assert.Equal(t, errorx.ErrTokenInvalid, code)
+}
+
+// TestAuthority_PublishedTemplateAllows_InjectsUserId 测试已发布模板授权通过并注入用户 ID
+func TestAuthority_PublishedTemplateAllows_InjectsUserId(t *testing.T) {
+	db := setupAuthorityTestDB(t)
+	createTestTemplate(t, db, "org_viewer", permissiontemplates.StatusPublished,
+		`{"organization": {"actions": ["read"], "scope": "organization"}}`)
+	createTestBinding(t, db, "user-1", "org_viewer")
+	m := newTestAuthority(t, db)
+
+	code, userId := serveAuthority(t, m, http.MethodGet, "/api/v1/system/organization/tree", signTestToken(t, "user-1"))
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "user-1", userId)
+}
+
+// TestAuthority_ActionNotGranted_ReturnsForbidden 测试模板未授予动作时拒绝访问
+func TestAuthority_ActionNotGranted_ReturnsForbidden(t *testing.T) {
+	db := setupAuthorityTestDB(t)
+	createTestTemplate(t, db, "org_viewer", permissiontemplates.StatusPublished,
+		`{"organization": {"actions": ["read"], "scope": "organization"}}`)
+	createTestBinding(t, db, "user-1", "org_viewer")
+	m := newTestAuthority(t, db)
+
+	code, _ := serveAuthority(t, m, http.MethodDelete, "/api/v1/system/organization/org-1", signTestToken(t, "user-1"))
+	assert.Equal(t, errorx.ErrForbidden, code)
+}
+
+// TestAuthority_DraftTemplate_Ignored 测试未发布模板不参与授权
+func TestAuthority_DraftTemplate_Ignored(t *testing.T) {
+	db := setupAuthorityTestDB(t)
+	createTestTemplate(t, db, "org_admin", permissiontemplates.StatusDraft,
+		`{"organization": {"actions": ["read"], "scope": "global"}}`)
+	createTestBinding(t, db, "user-1", "org_admin")
+	m := newTestAuthority(t, db)
+
+	code, _ := serveAuthority(t, m, http.MethodGet, "/api/v1/system/organization/tree", signTestToken(t, "user-1"))
+	assert.Equal(t, errorx.ErrForbidden, code)
+}
+
+// TestAuthority_WildcardTemplate_AllowsAll 测试通配符模板拥有全部权限
+func TestAuthority_WildcardTemplate_AllowsAll(t *testing.T) {
+	db := setupAuthorityTestDB(t)
+	createTestTemplate(t, db, "super_admin", permissiontemplates.StatusPublished,
+		`{"*": {"actions": ["*"], "scope": "global"}}`)
+	createTestBinding(t, db, "admin", "super_admin")
+	m := newTestAuthority(t, db)
+
+	code, _ := serveAuthority(t, m, http.MethodPost, "/api/v1/system/permission-templates/tpl-1/publish", signTestToken(t, "admin"))
+	assert.Equal(t, 0, code)
+}
+
+// TestAuthority_RoleIdBinding_UsesRoleTemplate 测试通过 RoleId 引用角色时按角色来源模板授权
+func TestAuthority_RoleIdBinding_UsesRoleTemplate(t *testing.T) {
+	db := setupAuthorityTestDB(t)
+	createTestTemplate(t, db, "org_manager", permissiontemplates.StatusPublished,
+		`{"organization": {"actions": ["read", "delete"], "scope": "organization"}}`)
+	roleId := createTestRole(t, db, "dept_manager", "org_manager")
+	binding := &rolebindings.RoleBinding{UserId: "user-1", OrgId: "org-1", RoleId: &roleId}
+	require.NoError(t, db.Create(binding).Error)
+	m := newTestAuthority(t, db)
+
+	code, _ := serveAuthority(t, m, http.MethodDelete, "/api/v1/system/organization/org-1", signTestToken(t, "user-1"))
+	assert.Equal(t, 0, code)
+}
+
+// TestAuthority_RoleCodeBinding_DisabledTemplateIgnored 测试按角色编码解析时，来源模板已停用则不参与授权
+func TestAuthority_RoleCodeBinding_DisabledTemplateIgnored(t *testing.T) {
+	db := setupAuthorityTestDB(t)
+	createTestTemplate(t, db, "org_manager", permissiontemplates.StatusDisabled,
+		`{"organization": {"actions": ["read"], "scope": "organization"}}`)
+	createTestRole(t, db, "dept_manager", "org_manager")
+	createTestBinding(t, db, "user-1", "dept_manager")
+	m := newTestAuthority(t, db)
+
+	code, _ := serveAuthority(t, m, http.MethodGet, "/api/v1/system/organization/tree", signTestToken(t, "user-1"))
+	assert.Equal(t, errorx.ErrForbidden, code)
+}
+
+// TestAuthority_UndeclaredRoute_Rejected 测试未声明权限的路由被拒绝
+func TestAuthority_UndeclaredRoute_Rejected(t *testing.T) {
+	db := setupAuthorityTestDB(t)
+	createTestTemplate(t, db, "super_admin", permissiontemplates.StatusPublished,
+		`{"*": {"actions": ["*"], "scope": "global"}}`)
+	createTestBinding(t, db, "admin", "super_admin")
+	m := newTestAuthority(t, db)
+
+	code, _ := serveAuthority(t, m, http.MethodGet, "/api/v1/system/unknown", signTestToken(t, "admin"))
+	assert.Equal(t, errorx.ErrPermissionRuleNotDeclared, code)
+}
+
+// TestRoutePermissionTable_StaticSegmentPreferred 测试静态路径段优先于占位段
+func TestRoutePermissionTable_StaticSegmentPreferred(t *testing.T) {
+	table := NewRoutePermissionTable([]RoutePermission{
+		{Method: http.MethodPost, Path: "/api/v1/system/organization/:id", Module: ModuleOrganization, Action: ActionUpdate},
+		{Method: http.MethodPost, Path: "/api/v1/system/organization/move", Module: ModuleOrganization, Action: ActionCreate},
+	})
+
+	rule, ok := table.Match(http.MethodPost, "/api/v1/system/organization/move")
+	require.True(t, ok)
+	assert.Equal(t, ActionCreate, rule.Action)
+
+	rule, ok = table.Match(http.MethodPost, "/api/v1/system/organization/org-1")
+	require.True(t, ok)
+	assert.Equal(t, ActionUpdate, rule.Action)
+
+	_, ok = table.Match(http.MethodGet, "/api/v1/system/organization/org-1")
+	assert.False(t, ok)
+}
+
+// TestAuthority_UserManagementRoutes 测试用户管理敏感接口按 user 模块权限鉴权
+func TestAuthority_UserManagementRoutes(t *testing.T) {
+	db := setupAuthorityTestDB(t)
+	createTestTemplate(t, db, "user_viewer", permissiontemplates.StatusPublished,
+		`{"user": {"actions": ["read"], "scope": "global"}}`)
+	createTestTemplate(t, db, "user_admin", permissiontemplates.StatusPublished,
+		`{"user": {"actions": ["create", "read", "update", "delete"], "scope": "global"}}`)
+	createTestBinding(t, db, "viewer", "user_viewer")
+	createTestBinding(t, db, "admin", "user_admin")
+	m := newTestAuthority(t, db)
+
+	tests := []struct {
+		method string
+		path   string
+		viewer int // 仅有 user:read 的用户得到的错误码
+	}{
+		{http.MethodGet, "/api/v1/user_management/users/user-2/effective-permissions", 0},
+		{http.MethodPost, "/api/v1/user_management/users/user-2/force-logout", errorx.ErrForbidden},
+		{http.MethodPost, "/api/v1/user_management/users/batch-import", errorx.ErrForbidden},
+		{http.MethodPost, "/api/v1/system/jobs/user-import", errorx.ErrForbidden},
+		{http.MethodGet, "/api/v1/user_management/users/export", 0},
+		{http.MethodPost, "/api/v1/system/jobs/user-export", 0},
+	}
+	for _, tt := range tests {
+		// 未绑定任何角色的登录用户
+		code, _ := serveAuthority(t, m, tt.method, tt.path, signTestToken(t, "outsider"))
+		assert.Equal(t, errorx.ErrForbidden, code, "%s %s", tt.method, tt.path)
+
+		code, _ = serveAuthority(t, m, tt.method, tt.path, signTestToken(t, "viewer"))
+		assert.Equal(t, tt.viewer, code, "%s %s", tt.method, tt.path)
+
+		code, _ = serveAuthority(t, m, tt.method, tt.path, signTestToken(t, "admin"))
+		assert.Equal(t, 0, code, "%s %s", tt.method, tt.path)
+	}
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+)
+
+// PermissionChecker 基于已发布权限模板的权限校验器
+// 角色绑定到权限模板的解析规则见 authz.Resolver
+type PermissionChecker struct {
+	resolver *authz.Resolver
+}
+
+// NewPermissionChecker 创建权限校验器
+func NewPermissionChecker(resolver *authz.Resolver) *PermissionChecker {
+	return &PermissionChecker{
+		resolver: resolver,
+	}
+}
+
+// Allowed 判断用户是否拥有指定模块的动作权限
+func (c *PermissionChecker) Allowed(ctx context.Context, userId, module, action string) (bool, error) {
+	return c.resolver.Allowed(ctx, userId, module, action)
+}
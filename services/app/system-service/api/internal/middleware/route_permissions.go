@@ -0,0 +1,185 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+// 权限模块
+const (
+	ModuleOrganization       = "organization"
+	ModuleUser               = "user"
+	ModulePermissionTemplate = "permission_template"
+	ModuleRole               = "role"
+	ModuleAudit              = "audit"
+	ModuleAuthz              = "authz"
+	ModulePermissionCatalog  = "permission_catalog"
+	ModulePermissionPoint    = "permission_point"
+	ModuleMenu               = "menu"
+)
+
+// 权限动作（与权限模板策略矩阵中的 actions 保持一致）
+const (
+	ActionCreate  = "create"
+	ActionRead    = "read"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionPublish = "publish"
+	ActionCheck   = "check"
+)
+
+// RoutePermission 路由所需的权限声明
+type RoutePermission struct {
+	Method string // HTTP 方法
+	Path   string // 完整路由路径，支持 :param 占位
+	Module string // 所需模块
+	Action string // 所需动作
+}
+
+// DefaultRoutePermissions 受 Authority 中间件保护的路由权限声明
+// 新增受保护路由时需在此处登记，未登记的路由将被拒绝访问
+var DefaultRoutePermissions = []RoutePermission{
+	// 组织架构
+	{Method: http.MethodPost, Path: "/api/v1/system/organization", Module: ModuleOrganization, Action: ActionCreate},
+	{Method: http.MethodGet, Path: "/api/v1/system/organization/:id", Module: ModuleOrganization, Action: ActionRead},
+	{Method: http.MethodPut, Path: "/api/v1/system/organization/:id", Module: ModuleOrganization, Action: ActionUpdate},
+	{Method: http.MethodDelete, Path: "/api/v1/system/organization/:id", Module: ModuleOrganization, Action: ActionDelete},
+	{Method: http.MethodGet, Path: "/api/v1/system/organization/:id/users", Module: ModuleOrganization, Action: ActionRead},
+	{Method: http.MethodPost, Path: "/api/v1/system/organization/move", Module: ModuleOrganization, Action: ActionUpdate},
+	{Method: http.MethodGet, Path: "/api/v1/system/organization/tree", Module: ModuleOrganization, Action: ActionRead},
+	{Method: http.MethodDelete, Path: "/api/v1/system/user/:userId/aux-dept/:deptId", Module: ModuleOrganization, Action: ActionUpdate},
+	{Method: http.MethodPost, Path: "/api/v1/system/user/aux-dept", Module: ModuleOrganization, Action: ActionUpdate},
+	{Method: http.MethodPost, Path: "/api/v1/system/user/primary-dept", Module: ModuleOrganization, Action: ActionUpdate},
+
+	// 用户管理
+	{Method: http.MethodGet, Path: "/api/v1/user_management/users/:id/effective-permissions", Module: ModuleUser, Action: ActionRead},
+	{Method: http.MethodPost, Path: "/api/v1/user_management/users/:id/force-logout", Module: ModuleUser, Action: ActionUpdate},
+	{Method: http.MethodPost, Path: "/api/v1/user_management/users/batch-import", Module: ModuleUser, Action: ActionCreate},
+	{Method: http.MethodPost, Path: "/api/v1/system/jobs/user-import", Module: ModuleUser, Action: ActionCreate},
+	{Method: http.MethodGet, Path: "/api/v1/user_management/users/export", Module: ModuleUser, Action: ActionRead},
+	{Method: http.MethodPost, Path: "/api/v1/system/jobs/user-export", Module: ModuleUser, Action: ActionRead},
+
+	// 权限模板
+	{Method: http.MethodPost, Path: "/api/v1/system/permission-templates", Module: ModulePermissionTemplate, Action: ActionCreate},
+	{Method: http.MethodGet, Path: "/api/v1/system/permission-templates", Module: ModulePermissionTemplate, Action: ActionRead},
+	{Method: http.MethodPut, Path: "/api/v1/system/permission-templates/:id", Module: ModulePermissionTemplate, Action: ActionUpdate},
+	{Method: http.MethodGet, Path: "/api/v1/system/permission-templates/:id", Module: ModulePermissionTemplate, Action: ActionRead},
+	{Method: http.MethodDelete, Path: "/api/v1/system/permission-templates/:id", Module: ModulePermissionTemplate, Action: ActionDelete},
+	{Method: http.MethodPost, Path: "/api/v1/system/permission-templates/:id/clone", Module: ModulePermissionTemplate, Action: ActionCreate},
+	{Method: http.MethodPost, Path: "/api/v1/system/permission-templates/:id/discard-draft", Module: ModulePermissionTemplate, Action: ActionUpdate},
+	{Method: http.MethodPost, Path: "/api/v1/system/permission-templates/:id/disable", Module: ModulePermissionTemplate, Action: ActionPublish},
+	{Method: http.MethodPost, Path: "/api/v1/system/permission-templates/:id/enable", Module: ModulePermissionTemplate, Action: ActionPublish},
+	{Method: http.MethodPost, Path: "/api/v1/system/permission-templates/:id/publish", Module: ModulePermissionTemplate, Action: ActionPublish},
+	{Method: http.MethodGet, Path: "/api/v1/system/permission-templates/:id/impact", Module: ModulePermissionTemplate, Action: ActionPublish},
+	{Method: http.MethodGet, Path: "/api/v1/system/permission-templates/:id/versions", Module: ModulePermissionTemplate, Action: ActionRead},
+	{Method: http.MethodGet, Path: "/api/v1/system/permission-templates/:id/versions/:version", Module: ModulePermissionTemplate, Action: ActionRead},
+	{Method: http.MethodGet, Path: "/api/v1/system/permission-templates/:id/version-diff", Module: ModulePermissionTemplate, Action: ActionRead},
+	{Method: http.MethodPost, Path: "/api/v1/system/permission-templates/:id/versions/:version/restore", Module: ModulePermissionTemplate, Action: ActionCreate},
+
+	// 角色
+	{Method: http.MethodPost, Path: "/api/v1/system/roles", Module: ModuleRole, Action: ActionCreate},
+	{Method: http.MethodGet, Path: "/api/v1/system/roles", Module: ModuleRole, Action: ActionRead},
+	{Method: http.MethodPut, Path: "/api/v1/system/roles/:id", Module: ModuleRole, Action: ActionUpdate},
+	{Method: http.MethodGet, Path: "/api/v1/system/roles/:id", Module: ModuleRole, Action: ActionRead},
+	{Method: http.MethodDelete, Path: "/api/v1/system/roles/:id", Module: ModuleRole, Action: ActionDelete},
+
+	// 审计
+	{Method: http.MethodGet, Path: "/api/v1/system/audit-events", Module: ModuleAudit, Action: ActionRead},
+	{Method: http.MethodGet, Path: "/api/v1/system/organization/:id/audits", Module: ModuleAudit, Action: ActionRead},
+	{Method: http.MethodGet, Path: "/api/v1/system/organization/audits", Module: ModuleAudit, Action: ActionRead},
+	{Method: http.MethodGet, Path: "/api/v1/system/permission-templates/:id/history", Module: ModuleAudit, Action: ActionRead},
+
+	// 策略决策点（供其他服务查询授权判定）
+	{Method: http.MethodPost, Path: "/api/v1/system/authz/check", Module: ModuleAuthz, Action: ActionCheck},
+
+	// 权限目录（模板编辑器读取目录沿用模板查看权限）
+	{Method: http.MethodGet, Path: "/api/v1/system/permission-catalog", Module: ModulePermissionTemplate, Action: ActionRead},
+	{Method: http.MethodPost, Path: "/api/v1/system/permission-catalog/modules", Module: ModulePermissionCatalog, Action: ActionCreate},
+	{Method: http.MethodPut, Path: "/api/v1/system/permission-catalog/modules/:id", Module: ModulePermissionCatalog, Action: ActionUpdate},
+	{Method: http.MethodDelete, Path: "/api/v1/system/permission-catalog/modules/:id", Module: ModulePermissionCatalog, Action: ActionDelete},
+
+	// 权限点
+	{Method: http.MethodGet, Path: "/api/v1/system/permission-points", Module: ModulePermissionPoint, Action: ActionRead},
+	{Method: http.MethodPost, Path: "/api/v1/system/permission-points", Module: ModulePermissionPoint, Action: ActionCreate},
+	{Method: http.MethodGet, Path: "/api/v1/system/permission-points/:id", Module: ModulePermissionPoint, Action: ActionRead},
+	{Method: http.MethodPut, Path: "/api/v1/system/permission-points/:id", Module: ModulePermissionPoint, Action: ActionUpdate},
+	{Method: http.MethodDelete, Path: "/api/v1/system/permission-points/:id", Module: ModulePermissionPoint, Action: ActionDelete},
+	{Method: http.MethodGet, Path: "/api/v1/system/permission-points/:id/references", Module: ModulePermissionPoint, Action: ActionRead},
+
+	// 菜单管理
+	{Method: http.MethodGet, Path: "/api/v1/system/menu-groups", Module: ModuleMenu, Action: ActionRead},
+	{Method: http.MethodPost, Path: "/api/v1/system/menu-groups", Module: ModuleMenu, Action: ActionCreate},
+	{Method: http.MethodGet, Path: "/api/v1/system/menu-groups/:id", Module: ModuleMenu, Action: ActionRead},
+	{Method: http.MethodPut, Path: "/api/v1/system/menu-groups/:id", Module: ModuleMenu, Action: ActionUpdate},
+	{Method: http.MethodDelete, Path: "/api/v1/system/menu-groups/:id", Module: ModuleMenu, Action: ActionDelete},
+	{Method: http.MethodPost, Path: "/api/v1/system/menus/:id/revert", Module: ModuleMenu, Action: ActionUpdate},
+	{Method: http.MethodPost, Path: "/api/v1/system/menus/batch/bind-permission", Module: ModuleMenu, Action: ActionUpdate},
+	{Method: http.MethodPost, Path: "/api/v1/system/menus/batch/delete", Module: ModuleMenu, Action: ActionDelete},
+	{Method: http.MethodPost, Path: "/api/v1/system/menus/batch/enabled", Module: ModuleMenu, Action: ActionUpdate},
+	{Method: http.MethodPost, Path: "/api/v1/system/menus/batch/move", Module: ModuleMenu, Action: ActionUpdate},
+	{Method: http.MethodPost, Path: "/api/v1/system/menus/batch/visible", Module: ModuleMenu, Action: ActionUpdate},
+	{Method: http.MethodGet, Path: "/api/v1/system/menus/export", Module: ModuleMenu, Action: ActionRead},
+	{Method: http.MethodPost, Path: "/api/v1/system/menus/import", Module: ModuleMenu, Action: ActionUpdate},
+}
+
+// RoutePermissionTable 路由权限声明表
+type RoutePermissionTable struct {
+	rules []RoutePermission
+}
+
+// NewRoutePermissionTable 创建路由权限声明表
+func NewRoutePermissionTable(rules []RoutePermission) *RoutePermissionTable {
+	return &RoutePermissionTable{rules: rules}
+}
+
+// Match 根据请求方法和路径查找权限声明
+// 静态路径段优先于 :param 占位段，避免 /organization/tree 被 /organization/:id 命中
+func (t *RoutePermissionTable) Match(method, path string) (*RoutePermission, bool) {
+	segments := splitPath(path)
+
+	var best *RoutePermission
+	bestStatic := -1
+	for i := range t.rules {
+		rule := &t.rules[i]
+		if rule.Method != method {
+			continue
+		}
+		static, ok := matchSegments(splitPath(rule.Path), segments)
+		if !ok {
+			continue
+		}
+		if static > bestStatic {
+			best = rule
+			bestStatic = static
+		}
+	}
+
+	return best, best != nil
+}
+
+// matchSegments 逐段匹配，返回命中的静态段数量
+func matchSegments(pattern, segments []string) (int, bool) {
+	if len(pattern) != len(segments) {
+		return 0, false
+	}
+
+	static := 0
+	for i, p := range pattern {
+		if strings.HasPrefix(p, ":") {
+			if segments[i] == "" {
+				return 0, false
+			}
+			continue
+		}
+		if p != segments[i] {
+			return 0, false
+		}
+		static++
+	}
+	return static, true
+}
+
+// splitPath 拆分路径段（忽略首尾斜杠）
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/contextkeys"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// tokenClaims 从 JWT 中解析出的声明
+type tokenClaims struct {
+	UserId    string
+	Jti       string
+	SessionId string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// parseTokenFromRequest 从 Authorization 头解析并校验 JWT
+func parseTokenFromRequest(r *http.Request, secret string) (*tokenClaims, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return nil, fmt.Errorf("缺少 Authorization 头")
+	}
+	tokenString := strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+	if tokenString == "" {
+		return nil, fmt.Errorf("Token 为空")
+	}
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("不支持的签名算法: %v", token.Header["alg"])
+		}
+		return []byte(secret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("Token 无效")
+	}
+
+	userId, ok := claims["user_id"].(string)
+	if !ok || userId == "" {
+		return nil, fmt.Errorf("Token 缺少 user_id")
+	}
+
+	result := &tokenClaims{UserId: userId}
+	result.Jti, _ = claims["jti"].(string)
+	result.SessionId, _ = claims["sid"].(string)
+	// 签发时间优先取毫秒精度的 iat_ms，与用户级吊销水位线按毫秒比较；旧 Token 回退到 iat（秒）
+	if iatMs, ok := claims["iat_ms"].(float64); ok {
+		result.IssuedAt = time.UnixMilli(int64(iatMs))
+	} else if iat, ok := claims["iat"].(float64); ok {
+		result.IssuedAt = time.Unix(int64(iat), 0)
+	}
+	if exp, ok := claims["exp"].(float64); ok {
+		result.ExpiresAt = time.Unix(int64(exp), 0)
+	}
+
+	return result, nil
+}
+
+// withTokenClaims 将当前用户 ID 及 Token 信息注入 context
+func withTokenClaims(ctx context.Context, claims *tokenClaims) context.Context {
+	ctx = context.WithValue(ctx, contextkeys.UserIDKey, claims.UserId)
+	if claims.Jti != "" {
+		ctx = context.WithValue(ctx, contextkeys.TokenIDKey, claims.Jti)
+	}
+	if claims.SessionId != "" {
+		ctx = context.WithValue(ctx, contextkeys.SessionIDKey, claims.SessionId)
+	}
+	if !claims.ExpiresAt.IsZero() {
+		ctx = context.WithValue(ctx, contextkeys.TokenExpiresAtKey, claims.ExpiresAt)
+	}
+	return ctx
+}
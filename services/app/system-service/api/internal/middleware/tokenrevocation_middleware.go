@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/errorx"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/tokenstore"
+
+	baseErrorx "github.com/jinguoxing/idrm-go-base/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// TokenRevocationMiddleware Token 吊销校验中间件
+// 用于 rest.WithJwt 路由组：拒绝已退出登录或被强制下线的 Token，并注入当前用户 ID
+type TokenRevocationMiddleware struct {
+	accessSecret string
+	store        *tokenstore.Store
+}
+
+func NewTokenRevocationMiddleware(accessSecret string, store *tokenstore.Store) *TokenRevocationMiddleware {
+	return &TokenRevocationMiddleware{
+		accessSecret: accessSecret,
+		store:        store,
+	}
+}
+
+func (m *TokenRevocationMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 1. 解析 JWT（签名已由 rest.WithJwt 校验，这里需要拿到 jti/iat 等标准声明）
+		claims, err := parseTokenFromRequest(r, m.accessSecret)
+		if err != nil {
+			logx.WithContext(r.Context()).Infof("Token 校验失败: %v", err)
+			httpx.ErrorCtx(r.Context(), w, baseErrorx.New(errorx.ErrTokenInvalid, "Token 无效或已过期"))
+			return
+		}
+
+		// 2. 校验是否已吊销
+		if err := checkRevoked(r, m.store, claims); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		next(w, r.WithContext(withTokenClaims(r.Context(), claims)))
+	}
+}
+
+// checkRevoked 校验 Token 是否已被吊销
+func checkRevoked(r *http.Request, store *tokenstore.Store, claims *tokenClaims) error {
+	revoked, err := store.IsRevoked(r.Context(), claims.UserId, claims.Jti, claims.SessionId, claims.IssuedAt)
+	if err != nil {
+		logx.WithContext(r.Context()).Errorf("查询 Token 吊销状态失败: userId=%s, %v", claims.UserId, err)
+		return baseErrorx.New(50000, "系统错误")
+	}
+	if revoked {
+		return baseErrorx.New(errorx.ErrTokenInvalid, "Token 已失效，请重新登录")
+	}
+	return nil
+}
@@ -8,12 +8,27 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/authz"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/clientip"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/config"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/datascope"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/jobqueue"
 	"github.com/DataSemanticHub/services/app/system-service/api/internal/middleware"
+	"github.com/DataSemanticHub/services/app/system-service/api/internal/tokenstore"
+	auditevents "github.com/DataSemanticHub/services/app/system-service/model/system/audit_events"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/jobs"
 	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_audit_logs"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/menu_groups"
 	"github.com/DataSemanticHub/services/app/system-service/model/system/menus"
 	"github.com/DataSemanticHub/services/app/system-service/model/system/organization"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/orgaudit"
+	permissioncatalog "github.com/DataSemanticHub/services/app/system-service/model/system/permission_catalog"
+	permissionpoints "github.com/DataSemanticHub/services/app/system-service/model/system/permission_points"
 	permissiontemplates "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_audit_logs"
+	permissiontemplatedrafts "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_drafts"
+	permissiontemplateversions "github.com/DataSemanticHub/services/app/system-service/model/system/permission_template_versions"
+	"github.com/DataSemanticHub/services/app/system-service/model/system/roles"
 	"github.com/DataSemanticHub/services/app/system-service/model/system/userdept"
 	auditlogs "github.com/DataSemanticHub/services/app/system-service/model/user/audit_logs"
 	rolebindings "github.com/DataSemanticHub/services/app/system-service/model/user/role_bindings"
@@ -27,19 +42,38 @@ import (
 )
 
 type ServiceContext struct {
-	Config                  config.Config
-	DB                      *gorm.DB
-	RedisClient             *redis.Client
-	UserModel               users.Model
-	RoleBindingModel        rolebindings.Model
-	AuditLogModel           auditlogs.Model
-	OrgModel                organization.Model
-	OrgTreeService          organization.TreeService
-	UserDeptModel           userdept.Model
-	PermissionTemplateModel permissiontemplates.Model
-	MenuModel               menus.Model
-	MenuAuditLogModel       menu_audit_logs.Model
-	Authority               rest.Middleware
+	Config                          config.Config
+	DB                              *gorm.DB
+	RedisClient                     *redis.Client
+	UserModel                       users.Model
+	RoleBindingModel                rolebindings.Model
+	AuditLogModel                   auditlogs.Model
+	OrgModel                        organization.Model
+	OrgAuditModel                   orgaudit.Model
+	OrgTreeService                  organization.TreeService
+	UserDeptModel                   userdept.Model
+	PermissionTemplateModel         permissiontemplates.Model
+	PermissionTemplateAuditLogModel permission_template_audit_logs.Model
+	PermissionTemplateVersionModel  permissiontemplateversions.Model
+	PermissionTemplateDraftModel    permissiontemplatedrafts.Model
+	PermissionCatalogModel          permissioncatalog.Model
+	PermissionPointModel            permissionpoints.Model
+	RoleModel                       roles.Model
+	PermissionResolver              *authz.Resolver
+	PermissionCache                 *authz.Cache
+	DecisionPoint                   *authz.DecisionPoint
+	DataScope                       *datascope.Resolver
+	MenuModel                       menus.Model
+	MenuAuditLogModel               menu_audit_logs.Model
+	MenuGroupModel                  menu_groups.Model
+	AuditEventModel                 auditevents.Model
+	JobModel                        jobs.Model
+	JobQueue                        *jobqueue.Pool
+	JobStorage                      *jobqueue.Storage
+	TokenStore                      *tokenstore.Store
+	ClientIP                        *clientip.Resolver
+	Authority                       rest.Middleware
+	TokenRevocation                 rest.Middleware
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -54,24 +88,73 @@ func NewServiceContext(c config.Config) *ServiceContext {
 
 	// 初始化 Organization Model
 	orgModel := organization.NewModel(db)
+	userDeptModel := userdept.NewModel(db)
 
-	// 初始化 Authority 中间件
-	authority := middleware.NewAuthorityMiddleware().Handle
+	// 初始化客户端 IP 解析器
+	clientIP, err := clientip.NewResolver(c.TrustedProxies)
+	if err != nil {
+		panic(fmt.Sprintf("受信代理配置错误: %v", err))
+	}
+
+	// 初始化 Token 吊销存储
+	tokenStore := tokenstore.NewStore(redisClient, maxTokenTTL(c))
+
+	// 初始化 Authority 中间件（基于已发布权限模板的路由鉴权）
+	roleBindingModel := rolebindings.NewModel(db)
+	roleModel := roles.NewModel(db)
+	permissionTemplateModel := permissiontemplates.NewModel(db)
+	permissionTemplateVersionModel := permissiontemplateversions.NewModel(db)
+	permissionResolver := authz.NewResolver(roleBindingModel, roleModel, permissionTemplateModel, permissionTemplateVersionModel)
+	permissionCache := authz.NewCache(redisClient, time.Duration(c.Authz.CacheTTL)*time.Second)
+	permissionChecker := middleware.NewPermissionChecker(permissionResolver)
+	routePermissions := middleware.NewRoutePermissionTable(middleware.DefaultRoutePermissions)
+	authority := middleware.NewAuthorityMiddleware(c.Auth.AccessSecret, tokenStore, permissionChecker, routePermissions).Handle
+	tokenRevocation := middleware.NewTokenRevocationMiddleware(c.Auth.AccessSecret, tokenStore).Handle
+
+	// 初始化异步任务 worker 池（执行函数在 api.go 中注册后启动）
+	jobModel := jobs.NewModel(db)
+	jobStorage := jobqueue.NewStorage(c.Jobs.StorageDir)
+	jobQueue := jobqueue.NewPool(jobModel, redisClient, jobqueue.Options{
+		Workers:      c.Jobs.Workers,
+		PollInterval: time.Duration(c.Jobs.PollInterval) * time.Second,
+		LockTTL:      time.Duration(c.Jobs.LockTTL) * time.Second,
+		Storage:      jobStorage,
+		FileTTL:      time.Duration(c.Jobs.FileTTL) * time.Second,
+	})
 
 	return &ServiceContext{
-		Config:                  c,
-		DB:                      db,
-		RedisClient:             redisClient,
-		UserModel:               users.NewModel(db),
-		RoleBindingModel:        rolebindings.NewModel(db),
-		AuditLogModel:           auditlogs.NewModel(db),
-		OrgModel:                orgModel,
-		OrgTreeService:          organization.NewTreeService(orgModel),
-		UserDeptModel:           userdept.NewModel(db),
-		PermissionTemplateModel: permissiontemplates.NewModel(db),
-		MenuModel:               menus.NewModel(db),
-		MenuAuditLogModel:       menu_audit_logs.NewModel(db),
-		Authority:               authority,
+		Config:                          c,
+		DB:                              db,
+		RedisClient:                     redisClient,
+		UserModel:                       users.NewModel(db),
+		RoleBindingModel:                roleBindingModel,
+		AuditLogModel:                   auditlogs.NewModel(db),
+		OrgModel:                        orgModel,
+		OrgAuditModel:                   orgaudit.NewModel(db),
+		OrgTreeService:                  organization.NewTreeService(orgModel),
+		UserDeptModel:                   userDeptModel,
+		PermissionTemplateModel:         permissionTemplateModel,
+		PermissionTemplateAuditLogModel: permission_template_audit_logs.NewModel(db),
+		PermissionTemplateVersionModel:  permissionTemplateVersionModel,
+		PermissionTemplateDraftModel:    permissiontemplatedrafts.NewModel(db),
+		PermissionCatalogModel:          permissioncatalog.NewModel(db),
+		PermissionPointModel:            permissionpoints.NewModel(db),
+		RoleModel:                       roleModel,
+		PermissionResolver:              permissionResolver,
+		PermissionCache:                 permissionCache,
+		DecisionPoint:                   authz.NewDecisionPoint(permissionResolver, permissionCache),
+		DataScope:                       datascope.NewResolver(redisClient, permissionResolver, userDeptModel, orgModel),
+		MenuModel:                       menus.NewModel(db),
+		MenuAuditLogModel:               menu_audit_logs.NewModel(db),
+		MenuGroupModel:                  menu_groups.NewModel(db),
+		AuditEventModel:                 auditevents.NewModel(db),
+		JobModel:                        jobModel,
+		JobQueue:                        jobQueue,
+		JobStorage:                      jobStorage,
+		TokenStore:                      tokenStore,
+		ClientIP:                        clientIP,
+		Authority:                       authority,
+		TokenRevocation:                 tokenRevocation,
 	}
 }
 
@@ -126,6 +209,12 @@ func initDB(c config.Config) (*gorm.DB, error) {
 	return db, nil
 }
 
+// maxTokenTTL 访问令牌与刷新令牌有效期的最大值，Token 吊销记录至少保留该时长
+func maxTokenTTL(c config.Config) time.Duration {
+	seconds := max(c.Auth.AccessExpire, c.RefreshToken.Expire, c.RefreshToken.RememberMeExpire)
+	return time.Duration(seconds) * time.Second
+}
+
 // initRedis 初始化 Redis 连接
 func initRedis(c config.Config) *redis.Client {
 	return redis.NewClient(&redis.Options{
@@ -135,36 +224,10 @@ func initRedis(c config.Config) *redis.Client {
 	})
 }
 
-// BuildDeptCache 构建用户数据权限缓存
+// BuildDeptCache 构建用户数据权限缓存（主部门及全部下级部门）
 func (s *ServiceContext) BuildDeptCache(ctx context.Context, userId string) error {
-	// 1. 查询用户主部门
-	primaryDept, err := s.UserDeptModel.FindPrimaryByUserId(ctx, userId)
-	if err != nil {
-		return fmt.Errorf("查询主部门失败: %w", err)
-	}
-	if primaryDept == nil {
-		// 用户没有主部门，不构建缓存
-		return nil
-	}
-
-	// 2. 查询主部门的所有子部门
-	depts := []string{primaryDept.DeptId}
-	descendants, err := s.OrgModel.FindSubtree(ctx, primaryDept.DeptId)
-	if err != nil {
-		return fmt.Errorf("查询子部门失败: %w", err)
-	}
-	for _, d := range descendants {
-		depts = append(depts, d.Id)
-	}
-
-	// 3. 写入 Redis Set
-	key := fmt.Sprintf("user:dept:%s", userId)
-	members := make([]interface{}, len(depts))
-	for i, deptId := range depts {
-		members[i] = deptId
-	}
-
-	return s.RedisClient.SAdd(ctx, key, members...).Err()
+	_, err := s.DataScope.BuildDeptCache(ctx, userId)
+	return err
 }
 
 // InvalidateDeptCache 失效指定用户的数据权限缓存
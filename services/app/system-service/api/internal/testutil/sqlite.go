@@ -0,0 +1,39 @@
+// Package testutil 提供单元测试共用的辅助函数
+package testutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// permissionTableDDL permission_templates、roles、permission_template_versions 的 SQLite 建表语句
+// 这些表使用 MySQL 专有默认值，无法通过 AutoMigrate 在 SQLite 下建表
+var permissionTableDDL = []string{
+	`CREATE TABLE IF NOT EXISTS permission_templates (
+		id TEXT PRIMARY KEY, name TEXT NOT NULL, code TEXT NOT NULL, description TEXT,
+		status TEXT NOT NULL DEFAULT 'draft', scope_suggestion TEXT, parent_id TEXT, policy_matrix TEXT NOT NULL, policy_removals TEXT,
+		advanced_perms TEXT, version INTEGER NOT NULL DEFAULT 1, created_by TEXT NOT NULL,
+		created_at DATETIME, updated_by TEXT, updated_at DATETIME, deleted_at DATETIME
+	)`,
+	`CREATE TABLE IF NOT EXISTS roles (
+		id TEXT PRIMARY KEY, code TEXT NOT NULL, name TEXT NOT NULL, description TEXT,
+		scope TEXT NOT NULL DEFAULT 'global', org_id TEXT, template_id TEXT NOT NULL,
+		template_version INTEGER NOT NULL DEFAULT 1, template_applied_at DATETIME, created_by TEXT NOT NULL,
+		created_at DATETIME, updated_by TEXT, updated_at DATETIME, deleted_at DATETIME
+	)`,
+	`CREATE TABLE IF NOT EXISTS permission_template_versions (
+		id TEXT PRIMARY KEY, template_id TEXT NOT NULL, version INTEGER NOT NULL, name TEXT NOT NULL,
+		code TEXT NOT NULL, description TEXT, scope_suggestion TEXT, policy_matrix TEXT NOT NULL,
+		advanced_perms TEXT, published_by TEXT NOT NULL, published_at DATETIME, UNIQUE (template_id, version)
+	)`,
+}
+
+// CreatePermissionTables 在 SQLite 测试库中创建权限模板、角色及模板版本表
+func CreatePermissionTables(t testing.TB, db *gorm.DB) {
+	t.Helper()
+	for _, ddl := range permissionTableDDL {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+}
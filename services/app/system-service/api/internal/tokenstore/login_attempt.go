@@ -0,0 +1,82 @@
+package tokenstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// loginFailAccountKeyPrefix 账号维度登录失败计数（按邮箱）
+	loginFailAccountKeyPrefix = "auth:login:fail:account:"
+	// loginFailIPKeyPrefix 来源 IP 维度登录失败计数
+	loginFailIPKeyPrefix = "auth:login:fail:ip:"
+)
+
+// incrWithinScript 计数加一，未设置过期时间时（首次计数）设置窗口过期时间，两步在同一脚本内原子执行
+var incrWithinScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if redis.call("PTTL", KEYS[1]) == -1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// LoginFailures 登录失败计数
+type LoginFailures struct {
+	Account int64 // 账号在统计窗口内的失败次数
+	IP      int64 // 来源 IP 在统计窗口内的失败次数
+}
+
+// RecordLoginFailure 记录一次登录失败，返回记录后的计数
+// 计数采用固定窗口：首次失败时开始计时，窗口结束后自动清零
+// email/ip 为空时跳过对应维度
+func (s *Store) RecordLoginFailure(ctx context.Context, email, ip string, window time.Duration) (*LoginFailures, error) {
+	failures := &LoginFailures{}
+
+	var err error
+	if email != "" {
+		if failures.Account, err = s.incrWithin(ctx, loginFailAccountKeyPrefix+strings.ToLower(email), window); err != nil {
+			return nil, fmt.Errorf("记录账号登录失败次数失败: %w", err)
+		}
+	}
+	if ip != "" {
+		if failures.IP, err = s.incrWithin(ctx, loginFailIPKeyPrefix+ip, window); err != nil {
+			return nil, fmt.Errorf("记录 IP 登录失败次数失败: %w", err)
+		}
+	}
+
+	return failures, nil
+}
+
+// IPLoginFailures 查询来源 IP 在统计窗口内的登录失败次数
+func (s *Store) IPLoginFailures(ctx context.Context, ip string) (int64, error) {
+	if ip == "" {
+		return 0, nil
+	}
+	count, err := s.rdb.Get(ctx, loginFailIPKeyPrefix+ip).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("查询 IP 登录失败次数失败: %w", err)
+	}
+	return count, nil
+}
+
+// ResetLoginFailures 清除账号的登录失败计数（登录成功或解锁后调用）
+func (s *Store) ResetLoginFailures(ctx context.Context, email string) error {
+	if email == "" {
+		return nil
+	}
+	return s.rdb.Del(ctx, loginFailAccountKeyPrefix+strings.ToLower(email)).Err()
+}
+
+// incrWithin 计数加一，首次计数时设置窗口过期时间
+func (s *Store) incrWithin(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return incrWithinScript.Run(ctx, s.rdb, []string{key}, window.Milliseconds()).Int64()
+}
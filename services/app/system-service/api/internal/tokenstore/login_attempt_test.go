@@ -0,0 +1,66 @@
+package tokenstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRecordLoginFailure_CountsWithinWindow 测试失败次数在统计窗口内累计，窗口结束后清零
+func TestRecordLoginFailure_CountsWithinWindow(t *testing.T) {
+	store, mr := setupTestStore(t)
+	ctx := context.Background()
+
+	for i := 1; i <= 3; i++ {
+		failures, err := store.RecordLoginFailure(ctx, "User@Example.com", "10.0.0.1", time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, int64(i), failures.Account)
+		assert.Equal(t, int64(i), failures.IP)
+	}
+
+	count, err := store.IPLoginFailures(ctx, "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	// 窗口结束后计数清零
+	mr.FastForward(2 * time.Minute)
+	count, err = store.IPLoginFailures(ctx, "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	failures, err := store.RecordLoginFailure(ctx, "user@example.com", "", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), failures.Account)
+	assert.Equal(t, int64(0), failures.IP)
+}
+
+// TestRecordLoginFailure_RestoresMissingExpire 测试计数键缺少过期时间时补设窗口，避免计数永久保留
+func TestRecordLoginFailure_RestoresMissingExpire(t *testing.T) {
+	store, mr := setupTestStore(t)
+	ctx := context.Background()
+	require.NoError(t, mr.Set(loginFailIPKeyPrefix+"10.0.0.2", "4"))
+
+	failures, err := store.RecordLoginFailure(ctx, "", "10.0.0.2", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), failures.IP)
+	assert.Equal(t, time.Minute, mr.TTL(loginFailIPKeyPrefix+"10.0.0.2"))
+}
+
+// TestResetLoginFailures_ClearsAccountOnly 测试清除账号计数不影响 IP 计数
+func TestResetLoginFailures_ClearsAccountOnly(t *testing.T) {
+	store, _ := setupTestStore(t)
+	ctx := context.Background()
+
+	_, err := store.RecordLoginFailure(ctx, "user@example.com", "10.0.0.1", time.Minute)
+	require.NoError(t, err)
+
+	require.NoError(t, store.ResetLoginFailures(ctx, "USER@example.com"))
+
+	failures, err := store.RecordLoginFailure(ctx, "user@example.com", "10.0.0.1", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), failures.Account)
+	assert.Equal(t, int64(2), failures.IP)
+}
@@ -0,0 +1,104 @@
+package tokenstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// revokedTokenKeyPrefix 已吊销 Token（按 jti）
+	revokedTokenKeyPrefix = "auth:revoked:jti:"
+	// revokedUserKeyPrefix 用户级吊销水位线（Unix 毫秒，签发时间不晚于该时间的 Token 全部失效）
+	revokedUserKeyPrefix = "auth:revoked:user:"
+
+	// secondWatermarkLimit 小于该值的水位线为旧版本按秒记录的值
+	secondWatermarkLimit = 1e11
+
+	// DefaultMaxTokenTTL 未指定时的 Token 有效期上限
+	DefaultMaxTokenTTL = 7 * 24 * time.Hour
+)
+
+// Store 基于 Redis 的 Token 吊销存储
+type Store struct {
+	rdb         *redis.Client
+	maxTokenTTL time.Duration // 用户级水位线、会话吊销记录的保留时长
+}
+
+// NewStore 创建 Token 吊销存储
+// maxTokenTTL 为访问令牌与刷新令牌有效期的最大值，吊销记录至少保留该时长；不大于 0 时使用 DefaultMaxTokenTTL
+func NewStore(rdb *redis.Client, maxTokenTTL time.Duration) *Store {
+	if maxTokenTTL <= 0 {
+		maxTokenTTL = DefaultMaxTokenTTL
+	}
+	return &Store{rdb: rdb, maxTokenTTL: maxTokenTTL}
+}
+
+// RevokeToken 吊销单个 Token，记录保留到 Token 过期为止
+func (s *Store) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if jti == "" {
+		return errors.New("jti 不能为空")
+	}
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		// Token 已过期，无需记录
+		return nil
+	}
+	return s.rdb.Set(ctx, revokedTokenKeyPrefix+jti, 1, ttl).Err()
+}
+
+// RevokeUser 吊销用户在指定时间之前（含）签发的全部 Token，并删除其全部会话（刷新令牌）
+func (s *Store) RevokeUser(ctx context.Context, userId string, at time.Time) error {
+	if userId == "" {
+		return errors.New("userId 不能为空")
+	}
+	if err := s.rdb.Set(ctx, revokedUserKeyPrefix+userId, at.UnixMilli(), s.maxTokenTTL).Err(); err != nil {
+		return err
+	}
+	return s.deleteUserSessions(ctx, userId)
+}
+
+// IsRevoked 判断 Token 是否已被吊销
+// jti/sessionId 为空时（旧版本签发的 Token）跳过对应校验，仅校验用户级水位线
+func (s *Store) IsRevoked(ctx context.Context, userId, jti, sessionId string, issuedAt time.Time) (bool, error) {
+	// 1. 校验单个 Token 及所属会话的吊销记录
+	keys := make([]string, 0, 2)
+	if jti != "" {
+		keys = append(keys, revokedTokenKeyPrefix+jti)
+	}
+	if sessionId != "" {
+		keys = append(keys, revokedSessionKeyPrefix+sessionId)
+	}
+	if len(keys) > 0 {
+		n, err := s.rdb.Exists(ctx, keys...).Result()
+		if err != nil {
+			return false, fmt.Errorf("查询 Token 吊销记录失败: %w", err)
+		}
+		if n > 0 {
+			return true, nil
+		}
+	}
+
+	// 2. 校验用户级水位线
+	val, err := s.rdb.Get(ctx, revokedUserKeyPrefix+userId).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, fmt.Errorf("查询用户吊销水位线失败: %w", err)
+	}
+	watermark, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("解析用户吊销水位线失败: %w", err)
+	}
+	if watermark < secondWatermarkLimit {
+		// 旧版本按秒记录的水位线：覆盖该秒内签发的全部 Token
+		watermark = watermark*1000 + 999
+	}
+
+	return issuedAt.UnixMilli() <= watermark, nil
+}
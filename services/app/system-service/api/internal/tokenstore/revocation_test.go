@@ -0,0 +1,115 @@
+package tokenstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTestStore 创建测试用的吊销存储
+func setupTestStore(t *testing.T) (*Store, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	return NewStore(rdb, 0), mr
+}
+
+// TestRevokeToken_RevokedUntilExpire 测试单个 Token 吊销记录保留到过期
+func TestRevokeToken_RevokedUntilExpire(t *testing.T) {
+	store, mr := setupTestStore(t)
+	ctx := context.Background()
+
+	err := store.RevokeToken(ctx, "jti-1", time.Now().Add(time.Minute))
+	require.NoError(t, err)
+
+	revoked, err := store.IsRevoked(ctx, "user-1", "jti-1", "", time.Now())
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	// 其他 Token 不受影响
+	revoked, err = store.IsRevoked(ctx, "user-1", "jti-2", "", time.Now())
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	// Token 过期后记录自动清理
+	mr.FastForward(2 * time.Minute)
+	assert.False(t, mr.Exists(revokedTokenKeyPrefix+"jti-1"))
+}
+
+// TestRevokeToken_AlreadyExpired_NoRecord 测试已过期 Token 不写入记录
+func TestRevokeToken_AlreadyExpired_NoRecord(t *testing.T) {
+	store, mr := setupTestStore(t)
+
+	err := store.RevokeToken(context.Background(), "jti-1", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.False(t, mr.Exists(revokedTokenKeyPrefix+"jti-1"))
+}
+
+// TestRevokeUser_WatermarkAppliesToEarlierTokens 测试用户级水位线仅吊销之前签发的 Token
+func TestRevokeUser_WatermarkAppliesToEarlierTokens(t *testing.T) {
+	store, _ := setupTestStore(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	err := store.RevokeUser(ctx, "user-1", now)
+	require.NoError(t, err)
+
+	revoked, err := store.IsRevoked(ctx, "user-1", "jti-old", "", now.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	revoked, err = store.IsRevoked(ctx, "user-1", "jti-new", "", now.Add(time.Second))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	// 其他用户不受影响
+	revoked, err = store.IsRevoked(ctx, "user-2", "", "", now.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+}
+
+// TestRevokeUser_WatermarkMillisecondPrecision 测试同一秒内吊销之后签发的 Token 不受影响
+func TestRevokeUser_WatermarkMillisecondPrecision(t *testing.T) {
+	store, mr := setupTestStore(t)
+	ctx := context.Background()
+	at := time.Unix(1700000000, 500*int64(time.Millisecond))
+
+	require.NoError(t, store.RevokeUser(ctx, "user-1", at))
+
+	revoked, err := store.IsRevoked(ctx, "user-1", "", "", at.Add(-100*time.Millisecond))
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	revoked, err = store.IsRevoked(ctx, "user-1", "", "", at.Add(100*time.Millisecond))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	// 旧版本按秒记录的水位线覆盖该秒内签发的全部 Token
+	require.NoError(t, mr.Set(revokedUserKeyPrefix+"user-2", "1700000000"))
+	revoked, err = store.IsRevoked(ctx, "user-2", "", "", at.Add(100*time.Millisecond))
+	require.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+// TestRevokeUser_WatermarkKeptForMaxTokenTTL 测试用户级水位线与会话吊销记录按配置的 Token 有效期上限保留
+func TestRevokeUser_WatermarkKeptForMaxTokenTTL(t *testing.T) {
+	mr := miniredis.RunT(t)
+	store := NewStore(redis.NewClient(&redis.Options{Addr: mr.Addr()}), 30*24*time.Hour)
+	ctx := context.Background()
+
+	require.NoError(t, store.RevokeUser(ctx, "user-1", time.Now()))
+	assert.Equal(t, 30*24*time.Hour, mr.TTL(revokedUserKeyPrefix+"user-1"))
+
+	require.NoError(t, store.RevokeSession(ctx, "user-1", "sess-1"))
+	assert.Equal(t, 30*24*time.Hour, mr.TTL(revokedSessionKeyPrefix+"sess-1"))
+
+	// 超过默认上限后水位线仍然有效
+	mr.FastForward(DefaultMaxTokenTTL + time.Hour)
+	revoked, err := store.IsRevoked(ctx, "user-1", "", "", time.Now().Add(-time.Minute-DefaultMaxTokenTTL))
+	require.NoError(t, err)
+	assert.True(t, revoked)
+}
@@ -0,0 +1,285 @@
+package tokenstore
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// sessionKeyPrefix 会话（刷新令牌家族）
+	sessionKeyPrefix = "auth:session:"
+	// refreshTokenKeyPrefix 刷新令牌哈希 -> 会话 ID（轮换后保留，用于重用检测）
+	refreshTokenKeyPrefix = "auth:refresh:"
+	// userSessionsKeyPrefix 用户的会话 ID 集合
+	userSessionsKeyPrefix = "auth:user:sessions:"
+	// revokedSessionKeyPrefix 已吊销会话（该会话签发的访问令牌全部失效）
+	revokedSessionKeyPrefix = "auth:revoked:session:"
+
+	// refreshTokenBytes 刷新令牌随机字节数
+	refreshTokenBytes = 32
+)
+
+var (
+	// ErrRefreshTokenInvalid 刷新令牌无效或已过期
+	ErrRefreshTokenInvalid = errors.New("刷新令牌无效或已过期")
+	// ErrRefreshTokenReused 刷新令牌被重复使用（整个令牌家族已吊销）
+	ErrRefreshTokenReused = errors.New("刷新令牌被重复使用")
+	// ErrSessionNotFound 会话不存在
+	ErrSessionNotFound = errors.New("会话不存在")
+)
+
+// Session 登录会话，一个会话对应一个设备上的刷新令牌家族
+type Session struct {
+	Id           string    `json:"id"`
+	UserId       string    `json:"user_id"`
+	Email        string    `json:"email"`
+	DeviceName   string    `json:"device_name"`
+	UserAgent    string    `json:"user_agent"`
+	ClientIP     string    `json:"client_ip"`
+	RememberMe   bool      `json:"remember_me"`
+	TTL          int64     `json:"ttl"` // 刷新令牌有效期（秒），轮换时沿用
+	CreatedAt    time.Time `json:"created_at"`
+	LastActiveAt time.Time `json:"last_active_at"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	TokenHash    string    `json:"token_hash"` // 当前有效刷新令牌的哈希
+}
+
+// CreateSession 创建会话并签发首个刷新令牌
+func (s *Store) CreateSession(ctx context.Context, sess *Session, ttl time.Duration) (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	refreshToken, tokenHash, err := newRefreshToken()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	sess.Id = id.String()
+	sess.CreatedAt = now
+	sess.LastActiveAt = now
+	sess.ExpiresAt = now.Add(ttl)
+	sess.TTL = int64(ttl / time.Second)
+	sess.TokenHash = tokenHash
+
+	data, err := json.Marshal(sess)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, sessionKeyPrefix+sess.Id, data, ttl)
+		pipe.Set(ctx, refreshTokenKeyPrefix+tokenHash, sess.Id, ttl)
+		pipe.SAdd(ctx, userSessionsKeyPrefix+sess.UserId, sess.Id)
+		pipe.Expire(ctx, userSessionsKeyPrefix+sess.UserId, ttl)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("保存会话失败: %w", err)
+	}
+
+	return refreshToken, nil
+}
+
+// RotateRefreshToken 使用刷新令牌换取新的刷新令牌
+// 旧令牌作废但保留记录；再次使用已作废的令牌视为重用，吊销整个会话
+func (s *Store) RotateRefreshToken(ctx context.Context, refreshToken string) (*Session, string, error) {
+	tokenHash := hashRefreshToken(refreshToken)
+
+	// 1. 定位令牌所属会话
+	sessionId, err := s.rdb.Get(ctx, refreshTokenKeyPrefix+tokenHash).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, "", ErrRefreshTokenInvalid
+		}
+		return nil, "", fmt.Errorf("查询刷新令牌失败: %w", err)
+	}
+
+	// 2. 在 WATCH 保护下校验并轮换，防止并发刷新签发出多个有效令牌
+	newToken, newHash, err := newRefreshToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	var sess Session
+	reused := false
+	sessionKey := sessionKeyPrefix + sessionId
+	err = s.rdb.Watch(ctx, func(tx *redis.Tx) error {
+		data, err := tx.Get(ctx, sessionKey).Bytes()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				return ErrRefreshTokenInvalid
+			}
+			return err
+		}
+		if err := json.Unmarshal(data, &sess); err != nil {
+			return fmt.Errorf("解析会话失败: %w", err)
+		}
+		if sess.TokenHash != tokenHash {
+			reused = true
+			return ErrRefreshTokenReused
+		}
+
+		// 新令牌沿用会话创建时的有效期（记住我会话更长）
+		ttl := time.Duration(sess.TTL) * time.Second
+		if ttl <= 0 {
+			ttl = sess.ExpiresAt.Sub(sess.LastActiveAt)
+		}
+		now := time.Now()
+		sess.TokenHash = newHash
+		sess.LastActiveAt = now
+		sess.ExpiresAt = now.Add(ttl)
+		updated, err := json.Marshal(&sess)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, sessionKey, updated, ttl)
+			pipe.Set(ctx, refreshTokenKeyPrefix+newHash, sess.Id, ttl)
+			pipe.Expire(ctx, userSessionsKeyPrefix+sess.UserId, ttl)
+			return nil
+		})
+		return err
+	}, sessionKey)
+
+	if errors.Is(err, redis.TxFailedErr) {
+		// 同一令牌被并发使用
+		reused = true
+		err = ErrRefreshTokenReused
+	}
+	if reused {
+		if revokeErr := s.RevokeSession(ctx, sess.UserId, sessionId); revokeErr != nil {
+			return nil, "", fmt.Errorf("吊销会话失败: %w", revokeErr)
+		}
+		return nil, "", ErrRefreshTokenReused
+	}
+	if err != nil {
+		if errors.Is(err, ErrRefreshTokenInvalid) {
+			return nil, "", err
+		}
+		return nil, "", fmt.Errorf("轮换刷新令牌失败: %w", err)
+	}
+
+	return &sess, newToken, nil
+}
+
+// ListSessions 查询用户的全部有效会话（按最近活跃时间倒序）
+func (s *Store) ListSessions(ctx context.Context, userId string) ([]*Session, error) {
+	ids, err := s.rdb.SMembers(ctx, userSessionsKeyPrefix+userId).Result()
+	if err != nil {
+		return nil, fmt.Errorf("查询会话列表失败: %w", err)
+	}
+
+	sessions := make([]*Session, 0, len(ids))
+	var expired []interface{}
+	for _, id := range ids {
+		sess, err := s.getSession(ctx, id)
+		if err != nil {
+			if errors.Is(err, ErrSessionNotFound) {
+				expired = append(expired, id)
+				continue
+			}
+			return nil, err
+		}
+		sessions = append(sessions, sess)
+	}
+
+	// 清理已过期的会话 ID
+	if len(expired) > 0 {
+		s.rdb.SRem(ctx, userSessionsKeyPrefix+userId, expired...)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastActiveAt.After(sessions[j].LastActiveAt)
+	})
+	return sessions, nil
+}
+
+// RevokeSession 吊销会话：刷新令牌家族作废，且该会话签发的访问令牌全部失效
+func (s *Store) RevokeSession(ctx context.Context, userId, sessionId string) error {
+	if sessionId == "" {
+		return errors.New("sessionId 不能为空")
+	}
+	_, err := s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, sessionKeyPrefix+sessionId)
+		if userId != "" {
+			pipe.SRem(ctx, userSessionsKeyPrefix+userId, sessionId)
+		}
+		pipe.Set(ctx, revokedSessionKeyPrefix+sessionId, 1, s.maxTokenTTL)
+		return nil
+	})
+	return err
+}
+
+// FindSession 查询用户的指定会话
+func (s *Store) FindSession(ctx context.Context, userId, sessionId string) (*Session, error) {
+	sess, err := s.getSession(ctx, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	if sess.UserId != userId {
+		return nil, ErrSessionNotFound
+	}
+	return sess, nil
+}
+
+// deleteUserSessions 删除用户的全部会话（访问令牌由用户级水位线控制）
+func (s *Store) deleteUserSessions(ctx context.Context, userId string) error {
+	ids, err := s.rdb.SMembers(ctx, userSessionsKeyPrefix+userId).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, sessionKeyPrefix+id)
+	}
+	keys = append(keys, userSessionsKeyPrefix+userId)
+	return s.rdb.Del(ctx, keys...).Err()
+}
+
+// getSession 查询会话
+func (s *Store) getSession(ctx context.Context, sessionId string) (*Session, error) {
+	data, err := s.rdb.Get(ctx, sessionKeyPrefix+sessionId).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("查询会话失败: %w", err)
+	}
+
+	var sess Session
+	if err := json.Unmarshal(data, &sess); err != nil {
+		return nil, fmt.Errorf("解析会话失败: %w", err)
+	}
+	return &sess, nil
+}
+
+// newRefreshToken 生成不透明刷新令牌，返回令牌明文及其哈希（服务端仅保存哈希）
+func newRefreshToken() (string, string, error) {
+	buf := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("生成刷新令牌失败: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashRefreshToken(token), nil
+}
+
+// hashRefreshToken 计算刷新令牌哈希
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,108 @@
+package tokenstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRotateRefreshToken_ValidToken_IssuesNewToken 测试刷新令牌正常轮换
+func TestRotateRefreshToken_ValidToken_IssuesNewToken(t *testing.T) {
+	store, _ := setupTestStore(t)
+	ctx := context.Background()
+
+	sess := &Session{UserId: "user-1", DeviceName: "laptop"}
+	token, err := store.CreateSession(ctx, sess, time.Hour)
+	require.NoError(t, err)
+
+	rotated, newToken, err := store.RotateRefreshToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, sess.Id, rotated.Id)
+	assert.NotEqual(t, token, newToken)
+	// 轮换沿用会话创建时的有效期
+	assert.Equal(t, int64(3600), rotated.TTL)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), rotated.ExpiresAt, time.Minute)
+
+	// 新令牌可继续轮换
+	_, _, err = store.RotateRefreshToken(ctx, newToken)
+	require.NoError(t, err)
+}
+
+// TestRotateRefreshToken_ReusedToken_RevokesFamily 测试旧令牌重用时吊销整个会话
+func TestRotateRefreshToken_ReusedToken_RevokesFamily(t *testing.T) {
+	store, _ := setupTestStore(t)
+	ctx := context.Background()
+
+	sess := &Session{UserId: "user-1"}
+	token, err := store.CreateSession(ctx, sess, time.Hour)
+	require.NoError(t, err)
+
+	_, newToken, err := store.RotateRefreshToken(ctx, token)
+	require.NoError(t, err)
+
+	// 重用已作废的旧令牌
+	_, _, err = store.RotateRefreshToken(ctx, token)
+	assert.ErrorIs(t, err, ErrRefreshTokenReused)
+
+	// 同一家族的最新令牌也随之失效
+	_, _, err = store.RotateRefreshToken(ctx, newToken)
+	assert.ErrorIs(t, err, ErrRefreshTokenInvalid)
+
+	// 该会话签发的访问令牌被吊销
+	revoked, err := store.IsRevoked(ctx, "user-1", "jti-1", sess.Id, time.Now())
+	require.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+// TestRotateRefreshToken_UnknownToken_ReturnsInvalid 测试未知令牌
+func TestRotateRefreshToken_UnknownToken_ReturnsInvalid(t *testing.T) {
+	store, _ := setupTestStore(t)
+
+	_, _, err := store.RotateRefreshToken(context.Background(), "unknown")
+	assert.ErrorIs(t, err, ErrRefreshTokenInvalid)
+}
+
+// TestListSessions_MultipleDevices_ReturnsAll 测试按设备列出会话
+func TestListSessions_MultipleDevices_ReturnsAll(t *testing.T) {
+	store, _ := setupTestStore(t)
+	ctx := context.Background()
+
+	_, err := store.CreateSession(ctx, &Session{UserId: "user-1", DeviceName: "laptop"}, time.Hour)
+	require.NoError(t, err)
+	phone := &Session{UserId: "user-1", DeviceName: "phone"}
+	_, err = store.CreateSession(ctx, phone, time.Hour)
+	require.NoError(t, err)
+	_, err = store.CreateSession(ctx, &Session{UserId: "user-2"}, time.Hour)
+	require.NoError(t, err)
+
+	sessions, err := store.ListSessions(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Len(t, sessions, 2)
+
+	// 吊销其中一个设备
+	require.NoError(t, store.RevokeSession(ctx, "user-1", phone.Id))
+	sessions, err = store.ListSessions(ctx, "user-1")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "laptop", sessions[0].DeviceName)
+}
+
+// TestRevokeUser_DeletesAllSessions 测试强制下线删除用户全部会话
+func TestRevokeUser_DeletesAllSessions(t *testing.T) {
+	store, _ := setupTestStore(t)
+	ctx := context.Background()
+
+	token, err := store.CreateSession(ctx, &Session{UserId: "user-1"}, time.Hour)
+	require.NoError(t, err)
+
+	require.NoError(t, store.RevokeUser(ctx, "user-1", time.Now()))
+
+	_, _, err = store.RotateRefreshToken(ctx, token)
+	assert.ErrorIs(t, err, ErrRefreshTokenInvalid)
+	sessions, err := store.ListSessions(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+}
@@ -3,17 +3,67 @@
 
 package types
 
+type BatchBindPermissionReq struct {
+	Ids           []string `json:"ids" validate:"required,min=1"`                  // 菜单ID列表
+	PermissionKey string   `json:"permission_key" validate:"required"`             // 已登记的权限标识
+	Mode          string   `json:"mode,default=atomic,options=atomic|best_effort"` // atomic：全部成功才写入；best_effort：逐项写入
+}
+
+type BatchDeleteMenusReq struct {
+	Ids     []string `json:"ids" validate:"required,min=1"`                  // 菜单ID列表
+	Cascade bool     `json:"cascade,optional"`                               // 是否级联删除子菜单（默认false）
+	Mode    string   `json:"mode,default=atomic,options=atomic|best_effort"` // atomic：全部成功才写入；best_effort：逐项写入
+}
+
+type BatchMenuOperationResp struct {
+	Applied      bool                 `json:"applied"` // 是否已写入（atomic 模式下有失败项时为 false）
+	SuccessCount int                  `json:"success_count"`
+	FailedCount  int                  `json:"failed_count"`
+	Errors       []MenuOperationError `json:"errors,optional"`
+}
+
+type BatchMoveMenusReq struct {
+	Ids         []string `json:"ids" validate:"required,min=1"`                  // 菜单ID列表
+	NewParentId string   `json:"new_parent_id,optional"`                         // 新父级ID（空表示移到根节点）
+	Mode        string   `json:"mode,default=atomic,options=atomic|best_effort"` // atomic：全部成功才写入；best_effort：逐项写入
+}
+
+type BatchToggleMenusEnabledReq struct {
+	Ids     []string `json:"ids" validate:"required,min=1"`                  // 菜单ID列表
+	Enabled bool     `json:"enabled"`                                        // 目标启用状态
+	Mode    string   `json:"mode,default=atomic,options=atomic|best_effort"` // atomic：全部成功才写入；best_effort：逐项写入
+}
+
+type BatchToggleMenusVisibleReq struct {
+	Ids     []string `json:"ids" validate:"required,min=1"`                  // 菜单ID列表
+	Visible bool     `json:"visible"`                                        // 目标可见状态
+	Mode    string   `json:"mode,default=atomic,options=atomic|best_effort"` // atomic：全部成功才写入；best_effort：逐项写入
+}
+
 type BindPermissionReq struct {
 	Id               string `path:"id"`                         // UUID v7
-	PermissionKey    string `json:"permission_key,optional"`    // 已有权限标识
+	PermissionKey    string `json:"permission_key,optional"`    // 已有权限标识；创建新权限时为新标识（缺省为 menu:<code>）
 	CreatePermission bool   `json:"create_permission,optional"` // 是否创建新权限
 	PermissionName   string `json:"permission_name,optional"`   // 新权限名称
+	PermissionModule string `json:"permission_module,optional"` // 新权限所属模块（缺省为 menu）
 }
 
 type BindPermissionResp struct {
 	Menu Menu `json:"menu"`
 }
 
+type CreateMenuGroupReq struct {
+	Code        string `json:"code" validate:"required,min=1,max=64"`
+	Name        string `json:"name" validate:"required,min=1,max=128"`
+	Order       int    `json:"order,optional"`
+	Icon        string `json:"icon,optional" validate:"max=64"`
+	Description string `json:"description,optional" validate:"max=500"`
+}
+
+type CreateMenuGroupResp struct {
+	Id string `json:"id"`
+}
+
 type CreateMenuReq struct {
 	Name             string `json:"name" validate:"required,min=1,max=128"`
 	Code             string `json:"code" validate:"required,min=1,max=128"`
@@ -32,7 +82,7 @@ type CreateMenuReq struct {
 	Order            int    `json:"order,optional"` // 默认插入同级末尾
 	ShowInNav        bool   `json:"show_in_nav,optional"`
 	Cacheable        bool   `json:"cacheable,optional"`
-	CreatePermission bool   `json:"create_permission,optional"` // 是否创建新权限（需权限服务联动）
+	CreatePermission bool   `json:"create_permission,optional"` // 是否创建新权限点（permission_key 缺省为 menu:<code>）
 	PermissionName   string `json:"permission_name,optional"`   // 新权限名称（create_permission=true时必填）
 }
 
@@ -40,6 +90,16 @@ type CreateMenuResp struct {
 	Menu Menu `json:"menu"`
 }
 
+type DeleteMenuGroupReq struct {
+	Id         string `path:"id"`
+	ReassignTo string `form:"reassign_to,optional"` // 分组下菜单改挂的目标分组ID（空表示清空分组）
+}
+
+type DeleteMenuGroupResp struct {
+	Success         bool  `json:"success"`
+	ReassignedMenus int64 `json:"reassigned_menus"` // 改挂的菜单数量
+}
+
 type DeleteMenuReq struct {
 	Id      string `path:"id"`               // UUID v7
 	Cascade bool   `form:"cascade,optional"` // 是否级联删除（默认false）
@@ -49,6 +109,11 @@ type DeleteMenuResp struct {
 	ImpactInfo ImpactInfo `json:"impact_info,optional"` // 影响面信息
 }
 
+type ExportMenusReq struct {
+	RootCode string `form:"root_code,optional"`                    // 导出子树的根菜单编码（空表示导出全部）
+	Format   string `form:"format,default=json,options=json|yaml"` // 导出格式
+}
+
 type GetMenuAuditsReq struct {
 	Id            string `path:"id"` // UUID v7
 	Page          int    `form:"page,default=1" validate:"min=1"`
@@ -66,6 +131,14 @@ type GetMenuAuditsResp struct {
 	Logs     []MenuAuditLog `json:"logs"`
 }
 
+type GetMenuGroupReq struct {
+	Id string `path:"id"`
+}
+
+type GetMenuGroupResp struct {
+	Data MenuGroup `json:"data"`
+}
+
 type GetMenuInspectionResp struct {
 	Risks []RiskItem `json:"risks"`
 }
@@ -79,6 +152,10 @@ type GetMenuResp struct {
 	AuditSummary AuditSummary `json:"audit_summary,optional"` // 最近一次操作摘要
 }
 
+type GetMenuStatsReq struct {
+	GroupId string `form:"group_id,optional"` // 分组ID（空表示统计全部菜单）
+}
+
 type GetMenuStatsResp struct {
 	Total             int64 `json:"total"`              // 总菜单数
 	Enabled           int64 `json:"enabled"`            // 启用菜单数
@@ -99,6 +176,33 @@ type GetMenuTreeResp struct {
 	Menus []Menu `json:"menus"`
 }
 
+type GetMyMenusResp struct {
+	Menus       []NavMenu `json:"menus"`        // 当前用户可见的导航菜单树
+	ButtonCodes []string  `json:"button_codes"` // 当前用户有权使用的按钮编码
+}
+
+type ImportMenusReq struct {
+	DryRun bool   `form:"dry_run,optional"` // 仅预览差异，不写入
+	Prune  bool   `form:"prune,optional"`   // 删除导入范围内配置文件中不存在的菜单
+	Format string `form:"format,optional"`  // 文件格式：json/yaml（缺省按文件扩展名识别）
+}
+
+type ImportMenusResp struct {
+	DryRun    bool               `json:"dry_run"`
+	Applied   bool               `json:"applied"` // 是否已写入（预览或校验失败时为 false）
+	Created   int                `json:"created"`
+	Updated   int                `json:"updated"`
+	Moved     int                `json:"moved"`
+	Deleted   int                `json:"deleted"`
+	Unchanged int                `json:"unchanged"`
+	Changes   []MenuImportChange `json:"changes"`
+	Errors    []MenuImportError  `json:"errors,optional"` // 校验失败时不写入任何变更
+}
+
+type ListMenuGroupsResp struct {
+	Groups []MenuGroup `json:"groups"` // 按排序号升序
+}
+
 type MoveMenuReq struct {
 	Id          string `path:"id"`                                  // UUID v7
 	NewParentId string `json:"new_parent_id,optional"`              // 新父级ID（空表示移到根节点）
@@ -119,6 +223,17 @@ type ReorderMenusResp struct {
 	Errors       []MenuOperationError `json:"errors,optional"`
 }
 
+type RevertMenuReq struct {
+	Id      string `path:"id"`                           // UUID v7
+	AuditId string `json:"audit_id" validate:"required"` // 审计记录ID（恢复到该记录捕获的状态）
+}
+
+type RevertMenuResp struct {
+	Menu          Menu     `json:"menu"`
+	ChangedFields []string `json:"changed_fields"` // 恢复的字段
+	Undeleted     bool     `json:"undeleted"`      // 是否恢复了已删除的菜单
+}
+
 type ToggleMenuEnabledReq struct {
 	Id      string `path:"id"` // UUID v7
 	Enabled bool   `json:"enabled" validate:"required"`
@@ -137,6 +252,18 @@ type ToggleMenuVisibleResp struct {
 	Menu Menu `json:"menu"`
 }
 
+type UpdateMenuGroupReq struct {
+	Id          string `path:"id"`
+	Name        string `json:"name" validate:"required,min=1,max=128"`
+	Order       int    `json:"order,optional"`
+	Icon        string `json:"icon,optional" validate:"max=64"`
+	Description string `json:"description,optional" validate:"max=500"`
+}
+
+type UpdateMenuGroupResp struct {
+	Success bool `json:"success"`
+}
+
 type UpdateMenuReq struct {
 	Id            string `path:"id"` // UUID v7
 	Name          string `json:"name,optional" validate:"omitempty,min=1,max=128"`
@@ -34,6 +34,16 @@ type DeleteOrgResp struct {
 	Success bool `json:"success"`
 }
 
+type GetOrgAuditsReq struct {
+	Id         string `path:"id" validate:"required"`
+	Operation  string `form:"operation,optional"` // create/update/move/delete
+	OperatorId string `form:"operatorId,optional"`
+	StartTime  string `form:"startTime,optional"` // 2006-01-02 15:04:05 / 2006-01-02 / RFC3339
+	EndTime    string `form:"endTime,optional"`
+	Page       int    `form:"page,default=1" validate:"min=1"`
+	PageSize   int    `form:"pageSize,default=20" validate:"min=1,max=100"`
+}
+
 type GetOrgDetailReq struct {
 	Id string `path:"id" validate:"required"`
 }
@@ -60,6 +70,16 @@ type GetOrgUsersResp struct {
 	Users []*DeptUser `json:"users"`
 }
 
+type ListOrgAuditsReq struct {
+	OrgId      string `form:"orgId,optional"`
+	Operation  string `form:"operation,optional"`
+	OperatorId string `form:"operatorId,optional"`
+	StartTime  string `form:"startTime,optional"`
+	EndTime    string `form:"endTime,optional"`
+	Page       int    `form:"page,default=1" validate:"min=1"`
+	PageSize   int    `form:"pageSize,default=20" validate:"min=1,max=100"`
+}
+
 type MoveOrgReq struct {
 	Id             string   `json:"id" validate:"required"`
 	TargetParentId string   `json:"targetParentId" validate:"required"`
@@ -70,6 +90,13 @@ type MoveOrgResp struct {
 	Success bool `json:"success"`
 }
 
+type OrgAuditListResp struct {
+	Total    int64          `json:"total"`
+	Page     int            `json:"page"`
+	PageSize int            `json:"pageSize"`
+	Logs     []*OrgAuditLog `json:"logs"`
+}
+
 type RemoveUserAuxDeptReq struct {
 	UserId string `path:"userId" validate:"required"`
 	DeptId string `path:"deptId" validate:"required"`
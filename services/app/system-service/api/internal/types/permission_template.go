@@ -17,9 +17,11 @@ type CreatePermissionTemplateReq struct {
 	Name            string                       `json:"name" validate:"required,max=128"`
 	Code            string                       `json:"code" validate:"required,max=64,lowercase_alphanum"`
 	Description     string                       `json:"description" validate:"max=500"`
-	ScopeSuggestion string                       `json:"scope_suggestion" validate:"omitempty,oneof=global organization domain project"`
+	ScopeSuggestion string                       `json:"scope_suggestion" validate:"omitempty,oneof=global organization domain project department department_tree self"`
 	PolicyMatrix    map[string]PolicyMatrixEntry `json:"policy_matrix" validate:"required"`
 	AdvancedPerms   map[string]AdvancedPermEntry `json:"advanced_perms"`
+	ParentId        string                       `json:"parent_id,optional"`       // 继承的父模板ID
+	PolicyRemovals  map[string][]string          `json:"policy_removals,optional"` // 从父模板显式移除的动作（模块 -> 动作，* 表示整个模块）
 }
 
 type CreatePermissionTemplateResp struct {
@@ -34,14 +36,38 @@ type DeletePermissionTemplateResp struct {
 	Success bool `json:"success"`
 }
 
-type DisablePermissionTemplateReq struct {
+type DiffPermissionTemplateVersionsReq struct {
+	Id   string `path:"id"` // UUID v7
+	From int    `form:"from" validate:"min=1"`
+	To   int    `form:"to" validate:"min=1"`
+}
+
+type DiffPermissionTemplateVersionsResp struct {
+	TemplateId    string             `json:"template_id"`
+	FromVersion   int                `json:"from_version"`
+	ToVersion     int                `json:"to_version"`
+	Modules       []PolicyModuleDiff `json:"modules"`
+	AdvancedPerms []AdvancedPermDiff `json:"advanced_perms"`
+}
+
+type DiscardPermissionTemplateDraftReq struct {
 	Id string `path:"id"` // UUID v7
 }
 
-type DisablePermissionTemplateResp struct {
+type DiscardPermissionTemplateDraftResp struct {
 	Success bool `json:"success"`
 }
 
+type DisablePermissionTemplateReq struct {
+	Id       string `path:"id"`                 // UUID v7
+	AckToken string `json:"ack_token,optional"` // 影响预览返回的确认令牌（失去权限的用户数超过阈值时必填）
+}
+
+type DisablePermissionTemplateResp struct {
+	Success       bool           `json:"success"`
+	AffectedRoles []AffectedRole `json:"affected_roles"` // 引用该模板的角色（停用后这些角色不再授予权限）
+}
+
 type EnablePermissionTemplateReq struct {
 	Id string `path:"id"` // UUID v7
 }
@@ -50,6 +76,20 @@ type EnablePermissionTemplateResp struct {
 	Success bool `json:"success"`
 }
 
+type GetPermissionTemplateHistoryReq struct {
+	Id            string `path:"id"` // UUID v7
+	OperationType string `form:"operation_type,optional" validate:"omitempty,oneof=create update publish enable disable clone delete restore discard_draft"`
+	Page          int    `form:"page,default=1" validate:"min=1"`
+	PageSize      int    `form:"page_size,default=20" validate:"min=1,max=100"`
+}
+
+type GetPermissionTemplateHistoryResp struct {
+	Total    int64                        `json:"total"`
+	Page     int                          `json:"page"`
+	PageSize int                          `json:"page_size"`
+	Logs     []PermissionTemplateAuditLog `json:"logs"`
+}
+
 type GetPermissionTemplateReq struct {
 	Id string `path:"id"` // UUID v7
 }
@@ -58,10 +98,27 @@ type GetPermissionTemplateResp struct {
 	Data PermissionTemplateDetail `json:"data"`
 }
 
+type GetPermissionTemplateVersionReq struct {
+	Id      string `path:"id"` // UUID v7
+	Version int    `path:"version"`
+}
+
+type GetPermissionTemplateVersionResp struct {
+	Data PermissionTemplateVersion `json:"data"`
+}
+
+type ListPermissionTemplateVersionsReq struct {
+	Id string `path:"id"` // UUID v7
+}
+
+type ListPermissionTemplateVersionsResp struct {
+	Data []PermissionTemplateVersion `json:"data"`
+}
+
 type ListPermissionTemplatesReq struct {
 	Keyword         string `json:"keyword" validate:"max=128"`
 	Status          string `json:"status" validate:"omitempty,oneof=draft published disabled"`
-	ScopeSuggestion string `json:"scope_suggestion" validate:"omitempty,oneof=global organization domain project"`
+	ScopeSuggestion string `json:"scope_suggestion" validate:"omitempty,oneof=global organization domain project department department_tree self"`
 	Page            int    `json:"page" validate:"min=1"`
 	PageSize        int    `json:"page_size" validate:"min=1,max=100"`
 }
@@ -71,13 +128,45 @@ type ListPermissionTemplatesResp struct {
 	Data  []PermissionTemplateItem `json:"data"`
 }
 
+type PreviewPermissionTemplateImpactReq struct {
+	Id        string `path:"id"` // UUID v7
+	Operation string `form:"operation" validate:"required,oneof=publish disable"`
+}
+
+type PreviewPermissionTemplateImpactResp struct {
+	TemplateId        string                     `json:"template_id"`
+	Operation         string                     `json:"operation"`
+	FromVersion       int                        `json:"from_version"`
+	ToVersion         int                        `json:"to_version"`
+	BoundUserCount    int                        `json:"bound_user_count"`    // 绑定了使用该模板角色的用户数
+	AffectedUserCount int                        `json:"affected_user_count"` // 权限发生变化的用户数
+	LosingUserCount   int                        `json:"losing_user_count"`   // 失去权限的用户数
+	Departments       []TemplateImpactDepartment `json:"departments"`
+	Users             []TemplateImpactUser       `json:"users"`
+	AckRequired       bool                       `json:"ack_required"` // 执行操作时是否需提交确认令牌
+	AckToken          string                     `json:"ack_token"`    // 确认令牌（仅 ack_required 为 true 时返回）
+}
+
 type PublishPermissionTemplateReq struct {
-	Id string `path:"id"` // UUID v7
+	Id       string `path:"id"`                 // UUID v7
+	AckToken string `json:"ack_token,optional"` // 影响预览返回的确认令牌（失去权限的用户数超过阈值时必填）
 }
 
 type PublishPermissionTemplateResp struct {
-	Success bool `json:"success"`
-	Version int  `json:"version"`
+	Success          bool            `json:"success"`
+	Version          int             `json:"version"`
+	AffectedChildren []ChildTemplate `json:"affected_children"` // 继承该模板、有效策略矩阵随之变化的子模板
+}
+
+type RestorePermissionTemplateVersionReq struct {
+	Id      string `path:"id"` // UUID v7
+	Version int    `path:"version"`
+	Name    string `json:"name" validate:"required,max=128"`
+	Code    string `json:"code" validate:"required,max=64,lowercase_alphanum"`
+}
+
+type RestorePermissionTemplateVersionResp struct {
+	Id string `json:"id"`
 }
 
 type UpdatePermissionTemplateReq struct {
@@ -85,11 +174,14 @@ type UpdatePermissionTemplateReq struct {
 	Name            string                       `json:"name" validate:"required,max=128"`
 	Code            string                       `json:"code" validate:"required,max=64,lowercase_alphanum"`
 	Description     string                       `json:"description" validate:"max=500"`
-	ScopeSuggestion string                       `json:"scope_suggestion" validate:"omitempty,oneof=global organization domain project"`
+	ScopeSuggestion string                       `json:"scope_suggestion" validate:"omitempty,oneof=global organization domain project department department_tree self"`
 	PolicyMatrix    map[string]PolicyMatrixEntry `json:"policy_matrix" validate:"required"`
 	AdvancedPerms   map[string]AdvancedPermEntry `json:"advanced_perms"`
+	ParentId        string                       `json:"parent_id,optional"`       // 继承的父模板ID
+	PolicyRemovals  map[string][]string          `json:"policy_removals,optional"` // 从父模板显式移除的动作（模块 -> 动作，* 表示整个模块）
 }
 
 type UpdatePermissionTemplateResp struct {
-	Success bool `json:"success"`
+	Success           bool `json:"success"`
+	HasPendingChanges bool `json:"has_pending_changes"` // 已发布模板的修改保存为工作草稿，发布后生效
 }
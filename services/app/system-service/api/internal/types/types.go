@@ -3,11 +3,39 @@
 
 package types
 
+type AdvancedPermDiff struct {
+	Key           string `json:"key"`
+	ChangeType    string `json:"change_type"` // added/removed/modified
+	OldEnabled    bool   `json:"old_enabled"`
+	NewEnabled    bool   `json:"new_enabled"`
+	ConfigChanged bool   `json:"config_changed"`
+}
+
 type AdvancedPermEntry struct {
 	Enabled bool                   `json:"enabled"`
 	Config  map[string]interface{} `json:"config"`
 }
 
+type AffectedRole struct {
+	Id              string `json:"id"`
+	Code            string `json:"code"`
+	Name            string `json:"name"`
+	TemplateVersion int    `json:"template_version"`
+}
+
+type AuditEvent struct {
+	Id           string                 `json:"id"` // 资源类型:原日志ID
+	ResourceType string                 `json:"resource_type"`
+	ResourceId   string                 `json:"resource_id"`
+	Operation    string                 `json:"operation"`
+	OperatorId   string                 `json:"operator_id,optional"`
+	OperatorName string                 `json:"operator_name,optional"`
+	Changes      map[string]interface{} `json:"changes,optional"` // 字段变更（字段名 -> {old, new}）
+	OldValue     map[string]interface{} `json:"old_value,optional"`
+	NewValue     map[string]interface{} `json:"new_value,optional"`
+	CreatedAt    string                 `json:"created_at"`
+}
+
 type AuditLog struct {
 	Id         int64                  `json:"id"`
 	Action     string                 `json:"action"`
@@ -23,6 +51,53 @@ type AuditSummary struct {
 	LastOperationAt  string `json:"last_operation_at,optional"`
 }
 
+type AuthzCheckItem struct {
+	Subject      string `json:"subject" validate:"required"`       // 主体（用户ID）
+	ResourceType string `json:"resource_type" validate:"required"` // 资源类型（对应策略矩阵模块）
+	ResourceId   string `json:"resource_id,optional"`              // 资源ID（原样返回，供调用方关联结果）
+	Action       string `json:"action" validate:"required"`        // 动作
+}
+
+type AuthzDecision struct {
+	Subject         string `json:"subject"`
+	ResourceType    string `json:"resource_type"`
+	ResourceId      string `json:"resource_id"`
+	Action          string `json:"action"`
+	Allowed         bool   `json:"allowed"`
+	Reason          string `json:"reason"`           // 判定原因
+	RoleId          string `json:"role_id,optional"` // 命中的角色（历史绑定直接引用模板时为空）
+	RoleCode        string `json:"role_code,optional"`
+	TemplateId      string `json:"template_id,optional"` // 命中的权限模板
+	TemplateCode    string `json:"template_code,optional"`
+	TemplateVersion int    `json:"template_version,optional"`
+	Scope           string `json:"scope,optional"`  // 命中策略条目的数据范围
+	OrgId           string `json:"org_id,optional"` // 命中角色绑定所属组织
+}
+
+type CatalogAction struct {
+	Code string `json:"code" validate:"required,max=64"`
+	Name string `json:"name" validate:"required,max=128"`
+}
+
+type CatalogModule struct {
+	Id          string          `json:"id"`
+	Code        string          `json:"code"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Actions     []CatalogAction `json:"actions"`
+	Scopes      []string        `json:"scopes"`
+	SortOrder   int             `json:"sort_order"`
+	UpdatedAt   string          `json:"updated_at"`
+}
+
+type ChildTemplate struct {
+	Id       string `json:"id"`
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	ParentId string `json:"parent_id"`
+}
+
 type DeptUser struct {
 	UserId    string `json:"userId"`
 	UserName  string `json:"userName"`
@@ -51,6 +126,13 @@ type ImportError struct {
 	Reason string `json:"reason"`
 }
 
+type ImportInitialPassword struct {
+	Row             int    `json:"row"`
+	UserId          string `json:"user_id"`
+	Email           string `json:"email"`
+	InitialPassword string `json:"initial_password"` // 系统生成的初始密码，仅在导入结果中返回一次
+}
+
 type KeywordInfo struct {
 	Keyword string `form:"keyword,optional"` // 关键字查询
 }
@@ -96,11 +178,61 @@ type MenuAuditLog struct {
 	CreatedAt     string                 `json:"created_at"`
 }
 
+type MenuGroup struct {
+	Id          string `json:"id"` // UUID v7
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Order       int    `json:"order"`
+	Icon        string `json:"icon,optional"`
+	Description string `json:"description,optional"`
+	MenuCount   int64  `json:"menu_count"` // 分组下的菜单数量
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type MenuImportChange struct {
+	Action        string   `json:"action"` // create/update/move/delete
+	Code          string   `json:"code"`
+	MenuId        string   `json:"menu_id"`                  // 已有菜单保留原ID；新建菜单在预览时为空
+	ChangedFields []string `json:"changed_fields,optional"`  // 变更字段（update/move）
+	OldParentCode string   `json:"old_parent_code,optional"` // 原父菜单编码（move/delete）
+	NewParentCode string   `json:"new_parent_code,optional"` // 新父菜单编码（create/move）
+}
+
+type MenuImportError struct {
+	Code   string `json:"code"`   // 菜单编码
+	Reason string `json:"reason"` // 校验失败原因
+}
+
 type MenuOperationError struct {
 	Id     string `json:"id"`
 	Reason string `json:"reason"`
 }
 
+type ModulePermission struct {
+	Actions []string `json:"actions"`
+	Scopes  []string `json:"scopes"`           // global/organization/domain/project
+	OrgIds  []string `json:"org_ids,optional"` // organization 范围下可访问的组织ID
+}
+
+type NavMenu struct {
+	Id            string    `json:"id"` // UUID v7
+	Name          string    `json:"name"`
+	Code          string    `json:"code"`
+	Type          string    `json:"type"` // directory/page/external
+	ParentId      string    `json:"parent_id,optional"`
+	Path          string    `json:"path,optional"`
+	RouteName     string    `json:"route_name,optional"`
+	ComponentKey  string    `json:"component_key,optional"`
+	ExternalUrl   string    `json:"external_url,optional"`
+	OpenMode      string    `json:"open_mode,optional"` // new/iframe/same
+	PermissionKey string    `json:"permission_key,optional"`
+	Icon          string    `json:"icon,optional"`
+	Order         int       `json:"order"`
+	Cacheable     bool      `json:"cacheable"`
+	Children      []NavMenu `json:"children,optional"` // 子菜单（树形结构）
+}
+
 type OperationError struct {
 	UserId string `json:"user_id"`
 	Reason string `json:"reason"`
@@ -111,6 +243,19 @@ type OrderUpdate struct {
 	Order int    `json:"order" validate:"required,min=0"`
 }
 
+type OrgAuditLog struct {
+	Id           string                 `json:"id"`
+	OrgId        string                 `json:"orgId"`
+	OrgName      string                 `json:"orgName"`
+	Operation    string                 `json:"operation"`
+	OperatorId   string                 `json:"operatorId"`
+	OperatorName string                 `json:"operatorName"`
+	OldValue     map[string]interface{} `json:"oldValue,optional"`
+	NewValue     map[string]interface{} `json:"newValue,optional"`
+	Changes      map[string]interface{} `json:"changes,optional"` // 字段变更（字段名 -> {old, new}）
+	CreatedAt    string                 `json:"createdAt"`
+}
+
 type OrgDetail struct {
 	Id         string `json:"id"`
 	ParentId   string `json:"parentId"`
@@ -162,32 +307,118 @@ type PageResp struct {
 	TotalCount int64       `json:"total_count"` // 总记录数
 }
 
+type PermissionPoint struct {
+	Id            string `json:"id"`
+	PermissionKey string `json:"permission_key"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Module        string `json:"module"`
+	Description   string `json:"description"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+type PermissionPointMenuRef struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Code string `json:"code"`
+	Type string `json:"type"`
+}
+
+type PermissionPointTemplateRef struct {
+	Id      string `json:"id"`
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Version int    `json:"version"`
+	Source  string `json:"source"` // 授予来源：policy_matrix / advanced_perms
+}
+
+type PermissionSource struct {
+	OrgId           string `json:"org_id"`
+	RoleId          string `json:"role_id,optional"`
+	RoleCode        string `json:"role_code,optional"`
+	TemplateId      string `json:"template_id"`
+	TemplateCode    string `json:"template_code"`
+	TemplateVersion int    `json:"template_version"`
+}
+
+type PermissionTemplateAuditLog struct {
+	Id            string                 `json:"id"`
+	TemplateId    string                 `json:"template_id"`
+	OperationType string                 `json:"operation_type"`
+	OperatorId    string                 `json:"operator_id"`
+	OperatorName  string                 `json:"operator_name"`
+	Version       int                    `json:"version"`
+	Changes       map[string]interface{} `json:"changes,optional"`   // 变更差异：字段 -> {old, new}，policy_matrix/advanced_perms 按模块/权限点展开
+	OldValue      map[string]interface{} `json:"old_value,optional"` // 旧值
+	NewValue      map[string]interface{} `json:"new_value,optional"` // 新值
+	Remark        string                 `json:"remark,optional"`
+	CreatedAt     string                 `json:"created_at"`
+}
+
 type PermissionTemplateDetail struct {
-	Id              string                       `json:"id"`
+	Id                    string                       `json:"id"`
+	Name                  string                       `json:"name"`
+	Code                  string                       `json:"code"`
+	Description           string                       `json:"description"`
+	Status                string                       `json:"status"`
+	ScopeSuggestion       string                       `json:"scope_suggestion"`
+	PolicyMatrix          map[string]PolicyMatrixEntry `json:"policy_matrix"` // 模板自身的策略矩阵
+	AdvancedPerms         map[string]AdvancedPermEntry `json:"advanced_perms"`
+	ParentId              string                       `json:"parent_id"`               // 继承的父模板ID
+	PolicyRemovals        map[string][]string          `json:"policy_removals"`         // 从父模板显式移除的动作
+	EffectivePolicyMatrix map[string]PolicyMatrixEntry `json:"effective_policy_matrix"` // 合并父模板后的有效策略矩阵
+	Children              []ChildTemplate              `json:"children"`                // 直接继承该模板的子模板
+	Version               int                          `json:"version"`
+	UsedByRoleCount       int64                        `json:"used_by_role_count"`
+	LastAppliedAt         string                       `json:"last_applied_at"`
+	CreatedBy             string                       `json:"created_by"`
+	CreatedAt             string                       `json:"created_at"`
+	UpdatedBy             string                       `json:"updated_by"`
+	UpdatedAt             string                       `json:"updated_at"`
+	HasPendingChanges     bool                         `json:"has_pending_changes"`     // 是否存在待发布的工作草稿
+	PendingDraft          *PermissionTemplateDraft     `json:"pending_draft,omitempty"` // 待发布的工作草稿
+}
+
+type PermissionTemplateDraft struct {
 	Name            string                       `json:"name"`
 	Code            string                       `json:"code"`
 	Description     string                       `json:"description"`
-	Status          string                       `json:"status"`
 	ScopeSuggestion string                       `json:"scope_suggestion"`
 	PolicyMatrix    map[string]PolicyMatrixEntry `json:"policy_matrix"`
 	AdvancedPerms   map[string]AdvancedPermEntry `json:"advanced_perms"`
-	Version         int                          `json:"version"`
-	UsedByRoleCount int64                        `json:"used_by_role_count"`
-	LastAppliedAt   string                       `json:"last_applied_at"`
-	CreatedBy       string                       `json:"created_by"`
-	CreatedAt       string                       `json:"created_at"`
+	ParentId        string                       `json:"parent_id"`
+	PolicyRemovals  map[string][]string          `json:"policy_removals"`
+	BaseVersion     int                          `json:"base_version"` // 草稿基于的已发布版本号
 	UpdatedBy       string                       `json:"updated_by"`
 	UpdatedAt       string                       `json:"updated_at"`
 }
 
 type PermissionTemplateItem struct {
-	Id              string `json:"id"`
-	Name            string `json:"name"`
-	Code            string `json:"code"`
-	Status          string `json:"status"`
-	ScopeSuggestion string `json:"scope_suggestion"`
-	Version         int    `json:"version"`
-	UpdatedAt       string `json:"updated_at"`
+	Id                string `json:"id"`
+	Name              string `json:"name"`
+	Code              string `json:"code"`
+	Status            string `json:"status"`
+	ScopeSuggestion   string `json:"scope_suggestion"`
+	ParentId          string `json:"parent_id"` // 继承的父模板ID
+	Version           int    `json:"version"`
+	UpdatedAt         string `json:"updated_at"`
+	HasPendingChanges bool   `json:"has_pending_changes"` // 是否存在待发布的工作草稿
+}
+
+type PermissionTemplateVersion struct {
+	TemplateId      string                       `json:"template_id"`
+	Version         int                          `json:"version"`
+	Name            string                       `json:"name"`
+	Code            string                       `json:"code"`
+	Description     string                       `json:"description"`
+	ScopeSuggestion string                       `json:"scope_suggestion"`
+	PolicyMatrix    map[string]PolicyMatrixEntry `json:"policy_matrix,omitempty"`  // 列表接口不返回
+	AdvancedPerms   map[string]AdvancedPermEntry `json:"advanced_perms,omitempty"` // 列表接口不返回
+	IsCurrent       bool                         `json:"is_current"`               // 是否为模板当前版本
+	PublishedBy     string                       `json:"published_by"`
+	PublishedAt     string                       `json:"published_at"`
 }
 
 type PolicyMatrixEntry struct {
@@ -195,6 +426,15 @@ type PolicyMatrixEntry struct {
 	Scope   string   `json:"scope"`
 }
 
+type PolicyModuleDiff struct {
+	Module         string   `json:"module"`
+	ChangeType     string   `json:"change_type"` // added/removed/modified
+	AddedActions   []string `json:"added_actions"`
+	RemovedActions []string `json:"removed_actions"`
+	OldScope       string   `json:"old_scope"`
+	NewScope       string   `json:"new_scope"`
+}
+
 type RiskItem struct {
 	MenuId      string `json:"menu_id"`
 	MenuName    string `json:"menu_name"`
@@ -208,13 +448,69 @@ type RoleBinding struct {
 	UserId         string `json:"user_id"`
 	OrgId          string `json:"org_id"`
 	Position       string `json:"position,optional"`
+	RoleId         string `json:"role_id,optional"`
 	PermissionRole string `json:"permission_role,optional"`
 }
 
 type RoleBindingInput struct {
 	OrgId          string `json:"org_id" validate:"required"`
 	Position       string `json:"position,optional"`
-	PermissionRole string `json:"permission_role,optional"`
+	RoleId         string `json:"role_id,optional"`         // 角色ID（优先）
+	PermissionRole string `json:"permission_role,optional"` // 角色编码（兼容旧版，RoleId 为空时使用）
+}
+
+type RoleDetail struct {
+	Id                string `json:"id"`
+	Code              string `json:"code"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	Scope             string `json:"scope"`
+	OrgId             string `json:"org_id"`
+	TemplateId        string `json:"template_id"`
+	TemplateCode      string `json:"template_code"`
+	TemplateName      string `json:"template_name"`
+	TemplateVersion   int    `json:"template_version"`
+	TemplateAppliedAt string `json:"template_applied_at"`
+	BindingCount      int64  `json:"binding_count"` // 引用该角色的角色绑定数量
+	CreatedBy         string `json:"created_by"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedBy         string `json:"updated_by"`
+	UpdatedAt         string `json:"updated_at"`
+}
+
+type RoleItem struct {
+	Id              string `json:"id"`
+	Code            string `json:"code"`
+	Name            string `json:"name"`
+	Scope           string `json:"scope"`
+	OrgId           string `json:"org_id"`
+	TemplateId      string `json:"template_id"`
+	TemplateVersion int    `json:"template_version"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+type TemplateImpactAction struct {
+	Module    string `json:"module"`
+	Action    string `json:"action"`
+	UserCount int    `json:"user_count"` // 部门内发生该变化的用户数
+}
+
+type TemplateImpactDepartment struct {
+	DeptId          string                 `json:"dept_id"` // 用户主部门ID，未分配部门时为空
+	DeptName        string                 `json:"dept_name"`
+	UserCount       int                    `json:"user_count"`        // 权限发生变化的用户数
+	LosingUserCount int                    `json:"losing_user_count"` // 失去权限的用户数
+	Gained          []TemplateImpactAction `json:"gained"`
+	Lost            []TemplateImpactAction `json:"lost"`
+}
+
+type TemplateImpactUser struct {
+	UserId   string   `json:"user_id"`
+	UserName string   `json:"user_name"`
+	DeptId   string   `json:"dept_id"`
+	DeptName string   `json:"dept_name"`
+	Gained   []string `json:"gained"` // 新增的权限（模块:动作）
+	Lost     []string `json:"lost"`   // 失去的权限（模块:动作）
 }
 
 type User struct {
@@ -232,6 +528,11 @@ type User struct {
 	UpdatedBy     string `json:"updated_by,optional"`
 }
 
+type UserDepartment struct {
+	DeptId    string `json:"dept_id"`
+	IsPrimary bool   `json:"is_primary"`
+}
+
 type UserInfo struct {
 	Id           string `json:"id"`
 	FirstName    string `json:"first_name"`
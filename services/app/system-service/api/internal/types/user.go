@@ -7,6 +7,25 @@ type GetUserInfoResp struct {
 	UserInfo UserInfo `json:"user_info"`
 }
 
+type ListSessionsResp struct {
+	Sessions []SessionInfo `json:"sessions"`
+}
+
 type LogoutResp struct {
 	Message string `json:"message"`
 }
+
+type RevokeSessionReq struct {
+	Id string `path:"id"`
+}
+
+type SessionInfo struct {
+	Id           string `json:"id"`
+	DeviceName   string `json:"device_name"`
+	UserAgent    string `json:"user_agent"`
+	ClientIp     string `json:"client_ip"`
+	CreatedAt    string `json:"created_at"`
+	LastActiveAt string `json:"last_active_at"`
+	ExpiresAt    string `json:"expires_at"`
+	Current      bool   `json:"current"`
+}
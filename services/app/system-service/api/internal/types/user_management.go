@@ -8,11 +8,12 @@ type BatchImportReq struct {
 }
 
 type BatchImportResp struct {
-	TotalRows    int           `json:"total_rows"`
-	SuccessCount int           `json:"success_count"`
-	FailedCount  int           `json:"failed_count"`
-	Errors       []ImportError `json:"errors,optional"`
-	UserIds      []string      `json:"user_ids"`
+	TotalRows        int                     `json:"total_rows"`
+	SuccessCount     int                     `json:"success_count"`
+	FailedCount      int                     `json:"failed_count"`
+	Errors           []ImportError           `json:"errors,optional"`
+	UserIds          []string                `json:"user_ids"`
+	InitialPasswords []ImportInitialPassword `json:"initial_passwords,optional"` // 未填写初始密码的本地账号由系统生成
 }
 
 type BatchUpdateStatusReq struct {
@@ -56,6 +57,31 @@ type DeleteUserResp struct {
 type EmptyResp struct {
 }
 
+type ExportUsersReq struct {
+	Keyword        string `form:"keyword,optional"`
+	DeptId         string `form:"dept_id,optional"`
+	Status         int8   `form:"status,optional"`
+	AccountSource  string `form:"account_source,optional"`
+	PermissionRole string `form:"permission_role,optional"`
+	SortField      string `form:"sort_field,optional"`                 // 导出按创建时间游标分批读取，仅支持 created_at
+	SortOrder      string `form:"sort_order,optional"`                 // asc,desc（按创建时间）
+	Format         string `form:"format,default=csv,options=csv|xlsx"` // 导出格式
+	Columns        string `form:"columns,optional"`                    // 导出列（逗号分隔，为空导出全部列）
+}
+
+type ForceLogoutReq struct {
+	Reason string `json:"reason,optional"`
+}
+
+type GetEffectivePermissionsResp struct {
+	UserId         string                      `json:"user_id"`
+	Modules        map[string]ModulePermission `json:"modules"`         // 模块 -> 合并后的动作与数据范围
+	AdvancedPerms  []string                    `json:"advanced_perms"`  // 已启用的高级权限点
+	PermissionKeys []string                    `json:"permission_keys"` // 前端应启用的菜单/按钮权限标识
+	Departments    []UserDepartment            `json:"departments"`     // 主部门与辅助部门
+	Sources        []PermissionSource          `json:"sources"`         // 授权来源（角色绑定 -> 角色 -> 模板）
+}
+
 type GetStatisticsResp struct {
 	Total            int64   `json:"total"`
 	Active           int64   `json:"active"`
@@ -7,6 +7,7 @@ type LoginReq struct {
 	Email      string `json:"email" validate:"required,email"`
 	Password   string `json:"password" validate:"required"`
 	RememberMe bool   `json:"remember_me"`
+	DeviceName string `json:"device_name,optional"`
 }
 
 type LoginResp struct {
@@ -16,6 +17,16 @@ type LoginResp struct {
 	UserInfo     UserInfo `json:"user_info"`
 }
 
+type RefreshTokenReq struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type RefreshTokenResp struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
 type RegisterReq struct {
 	FirstName       string `json:"first_name" validate:"required,min=1,max=50"`
 	LastName        string `json:"last_name" validate:"required,min=1,max=50"`
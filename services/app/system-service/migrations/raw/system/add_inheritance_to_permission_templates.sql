@@ -0,0 +1,16 @@
+-- 为权限模板及工作草稿添加继承字段
+-- 执行时间: 2026-10-17
+-- 说明: 模板可声明父模板，有效策略矩阵 = 父模板有效策略矩阵 + 自身策略矩阵 - 显式移除项
+
+ALTER TABLE `permission_templates`
+ADD COLUMN `parent_id` CHAR(36) NULL DEFAULT NULL COMMENT '父模板ID（继承父模板的有效策略矩阵）'
+AFTER `scope_suggestion`,
+ADD COLUMN `policy_removals` JSON NULL DEFAULT NULL COMMENT '从父模板继承时显式移除的模块动作（模块 -> 动作列表，* 表示整个模块）'
+AFTER `policy_matrix`,
+ADD KEY `idx_parent_id` (`parent_id`);
+
+ALTER TABLE `permission_template_drafts`
+ADD COLUMN `parent_id` CHAR(36) NULL DEFAULT NULL COMMENT '父模板ID'
+AFTER `scope_suggestion`,
+ADD COLUMN `policy_removals` JSON NULL DEFAULT NULL COMMENT '从父模板继承时显式移除的模块动作'
+AFTER `policy_matrix`;
@@ -0,0 +1,53 @@
+-- 统一审计事件视图（合并用户、组织架构、菜单、权限模板审计日志）
+CREATE OR REPLACE VIEW `audit_events` AS
+SELECT
+    CONCAT('user:', a.`id`) COLLATE utf8mb4_unicode_ci AS `id`,
+    'user' COLLATE utf8mb4_unicode_ci AS `resource_type`,
+    a.`user_id` COLLATE utf8mb4_unicode_ci AS `resource_id`,
+    a.`action` COLLATE utf8mb4_unicode_ci AS `operation`,
+    a.`operator_id` COLLATE utf8mb4_unicode_ci AS `operator_id`,
+    a.`operator` COLLATE utf8mb4_unicode_ci AS `operator_name`,
+    a.`changes` AS `changes`,
+    NULL AS `old_value`,
+    NULL AS `new_value`,
+    CAST(a.`timestamp` AS DATETIME(3)) AS `created_at`
+FROM `audit_logs` a
+UNION ALL
+SELECT
+    CONCAT('organization:', o.`id`) COLLATE utf8mb4_unicode_ci,
+    'organization' COLLATE utf8mb4_unicode_ci,
+    o.`org_id` COLLATE utf8mb4_unicode_ci,
+    o.`operation` COLLATE utf8mb4_unicode_ci,
+    o.`operator_id` COLLATE utf8mb4_unicode_ci,
+    NULL,
+    NULL,
+    o.`old_value`,
+    o.`new_value`,
+    CAST(o.`created_at` AS DATETIME(3))
+FROM `sys_organization_audit` o
+UNION ALL
+SELECT
+    CONCAT('menu:', m.`id`) COLLATE utf8mb4_unicode_ci,
+    'menu' COLLATE utf8mb4_unicode_ci,
+    m.`menu_id` COLLATE utf8mb4_unicode_ci,
+    m.`operation_type` COLLATE utf8mb4_unicode_ci,
+    m.`operator_id` COLLATE utf8mb4_unicode_ci,
+    m.`operator_name` COLLATE utf8mb4_unicode_ci,
+    NULL,
+    m.`old_value`,
+    m.`new_value`,
+    m.`created_at`
+FROM `menu_audit_logs` m
+UNION ALL
+SELECT
+    CONCAT('permission_template:', p.`id`) COLLATE utf8mb4_unicode_ci,
+    'permission_template' COLLATE utf8mb4_unicode_ci,
+    p.`template_id` COLLATE utf8mb4_unicode_ci,
+    p.`operation_type` COLLATE utf8mb4_unicode_ci,
+    p.`operator_id` COLLATE utf8mb4_unicode_ci,
+    p.`operator_name` COLLATE utf8mb4_unicode_ci,
+    p.`changes`,
+    p.`old_value`,
+    p.`new_value`,
+    p.`created_at`
+FROM `permission_template_audit_logs` p;
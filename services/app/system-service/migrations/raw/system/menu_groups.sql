@@ -0,0 +1,17 @@
+-- 菜单分组表
+CREATE TABLE `menu_groups` (
+    `id` CHAR(36) NOT NULL COMMENT 'ID (UUID v7)',
+    `code` VARCHAR(64) NOT NULL COMMENT '分组编码（全局唯一）',
+    `name` VARCHAR(128) NOT NULL COMMENT '分组名称',
+    `order` INT NOT NULL DEFAULT 0 COMMENT '排序号（升序）',
+    `icon` VARCHAR(64) DEFAULT NULL COMMENT '图标名称',
+    `description` VARCHAR(500) DEFAULT NULL COMMENT '描述',
+    `created_by` CHAR(36) NOT NULL COMMENT '创建人ID',
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+    `updated_by` CHAR(36) DEFAULT NULL COMMENT '最后更新人ID',
+    `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '最后更新时间',
+    `deleted_at` DATETIME(3) DEFAULT NULL COMMENT '删除时间（软删除）',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_code_deleted` (`code`, `deleted_at`),
+    KEY `idx_order` (`order`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='菜单分组表';
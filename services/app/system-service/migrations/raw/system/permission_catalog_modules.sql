@@ -0,0 +1,17 @@
+-- 权限目录模块表
+CREATE TABLE `permission_catalog_modules` (
+    `id` CHAR(36) NOT NULL COMMENT 'ID (UUID v7)',
+    `code` VARCHAR(64) NOT NULL COMMENT '模块编码（策略矩阵的键，全局唯一）',
+    `name` VARCHAR(128) NOT NULL COMMENT '模块名称',
+    `description` VARCHAR(500) DEFAULT NULL COMMENT '模块描述',
+    `actions` JSON NOT NULL COMMENT '允许的动作列表',
+    `scopes` JSON NOT NULL COMMENT '允许的数据范围列表',
+    `sort_order` INT NOT NULL DEFAULT 0 COMMENT '排序号（升序）',
+    `created_by` CHAR(36) NOT NULL COMMENT '创建人ID',
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+    `updated_by` CHAR(36) DEFAULT NULL COMMENT '最后更新人ID',
+    `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '最后更新时间',
+    `deleted_at` DATETIME(3) DEFAULT NULL COMMENT '删除时间（软删除）',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_code_deleted` (`code`, `deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='权限目录模块表';
@@ -0,0 +1,18 @@
+-- 权限点表
+CREATE TABLE `permission_points` (
+    `id` CHAR(36) NOT NULL COMMENT 'ID (UUID v7)',
+    `permission_key` VARCHAR(128) NOT NULL COMMENT '权限标识（全局唯一）',
+    `name` VARCHAR(128) NOT NULL COMMENT '权限名称',
+    `type` VARCHAR(16) NOT NULL COMMENT '类型：menu/button/api',
+    `module` VARCHAR(64) NOT NULL COMMENT '所属模块（权限目录模块编码）',
+    `description` VARCHAR(500) DEFAULT NULL COMMENT '描述',
+    `created_by` CHAR(36) NOT NULL COMMENT '创建人ID',
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+    `updated_by` CHAR(36) DEFAULT NULL COMMENT '最后更新人ID',
+    `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '最后更新时间',
+    `deleted_at` DATETIME(3) DEFAULT NULL COMMENT '删除时间（软删除）',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_permission_key_deleted` (`permission_key`, `deleted_at`),
+    KEY `idx_module` (`module`),
+    KEY `idx_type` (`type`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='权限点表';
@@ -0,0 +1,19 @@
+-- 权限模板审计日志表
+CREATE TABLE `permission_template_audit_logs` (
+    `id` CHAR(36) NOT NULL COMMENT 'ID (UUID v7)',
+    `template_id` VARCHAR(36) NOT NULL COMMENT '权限模板ID',
+    `operation_type` VARCHAR(20) NOT NULL COMMENT '操作类型：create/update/publish/enable/disable/clone/delete/restore/discard_draft',
+    `operator_id` VARCHAR(36) DEFAULT NULL COMMENT '操作人ID',
+    `operator_name` VARCHAR(128) DEFAULT NULL COMMENT '操作人名称',
+    `version` INT NOT NULL DEFAULT 1 COMMENT '操作后的模板版本号',
+    `changes` JSON DEFAULT NULL COMMENT '变更差异（JSON格式，字段 -> {old, new}）',
+    `old_value` JSON DEFAULT NULL COMMENT '旧值（JSON格式）',
+    `new_value` JSON DEFAULT NULL COMMENT '新值（JSON格式）',
+    `remark` VARCHAR(512) DEFAULT NULL COMMENT '备注',
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_template_id` (`template_id`),
+    KEY `idx_operation_type` (`operation_type`),
+    KEY `idx_operator_id` (`operator_id`),
+    KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='权限模板审计日志表';
@@ -0,0 +1,16 @@
+-- 权限模板工作草稿表
+CREATE TABLE `permission_template_drafts` (
+    `template_id` CHAR(36) NOT NULL COMMENT '模板ID',
+    `name` VARCHAR(128) NOT NULL COMMENT '模板名称',
+    `code` VARCHAR(64) NOT NULL COMMENT '模板编码',
+    `description` VARCHAR(500) DEFAULT NULL COMMENT '模板描述',
+    `scope_suggestion` VARCHAR(50) DEFAULT NULL COMMENT '推荐适用范围',
+    `policy_matrix` JSON NOT NULL COMMENT '策略矩阵',
+    `advanced_perms` JSON DEFAULT NULL COMMENT '高级权限点配置',
+    `base_version` INT NOT NULL COMMENT '草稿基于的已发布版本号',
+    `created_by` CHAR(36) NOT NULL COMMENT '创建人ID',
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+    `updated_by` CHAR(36) DEFAULT NULL COMMENT '最后更新人ID',
+    `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '最后更新时间',
+    PRIMARY KEY (`template_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='权限模板工作草稿表';
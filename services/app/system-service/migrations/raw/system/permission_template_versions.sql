@@ -0,0 +1,16 @@
+-- 权限模板版本快照表
+CREATE TABLE `permission_template_versions` (
+    `id` CHAR(36) NOT NULL COMMENT 'ID (UUID v7)',
+    `template_id` CHAR(36) NOT NULL COMMENT '模板ID',
+    `version` INT NOT NULL COMMENT '版本号',
+    `name` VARCHAR(128) NOT NULL COMMENT '发布时的模板名称',
+    `code` VARCHAR(64) NOT NULL COMMENT '发布时的模板编码',
+    `description` VARCHAR(500) DEFAULT NULL COMMENT '发布时的模板描述',
+    `scope_suggestion` VARCHAR(50) DEFAULT NULL COMMENT '发布时的推荐适用范围',
+    `policy_matrix` JSON NOT NULL COMMENT '策略矩阵快照',
+    `advanced_perms` JSON DEFAULT NULL COMMENT '高级权限点快照',
+    `published_by` CHAR(36) NOT NULL COMMENT '发布人ID',
+    `published_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '发布时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_template_version` (`template_id`, `version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='权限模板版本快照表';
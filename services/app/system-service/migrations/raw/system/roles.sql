@@ -0,0 +1,22 @@
+-- 角色表
+CREATE TABLE `roles` (
+    `id` CHAR(36) NOT NULL COMMENT 'ID (UUID v7)',
+    `code` VARCHAR(64) NOT NULL COMMENT '角色编码（全局唯一）',
+    `name` VARCHAR(128) NOT NULL COMMENT '角色名称',
+    `description` VARCHAR(500) DEFAULT NULL COMMENT '角色描述',
+    `scope` VARCHAR(50) NOT NULL DEFAULT 'global' COMMENT '适用范围：global/organization/domain/project',
+    `org_id` VARCHAR(36) DEFAULT NULL COMMENT '组织范围（scope=organization 时必填）',
+    `template_id` CHAR(36) NOT NULL COMMENT '来源权限模板ID',
+    `template_version` INT NOT NULL DEFAULT 1 COMMENT '固定的模板版本号',
+    `template_applied_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '最近一次应用模板的时间',
+    `created_by` CHAR(36) NOT NULL COMMENT '创建人ID',
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+    `updated_by` CHAR(36) DEFAULT NULL COMMENT '最后更新人ID',
+    `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '最后更新时间',
+    `deleted_at` DATETIME(3) DEFAULT NULL COMMENT '删除时间（软删除）',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_code_deleted` (`code`, `deleted_at`),
+    KEY `idx_scope` (`scope`),
+    KEY `idx_org_id` (`org_id`),
+    KEY `idx_template_id` (`template_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='角色表';
@@ -0,0 +1,4 @@
+-- 角色绑定引用角色ID（需先创建 system 库 roles 表）
+ALTER TABLE `role_bindings`
+    ADD COLUMN `role_id` CHAR(36) DEFAULT NULL COMMENT '角色ID（roles.id）' AFTER `position`,
+    ADD KEY `idx_role_id` (`role_id`);
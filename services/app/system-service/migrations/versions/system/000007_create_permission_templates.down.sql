@@ -0,0 +1,3 @@
+-- 回滚: 删除权限模板表
+
+DROP TABLE IF EXISTS `permission_templates`;
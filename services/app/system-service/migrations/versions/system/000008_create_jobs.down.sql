@@ -0,0 +1,3 @@
+-- 回滚: 删除异步任务表
+
+DROP TABLE IF EXISTS `jobs`;
@@ -0,0 +1,25 @@
+-- 创建异步任务表
+-- 用于批量导入、导出等长耗时操作，由 worker 池异步执行
+
+CREATE TABLE IF NOT EXISTS `jobs` (
+    `id` CHAR(36) NOT NULL COMMENT 'ID (UUID v7)',
+    `type` VARCHAR(50) NOT NULL COMMENT '任务类型，如 user_import/user_export',
+    `status` VARCHAR(20) NOT NULL DEFAULT 'pending' COMMENT '任务状态：pending/running/succeeded/failed/canceled',
+    `progress` INT NOT NULL DEFAULT 0 COMMENT '进度（0-100）',
+    `params` JSON DEFAULT NULL COMMENT '任务参数',
+    `result` JSON DEFAULT NULL COMMENT '任务结果',
+    `result_path` VARCHAR(500) DEFAULT NULL COMMENT '结果文件存储位置',
+    `result_name` VARCHAR(255) DEFAULT NULL COMMENT '结果文件下载名称',
+    `error_message` VARCHAR(1000) DEFAULT NULL COMMENT '失败原因',
+    `cancel_requested` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否已请求取消',
+    `created_by` CHAR(36) NOT NULL COMMENT '提交人ID',
+    `started_at` DATETIME(3) DEFAULT NULL COMMENT '开始执行时间',
+    `finished_at` DATETIME(3) DEFAULT NULL COMMENT '结束时间',
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+    `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间（运行中作为心跳）',
+    PRIMARY KEY (`id`),
+    KEY `idx_type` (`type`),
+    KEY `idx_status` (`status`),
+    KEY `idx_created_by` (`created_by`),
+    KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='异步任务表';
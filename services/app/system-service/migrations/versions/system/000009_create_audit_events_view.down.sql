@@ -0,0 +1,3 @@
+-- 回滚: 删除统一审计事件视图
+
+DROP VIEW IF EXISTS `audit_events`;
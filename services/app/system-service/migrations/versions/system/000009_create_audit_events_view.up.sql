@@ -0,0 +1,44 @@
+-- 创建统一审计事件视图
+-- 合并用户（audit_logs）、组织架构（sys_organization_audit）、菜单（menu_audit_logs）审计日志，供统一审计查询使用
+-- 依赖 user 迁移中的 audit_logs 表，需在 user/000004_create_audit_logs 之后执行
+-- 事件ID 为 "资源类型:原日志ID"，保证跨表唯一，用于游标分页
+
+CREATE OR REPLACE VIEW `audit_events` AS
+SELECT
+    CONCAT('user:', a.`id`) COLLATE utf8mb4_unicode_ci AS `id`,
+    'user' COLLATE utf8mb4_unicode_ci AS `resource_type`,
+    a.`user_id` COLLATE utf8mb4_unicode_ci AS `resource_id`,
+    a.`action` COLLATE utf8mb4_unicode_ci AS `operation`,
+    a.`operator_id` COLLATE utf8mb4_unicode_ci AS `operator_id`,
+    a.`operator` COLLATE utf8mb4_unicode_ci AS `operator_name`,
+    a.`changes` AS `changes`,
+    NULL AS `old_value`,
+    NULL AS `new_value`,
+    CAST(a.`timestamp` AS DATETIME(3)) AS `created_at`
+FROM `audit_logs` a
+UNION ALL
+SELECT
+    CONCAT('organization:', o.`id`) COLLATE utf8mb4_unicode_ci,
+    'organization' COLLATE utf8mb4_unicode_ci,
+    o.`org_id` COLLATE utf8mb4_unicode_ci,
+    o.`operation` COLLATE utf8mb4_unicode_ci,
+    o.`operator_id` COLLATE utf8mb4_unicode_ci,
+    NULL,
+    NULL,
+    o.`old_value`,
+    o.`new_value`,
+    CAST(o.`created_at` AS DATETIME(3))
+FROM `sys_organization_audit` o
+UNION ALL
+SELECT
+    CONCAT('menu:', m.`id`) COLLATE utf8mb4_unicode_ci,
+    'menu' COLLATE utf8mb4_unicode_ci,
+    m.`menu_id` COLLATE utf8mb4_unicode_ci,
+    m.`operation_type` COLLATE utf8mb4_unicode_ci,
+    m.`operator_id` COLLATE utf8mb4_unicode_ci,
+    m.`operator_name` COLLATE utf8mb4_unicode_ci,
+    NULL,
+    m.`old_value`,
+    m.`new_value`,
+    m.`created_at`
+FROM `menu_audit_logs` m;
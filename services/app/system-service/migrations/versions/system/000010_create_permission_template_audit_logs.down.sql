@@ -0,0 +1,3 @@
+-- 回滚: 删除权限模板审计日志表
+
+DROP TABLE IF EXISTS `permission_template_audit_logs`;
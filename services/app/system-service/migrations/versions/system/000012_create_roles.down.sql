@@ -0,0 +1,3 @@
+-- 回滚: 删除角色表
+
+DROP TABLE IF EXISTS `roles`;
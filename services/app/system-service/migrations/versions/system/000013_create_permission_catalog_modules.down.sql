@@ -0,0 +1,3 @@
+-- 回滚: 删除权限目录模块表
+
+DROP TABLE IF EXISTS `permission_catalog_modules`;
@@ -0,0 +1,3 @@
+-- 回滚: 删除权限点表
+
+DROP TABLE IF EXISTS `permission_points`;
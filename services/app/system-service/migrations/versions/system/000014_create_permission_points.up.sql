@@ -0,0 +1,30 @@
+-- 创建权限点表
+-- 登记菜单、按钮、接口使用的权限标识，菜单绑定权限时校验标识已登记
+
+CREATE TABLE IF NOT EXISTS `permission_points` (
+    `id` CHAR(36) NOT NULL COMMENT 'ID (UUID v7)',
+    `permission_key` VARCHAR(128) NOT NULL COMMENT '权限标识（全局唯一）',
+    `name` VARCHAR(128) NOT NULL COMMENT '权限名称',
+    `type` VARCHAR(16) NOT NULL COMMENT '类型：menu/button/api',
+    `module` VARCHAR(64) NOT NULL COMMENT '所属模块（权限目录模块编码）',
+    `description` VARCHAR(500) DEFAULT NULL COMMENT '描述',
+    `created_by` CHAR(36) NOT NULL COMMENT '创建人ID',
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+    `updated_by` CHAR(36) DEFAULT NULL COMMENT '最后更新人ID',
+    `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '最后更新时间',
+    `deleted_at` DATETIME(3) DEFAULT NULL COMMENT '删除时间（软删除）',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_permission_key_deleted` (`permission_key`, `deleted_at`),
+    KEY `idx_module` (`module`),
+    KEY `idx_type` (`type`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='权限点表';
+
+-- 为存量菜单已绑定的权限标识补登记权限点，保证历史绑定仍然有效
+INSERT INTO `permission_points` (`id`, `permission_key`, `name`, `type`, `module`, `created_by`)
+SELECT UUID(), m.`permission_key`, MIN(m.`name`),
+       IF(MAX(m.`type` = 'button'), 'button', 'menu'),
+       SUBSTRING_INDEX(m.`permission_key`, ':', 1), 'system'
+FROM `menus` m
+WHERE m.`permission_key` IS NOT NULL AND m.`permission_key` <> '' AND m.`deleted_at` IS NULL
+  AND NOT EXISTS (SELECT 1 FROM `permission_points` p WHERE p.`permission_key` = m.`permission_key`)
+GROUP BY m.`permission_key`;
@@ -0,0 +1,32 @@
+-- 创建权限模板版本快照表
+-- 模板每次发布写入一条不可变快照，固定旧版本的角色按快照授权，并支持版本对比与恢复
+
+CREATE TABLE IF NOT EXISTS `permission_template_versions` (
+    `id` CHAR(36) NOT NULL COMMENT 'ID (UUID v7)',
+    `template_id` CHAR(36) NOT NULL COMMENT '模板ID',
+    `version` INT NOT NULL COMMENT '版本号',
+    `name` VARCHAR(128) NOT NULL COMMENT '发布时的模板名称',
+    `code` VARCHAR(64) NOT NULL COMMENT '发布时的模板编码',
+    `description` VARCHAR(500) DEFAULT NULL COMMENT '发布时的模板描述',
+    `scope_suggestion` VARCHAR(50) DEFAULT NULL COMMENT '发布时的推荐适用范围',
+    `policy_matrix` JSON NOT NULL COMMENT '策略矩阵快照',
+    `advanced_perms` JSON DEFAULT NULL COMMENT '高级权限点快照',
+    `published_by` CHAR(36) NOT NULL COMMENT '发布人ID',
+    `published_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '发布时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_template_version` (`template_id`, `version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='权限模板版本快照表';
+
+-- 为存量已发布/已停用模板补写当前版本快照，保证已固定当前版本的角色可追溯
+INSERT INTO `permission_template_versions` (
+    `id`, `template_id`, `version`, `name`, `code`, `description`, `scope_suggestion`,
+    `policy_matrix`, `advanced_perms`, `published_by`, `published_at`
+)
+SELECT UUID(), t.`id`, t.`version`, t.`name`, t.`code`, t.`description`, t.`scope_suggestion`,
+       t.`policy_matrix`, t.`advanced_perms`, COALESCE(t.`updated_by`, t.`created_by`), t.`updated_at`
+FROM `permission_templates` t
+WHERE t.`status` IN ('published', 'disabled') AND t.`deleted_at` IS NULL
+  AND NOT EXISTS (
+      SELECT 1 FROM `permission_template_versions` v
+      WHERE v.`template_id` = t.`id` AND v.`version` = t.`version`
+  );
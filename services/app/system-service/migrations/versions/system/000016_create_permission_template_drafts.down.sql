@@ -0,0 +1,3 @@
+-- 回滚: 删除权限模板工作草稿表
+
+DROP TABLE IF EXISTS `permission_template_drafts`;
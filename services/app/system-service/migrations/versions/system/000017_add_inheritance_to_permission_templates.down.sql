@@ -0,0 +1,5 @@
+-- 回滚: 删除权限模板及工作草稿的继承字段
+
+ALTER TABLE `permission_template_drafts` DROP COLUMN `policy_removals`, DROP COLUMN `parent_id`;
+
+ALTER TABLE `permission_templates` DROP KEY `idx_parent_id`, DROP COLUMN `policy_removals`, DROP COLUMN `parent_id`;
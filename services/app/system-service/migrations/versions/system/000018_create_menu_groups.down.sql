@@ -0,0 +1,3 @@
+-- 回滚: 删除菜单分组表
+
+DROP TABLE IF EXISTS `menu_groups`;
@@ -0,0 +1,27 @@
+-- 创建菜单分组表
+-- 菜单的 group_id 引用分组ID，创建/更新菜单时校验分组存在
+
+CREATE TABLE IF NOT EXISTS `menu_groups` (
+    `id` CHAR(36) NOT NULL COMMENT 'ID (UUID v7)',
+    `code` VARCHAR(64) NOT NULL COMMENT '分组编码（全局唯一）',
+    `name` VARCHAR(128) NOT NULL COMMENT '分组名称',
+    `order` INT NOT NULL DEFAULT 0 COMMENT '排序号（升序）',
+    `icon` VARCHAR(64) DEFAULT NULL COMMENT '图标名称',
+    `description` VARCHAR(500) DEFAULT NULL COMMENT '描述',
+    `created_by` CHAR(36) NOT NULL COMMENT '创建人ID',
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+    `updated_by` CHAR(36) DEFAULT NULL COMMENT '最后更新人ID',
+    `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '最后更新时间',
+    `deleted_at` DATETIME(3) DEFAULT NULL COMMENT '删除时间（软删除）',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_code_deleted` (`code`, `deleted_at`),
+    KEY `idx_order` (`order`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='菜单分组表';
+
+-- 为存量菜单已使用的分组ID补登记分组（编码、名称暂取分组ID），保证历史数据通过分组校验
+INSERT INTO `menu_groups` (`id`, `code`, `name`, `created_by`)
+SELECT m.`group_id`, m.`group_id`, m.`group_id`, 'system'
+FROM `menus` m
+WHERE m.`group_id` IS NOT NULL AND m.`group_id` <> '' AND m.`deleted_at` IS NULL
+  AND NOT EXISTS (SELECT 1 FROM `menu_groups` g WHERE g.`id` = m.`group_id`)
+GROUP BY m.`group_id`;
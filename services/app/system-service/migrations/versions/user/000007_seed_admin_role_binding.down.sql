@@ -0,0 +1,4 @@
+-- 回滚: 删除管理员角色绑定
+
+DELETE FROM `role_bindings`
+WHERE `user_id` = '00000000-0000-0000-0000-000000000001' AND `permission_role` = 'super_admin';
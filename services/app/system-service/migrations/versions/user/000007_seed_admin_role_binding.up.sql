@@ -0,0 +1,10 @@
+-- 初始化管理员角色绑定
+-- 将内置管理员绑定到根组织，权限角色为 super_admin（对应权限模板编码）
+
+INSERT INTO `role_bindings` (`user_id`, `org_id`, `position`, `permission_role`)
+SELECT '00000000-0000-0000-0000-000000000001', '01944f4e-7c6a-7000-8000-000000000001', NULL, 'super_admin'
+FROM DUAL
+WHERE NOT EXISTS (
+    SELECT 1 FROM `role_bindings`
+    WHERE `user_id` = '00000000-0000-0000-0000-000000000001' AND `permission_role` = 'super_admin'
+);
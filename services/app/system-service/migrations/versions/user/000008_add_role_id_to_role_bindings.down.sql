@@ -0,0 +1,5 @@
+-- 回滚: 删除角色绑定的角色ID字段
+
+ALTER TABLE `role_bindings`
+    DROP KEY `idx_role_id`,
+    DROP COLUMN `role_id`;
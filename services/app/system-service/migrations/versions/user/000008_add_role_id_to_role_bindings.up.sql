@@ -0,0 +1,11 @@
+-- 角色绑定引用角色ID
+-- 依赖 system/000012_create_roles：按 permission_role 编码回填 role_id
+
+ALTER TABLE `role_bindings`
+    ADD COLUMN `role_id` CHAR(36) DEFAULT NULL COMMENT '角色ID（roles.id）' AFTER `position`,
+    ADD KEY `idx_role_id` (`role_id`);
+
+UPDATE `role_bindings` rb
+JOIN `roles` r ON r.`code` = rb.`permission_role` AND r.`deleted_at` IS NULL
+SET rb.`role_id` = r.`id`
+WHERE rb.`role_id` IS NULL;
@@ -0,0 +1,10 @@
+package auditevents
+
+import (
+	"gorm.io/gorm"
+)
+
+// NewModel 创建统一审计事件 Model 实例
+func NewModel(db *gorm.DB) Model {
+	return &gormAuditEventModel{db: db}
+}
@@ -0,0 +1,63 @@
+package auditevents
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// gormAuditEventModel GORM 实现的统一审计事件 Model
+type gormAuditEventModel struct {
+	db *gorm.DB
+}
+
+// FindList 按筛选条件倒序查询审计事件（游标分页）
+func (m *gormAuditEventModel) FindList(ctx context.Context, req *FindListReq) ([]*AuditEvent, bool, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	query := m.db.WithContext(ctx).Model(&AuditEvent{})
+
+	// 1. 筛选条件
+	if req.ResourceType != "" {
+		query = query.Where("resource_type = ?", req.ResourceType)
+	}
+	if req.ResourceId != "" {
+		query = query.Where("resource_id = ?", req.ResourceId)
+	}
+	if req.OperatorId != "" {
+		query = query.Where("operator_id = ?", req.OperatorId)
+	}
+	if req.Operation != "" {
+		query = query.Where("operation = ?", req.Operation)
+	}
+	if req.StartTime != nil {
+		query = query.Where("created_at >= ?", *req.StartTime)
+	}
+	if req.EndTime != nil {
+		query = query.Where("created_at <= ?", *req.EndTime)
+	}
+
+	// 2. 游标定位：取上一页最后一条之后的事件
+	if req.Cursor != nil {
+		query = query.Where("(created_at < ? OR (created_at = ? AND id < ?))",
+			req.Cursor.CreatedAt, req.Cursor.CreatedAt, req.Cursor.Id)
+	}
+
+	// 3. 多取一条用于判断是否还有下一页
+	var list []*AuditEvent
+	if err := query.Order("created_at DESC").Order("id DESC").Limit(limit + 1).Find(&list).Error; err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(list) > limit
+	if hasMore {
+		list = list[:limit]
+	}
+	return list, hasMore, nil
+}
@@ -0,0 +1,142 @@
+package auditevents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestDB 创建测试数据库
+// SQLite 不支持 CONCAT/COLLATE 等 MySQL 语法，所以手动创建源表和等价视图
+// 生产环境使用 migrations/versions/system/000009_create_audit_events_view.up.sql 中的视图定义
+func setupTestDB(t *testing.T) *gorm.DB {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{})
+	require.NoError(t, err)
+
+	statements := []string{
+		`CREATE TABLE audit_logs (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id TEXT NOT NULL,
+			action TEXT NOT NULL,
+			operator TEXT NOT NULL,
+			operator_id TEXT NOT NULL,
+			changes TEXT,
+			timestamp DATETIME NOT NULL
+		)`,
+		`CREATE TABLE sys_organization_audit (
+			id TEXT PRIMARY KEY,
+			org_id TEXT NOT NULL,
+			operation TEXT NOT NULL,
+			operator_id TEXT NOT NULL,
+			old_value TEXT,
+			new_value TEXT,
+			created_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE menu_audit_logs (
+			id TEXT PRIMARY KEY,
+			menu_id TEXT NOT NULL,
+			operation_type TEXT NOT NULL,
+			operator_id TEXT,
+			operator_name TEXT,
+			changed_fields TEXT,
+			old_value TEXT,
+			new_value TEXT,
+			remark TEXT,
+			created_at DATETIME NOT NULL
+		)`,
+		`CREATE VIEW audit_events AS
+			SELECT 'user:' || id AS id, 'user' AS resource_type, user_id AS resource_id, action AS operation,
+				operator_id, operator AS operator_name, changes, NULL AS old_value, NULL AS new_value, timestamp AS created_at
+			FROM audit_logs
+			UNION ALL
+			SELECT 'organization:' || id, 'organization', org_id, operation, operator_id, NULL, NULL, old_value, new_value, created_at
+			FROM sys_organization_audit
+			UNION ALL
+			SELECT 'menu:' || id, 'menu', menu_id, operation_type, operator_id, operator_name, NULL, old_value, new_value, created_at
+			FROM menu_audit_logs`,
+	}
+	for _, statement := range statements {
+		require.NoError(t, db.Exec(statement).Error)
+	}
+	return db
+}
+
+// seedEvents 写入三类审计日志，时间从 base 开始每条间隔 1 分钟
+func seedEvents(t *testing.T, db *gorm.DB, base time.Time) {
+	require.NoError(t, db.Exec(`INSERT INTO audit_logs (user_id, action, operator, operator_id, changes, timestamp) VALUES
+		('user-1', 'create', 'Admin', 'admin-1', '{"status":{"new":0}}', ?),
+		('user-1', 'lock', 'Admin', 'admin-1', NULL, ?)`, base, base.Add(3*time.Minute)).Error)
+	require.NoError(t, db.Exec(`INSERT INTO sys_organization_audit (id, org_id, operation, operator_id, old_value, new_value, created_at) VALUES
+		('org-log-1', 'dept-1', 'create', 'admin-2', NULL, '{"name":"研发部"}', ?),
+		('org-log-2', 'dept-1', 'move', 'admin-1', '{"parent_id":"a"}', '{"parent_id":"b"}', ?)`, base.Add(time.Minute), base.Add(4*time.Minute)).Error)
+	require.NoError(t, db.Exec(`INSERT INTO menu_audit_logs (id, menu_id, operation_type, operator_id, operator_name, created_at) VALUES
+		('menu-log-1', 'menu-1', 'update', 'admin-1', 'Admin', ?)`, base.Add(2*time.Minute)).Error)
+}
+
+// TestFindList_CursorPagination 测试跨资源类型按时间倒序游标分页
+func TestFindList_CursorPagination(t *testing.T) {
+	db := setupTestDB(t)
+	base := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	seedEvents(t, db, base)
+	model := NewModel(db)
+	ctx := context.Background()
+
+	var ids []string
+	req := &FindListReq{Limit: 2}
+	for page := 0; page < 5; page++ {
+		list, hasMore, err := model.FindList(ctx, req)
+		require.NoError(t, err)
+		for _, event := range list {
+			ids = append(ids, event.Id)
+		}
+		if !hasMore {
+			break
+		}
+		last := list[len(list)-1]
+		req.Cursor = &Cursor{CreatedAt: last.CreatedAt, Id: last.Id}
+	}
+
+	assert.Equal(t, []string{
+		"organization:org-log-2",
+		"user:2",
+		"menu:menu-log-1",
+		"organization:org-log-1",
+		"user:1",
+	}, ids)
+}
+
+// TestFindList_Filters 测试按资源、操作人、操作类型和时间范围筛选
+func TestFindList_Filters(t *testing.T) {
+	db := setupTestDB(t)
+	base := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	seedEvents(t, db, base)
+	model := NewModel(db)
+	ctx := context.Background()
+
+	list, _, err := model.FindList(ctx, &FindListReq{ResourceType: ResourceTypeOrganization, ResourceId: "dept-1"})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.JSONEq(t, `{"parent_id":"b"}`, string(list[0].NewValue))
+
+	list, _, err = model.FindList(ctx, &FindListReq{OperatorId: "admin-1", Operation: "create"})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "user:1", list[0].Id)
+	require.NotNil(t, list[0].OperatorName)
+	assert.Equal(t, "Admin", *list[0].OperatorName)
+
+	start := base.Add(time.Minute)
+	end := base.Add(3 * time.Minute)
+	list, hasMore, err := model.FindList(ctx, &FindListReq{StartTime: &start, EndTime: &end})
+	require.NoError(t, err)
+	assert.False(t, hasMore)
+	require.Len(t, list, 3)
+	assert.Equal(t, "user:2", list[0].Id)
+	assert.Equal(t, "organization:org-log-1", list[2].Id)
+}
@@ -0,0 +1,12 @@
+package auditevents
+
+import (
+	"context"
+)
+
+// Model 统一审计事件查询接口（只读）
+type Model interface {
+	// FindList 按筛选条件倒序查询审计事件（游标分页），返回结果最多 req.Limit 条
+	// hasMore 表示是否还有下一页
+	FindList(ctx context.Context, req *FindListReq) (list []*AuditEvent, hasMore bool, err error)
+}
@@ -0,0 +1,45 @@
+package auditevents
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// AuditEvent 统一审计事件（audit_events 视图，只读）
+// 视图合并用户、组织架构、菜单的审计日志，字段统一为相同结构
+type AuditEvent struct {
+	Id           string         `gorm:"column:id" json:"id"`                       // 事件ID（资源类型:原日志ID，全局唯一）
+	ResourceType string         `gorm:"column:resource_type" json:"resource_type"` // 资源类型：user/organization/menu/permission_template
+	ResourceId   string         `gorm:"column:resource_id" json:"resource_id"`     // 资源ID
+	Operation    string         `gorm:"column:operation" json:"operation"`         // 操作类型
+	OperatorId   *string        `gorm:"column:operator_id" json:"operator_id"`     // 操作人ID
+	OperatorName *string        `gorm:"column:operator_name" json:"operator_name"` // 操作人名称（组织架构日志未记录）
+	Changes      datatypes.JSON `gorm:"column:changes" json:"changes"`             // 变更内容（用户日志）
+	OldValue     datatypes.JSON `gorm:"column:old_value" json:"old_value"`         // 旧值（组织架构、菜单日志）
+	NewValue     datatypes.JSON `gorm:"column:new_value" json:"new_value"`         // 新值（组织架构、菜单日志）
+	CreatedAt    time.Time      `gorm:"column:created_at" json:"created_at"`       // 操作时间
+}
+
+// TableName 指定视图名
+func (AuditEvent) TableName() string {
+	return "audit_events"
+}
+
+// Cursor 分页游标（按 created_at、id 倒序定位）
+type Cursor struct {
+	CreatedAt time.Time
+	Id        string
+}
+
+// FindListReq 查询审计事件请求参数
+type FindListReq struct {
+	ResourceType string     // 资源类型
+	ResourceId   string     // 资源ID
+	OperatorId   string     // 操作人ID
+	Operation    string     // 操作类型
+	StartTime    *time.Time // 开始时间（包含）
+	EndTime      *time.Time // 结束时间（包含）
+	Cursor       *Cursor    // 上一页最后一条事件，为空时从最新开始
+	Limit        int        // 每页大小
+}
@@ -0,0 +1,23 @@
+package auditevents
+
+// 资源类型
+const (
+	ResourceTypeUser               = "user"
+	ResourceTypeOrganization       = "organization"
+	ResourceTypeMenu               = "menu"
+	ResourceTypePermissionTemplate = "permission_template"
+)
+
+// 分页限制
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// ResourceTypes 支持查询的资源类型
+var ResourceTypes = []string{
+	ResourceTypeUser,
+	ResourceTypeOrganization,
+	ResourceTypeMenu,
+	ResourceTypePermissionTemplate,
+}
@@ -0,0 +1,12 @@
+package jobs
+
+import (
+	"gorm.io/gorm"
+)
+
+// NewModel 创建异步任务 Model 实例（GORM）
+func NewModel(db *gorm.DB) Model {
+	return &gormJobModel{
+		db: db,
+	}
+}
@@ -0,0 +1,174 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// gormJobModel GORM 实现的异步任务 Model
+type gormJobModel struct {
+	db *gorm.DB
+}
+
+// Insert 插入任务
+func (m *gormJobModel) Insert(ctx context.Context, data *Job) (*Job, error) {
+	if data.Id == "" {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, fmt.Errorf("generate uuid failed: %w", err)
+		}
+		data.Id = id.String()
+	}
+	data.Status = StatusPending
+	data.Progress = 0
+
+	if err := m.db.WithContext(ctx).Create(data).Error; err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// FindOne 根据 ID 查询
+func (m *gormJobModel) FindOne(ctx context.Context, id string) (*Job, error) {
+	var job Job
+	err := m.db.WithContext(ctx).Where("id = ?", id).First(&job).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrJobNotFound
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+// FindPending 按创建时间查询等待执行的任务
+func (m *gormJobModel) FindPending(ctx context.Context, limit int) ([]*Job, error) {
+	var list []*Job
+	err := m.db.WithContext(ctx).
+		Where("status = ?", StatusPending).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// FindStaleRunning 查询心跳过期的运行中任务
+func (m *gormJobModel) FindStaleRunning(ctx context.Context, before time.Time, limit int) ([]*Job, error) {
+	var list []*Job
+	err := m.db.WithContext(ctx).
+		Where("status = ? AND updated_at < ?", StatusRunning, before).
+		Order("updated_at ASC").
+		Limit(limit).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// MarkRunning 将等待中的任务标记为执行中（条件更新，防止重复抢占）
+func (m *gormJobModel) MarkRunning(ctx context.Context, id string) (bool, error) {
+	now := time.Now()
+	result := m.db.WithContext(ctx).Model(&Job{}).
+		Where("id = ? AND status = ?", id, StatusPending).
+		Updates(map[string]interface{}{
+			"status":     StatusRunning,
+			"started_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// UpdateProgress 更新运行中任务的进度
+func (m *gormJobModel) UpdateProgress(ctx context.Context, id string, progress int) error {
+	if progress < 0 {
+		progress = 0
+	}
+	if progress > 100 {
+		progress = 100
+	}
+	return m.db.WithContext(ctx).Model(&Job{}).
+		Where("id = ? AND status = ?", id, StatusRunning).
+		Updates(map[string]interface{}{
+			"progress":   progress,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// Finish 结束运行中的任务
+func (m *gormJobModel) Finish(ctx context.Context, id string, data *FinishData) error {
+	updateMap := map[string]interface{}{
+		"status":      data.Status,
+		"finished_at": time.Now(),
+	}
+	if data.Status == StatusSucceeded {
+		updateMap["progress"] = 100
+	}
+	if len(data.Result) > 0 {
+		updateMap["result"] = data.Result
+	}
+	if data.ResultPath != "" {
+		updateMap["result_path"] = data.ResultPath
+		updateMap["result_name"] = data.ResultName
+	}
+	if data.ErrorMessage != "" {
+		updateMap["error_message"] = truncate(data.ErrorMessage, 1000)
+	}
+
+	result := m.db.WithContext(ctx).Model(&Job{}).
+		Where("id = ? AND status = ?", id, StatusRunning).
+		Updates(updateMap)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrJobNotFound
+	}
+	return nil
+}
+
+// CancelPending 直接取消等待中的任务
+func (m *gormJobModel) CancelPending(ctx context.Context, id string) (bool, error) {
+	result := m.db.WithContext(ctx).Model(&Job{}).
+		Where("id = ? AND status = ?", id, StatusPending).
+		Updates(map[string]interface{}{
+			"status":           StatusCanceled,
+			"cancel_requested": true,
+			"finished_at":      time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// RequestCancel 为运行中的任务设置取消标记
+func (m *gormJobModel) RequestCancel(ctx context.Context, id string) (bool, error) {
+	result := m.db.WithContext(ctx).Model(&Job{}).
+		Where("id = ? AND status = ?", id, StatusRunning).
+		Update("cancel_requested", true)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// truncate 按字符截断字符串
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
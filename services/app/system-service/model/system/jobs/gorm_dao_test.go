@@ -0,0 +1,160 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestDB 创建测试数据库
+// SQLite 不支持 datetime(3) 和 JSON 类型，所以需要手动创建表结构
+// 生产环境使用 MySQL，会使用 migrations/versions/system/000008_create_jobs.up.sql 中的完整 DDL
+func setupTestDB(t *testing.T) *gorm.DB {
+	dbName := "file:test_" + t.Name() + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{})
+	require.NoError(t, err)
+
+	err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS jobs (
+			id TEXT PRIMARY KEY,
+			type TEXT NOT NULL,
+			status TEXT NOT NULL DEFAULT 'pending',
+			progress INTEGER NOT NULL DEFAULT 0,
+			params TEXT,
+			result TEXT,
+			result_path TEXT,
+			result_name TEXT,
+			error_message TEXT,
+			cancel_requested INTEGER NOT NULL DEFAULT 0,
+			created_by TEXT NOT NULL,
+			started_at DATETIME,
+			finished_at DATETIME,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`).Error
+	require.NoError(t, err)
+	return db
+}
+
+// TestMarkRunning_OnlyOnce 测试等待中的任务只能被抢占一次
+func TestMarkRunning_OnlyOnce(t *testing.T) {
+	model := NewModel(setupTestDB(t))
+	ctx := context.Background()
+
+	job, err := model.Insert(ctx, &Job{Type: "user_export", CreatedBy: "user-1"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, job.Id)
+
+	pending, err := model.FindPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	ok, err := model.MarkRunning(ctx, job.Id)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = model.MarkRunning(ctx, job.Id)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	pending, err = model.FindPending(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	found, err := model.FindOne(ctx, job.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StatusRunning, found.Status)
+	assert.NotNil(t, found.StartedAt)
+}
+
+// TestFinish_WritesResult 测试结束任务写入结果，已结束的任务不能再次结束
+func TestFinish_WritesResult(t *testing.T) {
+	model := NewModel(setupTestDB(t))
+	ctx := context.Background()
+
+	job, err := model.Insert(ctx, &Job{Type: "user_export", CreatedBy: "user-1"})
+	require.NoError(t, err)
+	_, err = model.MarkRunning(ctx, job.Id)
+	require.NoError(t, err)
+	require.NoError(t, model.UpdateProgress(ctx, job.Id, 40))
+
+	err = model.Finish(ctx, job.Id, &FinishData{
+		Status:     StatusSucceeded,
+		Result:     []byte(`{"rows":3}`),
+		ResultPath: "data/jobs/x/users.csv",
+		ResultName: "users.csv",
+	})
+	require.NoError(t, err)
+
+	found, err := model.FindOne(ctx, job.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StatusSucceeded, found.Status)
+	assert.Equal(t, 100, found.Progress)
+	assert.JSONEq(t, `{"rows":3}`, string(found.Result))
+	require.NotNil(t, found.ResultName)
+	assert.Equal(t, "users.csv", *found.ResultName)
+	assert.NotNil(t, found.FinishedAt)
+
+	err = model.Finish(ctx, job.Id, &FinishData{Status: StatusFailed})
+	assert.ErrorIs(t, err, ErrJobNotFound)
+}
+
+// TestCancel_PendingAndRunning 测试等待中的任务直接取消，执行中的任务只设置取消标记
+func TestCancel_PendingAndRunning(t *testing.T) {
+	model := NewModel(setupTestDB(t))
+	ctx := context.Background()
+
+	pendingJob, err := model.Insert(ctx, &Job{Type: "user_import", CreatedBy: "user-1"})
+	require.NoError(t, err)
+	ok, err := model.CancelPending(ctx, pendingJob.Id)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	found, err := model.FindOne(ctx, pendingJob.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCanceled, found.Status)
+
+	runningJob, err := model.Insert(ctx, &Job{Type: "user_import", CreatedBy: "user-1"})
+	require.NoError(t, err)
+	_, err = model.MarkRunning(ctx, runningJob.Id)
+	require.NoError(t, err)
+
+	ok, err = model.CancelPending(ctx, runningJob.Id)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = model.RequestCancel(ctx, runningJob.Id)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	found, err = model.FindOne(ctx, runningJob.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StatusRunning, found.Status)
+	assert.True(t, found.CancelRequested)
+}
+
+// TestFindStaleRunning_ByHeartbeat 测试按心跳时间查询中断的运行中任务
+func TestFindStaleRunning_ByHeartbeat(t *testing.T) {
+	db := setupTestDB(t)
+	model := NewModel(db)
+	ctx := context.Background()
+
+	job, err := model.Insert(ctx, &Job{Type: "user_export", CreatedBy: "user-1"})
+	require.NoError(t, err)
+	_, err = model.MarkRunning(ctx, job.Id)
+	require.NoError(t, err)
+
+	stale, err := model.FindStaleRunning(ctx, time.Now().Add(-time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, stale)
+
+	require.NoError(t, db.Model(&Job{}).Where("id = ?", job.Id).UpdateColumn("updated_at", time.Now().Add(-time.Hour)).Error)
+	stale, err = model.FindStaleRunning(ctx, time.Now().Add(-time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+	assert.Equal(t, job.Id, stale[0].Id)
+}
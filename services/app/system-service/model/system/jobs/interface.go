@@ -0,0 +1,36 @@
+package jobs
+
+import (
+	"context"
+	"time"
+)
+
+// Model 异步任务数据访问接口
+type Model interface {
+	// Insert 插入任务（自动生成 UUID v7，状态为 pending）
+	Insert(ctx context.Context, data *Job) (*Job, error)
+
+	// FindOne 根据 ID 查询
+	FindOne(ctx context.Context, id string) (*Job, error)
+
+	// FindPending 按创建时间查询等待执行的任务
+	FindPending(ctx context.Context, limit int) ([]*Job, error)
+
+	// FindStaleRunning 查询心跳（updated_at）早于指定时间的运行中任务
+	FindStaleRunning(ctx context.Context, before time.Time, limit int) ([]*Job, error)
+
+	// MarkRunning 将等待中的任务标记为执行中，返回是否抢占成功
+	MarkRunning(ctx context.Context, id string) (bool, error)
+
+	// UpdateProgress 更新运行中任务的进度（同时刷新心跳）
+	UpdateProgress(ctx context.Context, id string, progress int) error
+
+	// Finish 结束运行中的任务，写入结果或失败原因
+	Finish(ctx context.Context, id string, data *FinishData) error
+
+	// CancelPending 直接取消等待中的任务，返回是否取消成功
+	CancelPending(ctx context.Context, id string) (bool, error)
+
+	// RequestCancel 为运行中的任务设置取消标记，返回是否设置成功
+	RequestCancel(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,40 @@
+package jobs
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// Job 异步任务实体
+type Job struct {
+	Id              string         `gorm:"primaryKey;size:36" json:"id"`                                      // UUID v7
+	Type            string         `gorm:"size:50;not null;index:idx_type" json:"type"`                       // 任务类型，如 user_import/user_export
+	Status          string         `gorm:"size:20;not null;default:'pending';index:idx_status" json:"status"` // 任务状态：pending/running/succeeded/failed/canceled
+	Progress        int            `gorm:"not null;default:0" json:"progress"`                                // 进度（0-100）
+	Params          datatypes.JSON `gorm:"type:json" json:"params"`                                           // 任务参数
+	Result          datatypes.JSON `gorm:"type:json" json:"result"`                                           // 任务结果（结构由任务类型决定）
+	ResultPath      *string        `gorm:"size:500" json:"-"`                                                 // 结果文件存储位置（不返回）
+	ResultName      *string        `gorm:"size:255" json:"result_name,omitempty"`                             // 结果文件下载名称
+	ErrorMessage    *string        `gorm:"size:1000" json:"error_message,omitempty"`                          // 失败原因
+	CancelRequested bool           `gorm:"not null;default:false" json:"cancel_requested"`                    // 是否已请求取消（运行中任务由 worker 响应）
+	CreatedBy       string         `gorm:"size:36;not null;index:idx_created_by" json:"created_by"`           // 提交人ID
+	StartedAt       *time.Time     `gorm:"type:datetime(3)" json:"started_at,omitempty"`                      // 开始执行时间
+	FinishedAt      *time.Time     `gorm:"type:datetime(3)" json:"finished_at,omitempty"`                     // 结束时间
+	CreatedAt       time.Time      `gorm:"autoCreateTime;index:idx_created_at" json:"created_at"`             // 创建时间
+	UpdatedAt       time.Time      `gorm:"autoUpdateTime" json:"updated_at"`                                  // 更新时间（运行中作为心跳）
+}
+
+// TableName 指定表名
+func (Job) TableName() string {
+	return "jobs"
+}
+
+// FinishData 任务结束时写入的数据
+type FinishData struct {
+	Status       string
+	Result       datatypes.JSON
+	ResultPath   string
+	ResultName   string
+	ErrorMessage string
+}
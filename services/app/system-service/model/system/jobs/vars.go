@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"errors"
+)
+
+var (
+	// ErrJobNotFound 任务不存在
+	ErrJobNotFound = errors.New("任务不存在")
+)
+
+const (
+	// StatusPending 等待执行
+	StatusPending = "pending"
+	// StatusRunning 执行中
+	StatusRunning = "running"
+	// StatusSucceeded 执行成功
+	StatusSucceeded = "succeeded"
+	// StatusFailed 执行失败
+	StatusFailed = "failed"
+	// StatusCanceled 已取消
+	StatusCanceled = "canceled"
+)
+
+// IsFinished 判断任务是否已结束
+func IsFinished(status string) bool {
+	return status == StatusSucceeded || status == StatusFailed || status == StatusCanceled
+}
@@ -16,6 +16,19 @@ func (m *gormMenuAuditLogModel) Insert(ctx context.Context, data *MenuAuditLog)
 	return data, nil
 }
 
+// FindOne 根据 ID 查询
+func (m *gormMenuAuditLogModel) FindOne(ctx context.Context, id string) (*MenuAuditLog, error) {
+	var log MenuAuditLog
+	err := m.db.WithContext(ctx).Where("id = ?", id).First(&log).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrMenuAuditLogNotFound
+		}
+		return nil, fmt.Errorf("查询审计日志失败: %w", err)
+	}
+	return &log, nil
+}
+
 // FindList 查询审计日志列表（支持分页和筛选）
 func (m *gormMenuAuditLogModel) FindList(ctx context.Context, req *FindListReq) ([]*MenuAuditLog, int64, error) {
 	var logs []*MenuAuditLog
@@ -9,6 +9,9 @@ type Model interface {
 	// Insert 插入审计日志
 	Insert(ctx context.Context, data *MenuAuditLog) (*MenuAuditLog, error)
 
+	// FindOne 根据 ID 查询
+	FindOne(ctx context.Context, id string) (*MenuAuditLog, error)
+
 	// FindList 查询审计日志列表（支持分页和筛选）
 	FindList(ctx context.Context, req *FindListReq) ([]*MenuAuditLog, int64, error)
 
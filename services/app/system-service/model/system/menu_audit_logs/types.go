@@ -10,7 +10,7 @@ import (
 type MenuAuditLog struct {
 	Id            string         `gorm:"primaryKey;size:36" json:"id"`                                             // UUID v7
 	MenuId        string         `gorm:"size:36;not null;index" json:"menu_id"`                                    // 菜单ID
-	OperationType string         `gorm:"size:20;not null;index" json:"operation_type"`                             // 操作类型：create/update/delete/move/reorder/enable/disable/show/hide
+	OperationType string         `gorm:"size:20;not null;index" json:"operation_type"`                             // 操作类型：create/update/delete/move/reorder/enable/disable/show/hide/revert
 	OperatorId    *string        `gorm:"size:36;index" json:"operator_id,omitempty"`                               // 操作人ID
 	OperatorName  *string        `gorm:"size:128" json:"operator_name,omitempty"`                                  // 操作人名称
 	ChangedFields datatypes.JSON `gorm:"type:json" json:"changed_fields,omitempty"`                                // 变更字段（JSON格式）
@@ -0,0 +1,12 @@
+package menu_groups
+
+import (
+	"gorm.io/gorm"
+)
+
+// NewModel 创建菜单分组 Model 实例（GORM）
+func NewModel(db *gorm.DB) Model {
+	return &gormMenuGroupModel{
+		db: db,
+	}
+}
@@ -0,0 +1,97 @@
+package menu_groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// gormMenuGroupModel GORM 实现的菜单分组 Model
+type gormMenuGroupModel struct {
+	db *gorm.DB
+}
+
+// Insert 插入菜单分组
+func (m *gormMenuGroupModel) Insert(ctx context.Context, data *MenuGroup) (*MenuGroup, error) {
+	err := m.db.WithContext(ctx).Create(data).Error
+	if err != nil {
+		if isDuplicateError(err) {
+			return nil, ErrMenuGroupCodeExists
+		}
+		return nil, fmt.Errorf("创建菜单分组失败: %w", err)
+	}
+	return data, nil
+}
+
+// FindOne 根据 ID 查询
+func (m *gormMenuGroupModel) FindOne(ctx context.Context, id string) (*MenuGroup, error) {
+	var group MenuGroup
+	err := m.db.WithContext(ctx).Where("id = ?", id).First(&group).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMenuGroupNotFound
+		}
+		return nil, fmt.Errorf("查询菜单分组失败: %w", err)
+	}
+	return &group, nil
+}
+
+// FindOneByCode 根据编码查询
+func (m *gormMenuGroupModel) FindOneByCode(ctx context.Context, code string) (*MenuGroup, error) {
+	var group MenuGroup
+	err := m.db.WithContext(ctx).Where("code = ?", code).First(&group).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMenuGroupNotFound
+		}
+		return nil, fmt.Errorf("查询菜单分组失败: %w", err)
+	}
+	return &group, nil
+}
+
+// FindAll 查询全部菜单分组
+func (m *gormMenuGroupModel) FindAll(ctx context.Context) ([]*MenuGroup, error) {
+	var list []*MenuGroup
+	err := m.db.WithContext(ctx).Order("`order` ASC").Order("code ASC").Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询菜单分组列表失败: %w", err)
+	}
+	return list, nil
+}
+
+// Update 更新菜单分组
+func (m *gormMenuGroupModel) Update(ctx context.Context, data *MenuGroup) error {
+	err := m.db.WithContext(ctx).Save(data).Error
+	if err != nil {
+		if isDuplicateError(err) {
+			return ErrMenuGroupCodeExists
+		}
+		return fmt.Errorf("更新菜单分组失败: %w", err)
+	}
+	return nil
+}
+
+// Delete 删除菜单分组（软删除）
+func (m *gormMenuGroupModel) Delete(ctx context.Context, id string) error {
+	err := m.db.WithContext(ctx).Delete(&MenuGroup{}, "id = ?", id).Error
+	if err != nil {
+		return fmt.Errorf("删除菜单分组失败: %w", err)
+	}
+	return nil
+}
+
+// WithTx 使用事务
+func (m *gormMenuGroupModel) WithTx(tx interface{}) Model {
+	if gormTx, ok := tx.(*gorm.DB); ok {
+		return &gormMenuGroupModel{db: gormTx}
+	}
+	return m
+}
+
+// isDuplicateError 判断是否为唯一性约束错误
+func isDuplicateError(err error) bool {
+	return strings.Contains(err.Error(), "Duplicate entry") || strings.Contains(err.Error(), "UNIQUE constraint")
+}
@@ -0,0 +1,29 @@
+package menu_groups
+
+import (
+	"context"
+)
+
+// Model 菜单分组数据访问接口
+type Model interface {
+	// Insert 插入菜单分组
+	Insert(ctx context.Context, data *MenuGroup) (*MenuGroup, error)
+
+	// FindOne 根据 ID 查询
+	FindOne(ctx context.Context, id string) (*MenuGroup, error)
+
+	// FindOneByCode 根据编码查询（全局唯一）
+	FindOneByCode(ctx context.Context, code string) (*MenuGroup, error)
+
+	// FindAll 查询全部菜单分组（按排序号、编码升序）
+	FindAll(ctx context.Context) ([]*MenuGroup, error)
+
+	// Update 更新菜单分组
+	Update(ctx context.Context, data *MenuGroup) error
+
+	// Delete 删除菜单分组（软删除）
+	Delete(ctx context.Context, id string) error
+
+	// WithTx 使用事务
+	WithTx(tx interface{}) Model
+}
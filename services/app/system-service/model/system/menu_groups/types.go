@@ -0,0 +1,28 @@
+package menu_groups
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MenuGroup 菜单分组
+// 菜单的 group_id 引用此表，同一分组内的菜单父子关系必须同组
+type MenuGroup struct {
+	Id          string         `gorm:"primaryKey;size:36" json:"id"`                                                                            // UUID v7
+	Code        string         `gorm:"size:64;not null;index:idx_code" json:"code"`                                                             // 分组编码（全局唯一）
+	Name        string         `gorm:"size:128;not null" json:"name"`                                                                           // 分组名称
+	Order       int            `gorm:"not null;default:0" json:"order"`                                                                         // 排序号（升序）
+	Icon        *string        `gorm:"size:64" json:"icon,omitempty"`                                                                           // 图标名称
+	Description *string        `gorm:"size:500" json:"description,omitempty"`                                                                   // 描述
+	CreatedBy   string         `gorm:"size:36;not null" json:"created_by"`                                                                      // 创建人ID
+	CreatedAt   time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3)" json:"created_at"`                                // 创建时间
+	UpdatedBy   *string        `gorm:"size:36" json:"updated_by,omitempty"`                                                                     // 最后更新人ID
+	UpdatedAt   time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3)" json:"updated_at"` // 最后更新时间
+	DeletedAt   gorm.DeletedAt `gorm:"type:datetime(3);index:uk_code_deleted" json:"-"`                                                         // 删除时间（软删除，不返回）
+}
+
+// TableName 指定表名
+func (MenuGroup) TableName() string {
+	return "menu_groups"
+}
@@ -0,0 +1,16 @@
+package menu_groups
+
+import (
+	"github.com/jinguoxing/idrm-go-base/errorx"
+)
+
+var (
+	// ErrMenuGroupNotFound 菜单分组不存在
+	ErrMenuGroupNotFound = errorx.New(200260, "菜单分组不存在")
+
+	// ErrMenuGroupCodeExists 菜单分组编码已存在
+	ErrMenuGroupCodeExists = errorx.New(200261, "菜单分组编码已存在")
+
+	// ErrMenuGroupReassignInvalid 菜单改挂的目标分组无效（不能是被删除的分组）
+	ErrMenuGroupReassignInvalid = errorx.New(200262, "菜单改挂的目标分组无效")
+)
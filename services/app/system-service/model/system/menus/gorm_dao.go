@@ -74,6 +74,19 @@ func (m *gormMenuModel) FindOne(ctx context.Context, id string) (*Menu, error) {
 	return &menu, nil
 }
 
+// FindOneIncludingDeleted 根据 ID 查询（包括已删除）
+func (m *gormMenuModel) FindOneIncludingDeleted(ctx context.Context, id string) (*Menu, error) {
+	var menu Menu
+	err := m.db.WithContext(ctx).Unscoped().Where("id = ?", id).First(&menu).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrMenuNotFound
+		}
+		return nil, fmt.Errorf("查询菜单失败: %w", err)
+	}
+	return &menu, nil
+}
+
 // FindOneByCode 根据 code 查询（全局唯一）
 func (m *gormMenuModel) FindOneByCode(ctx context.Context, code string) (*Menu, error) {
 	var menu Menu
@@ -141,6 +154,16 @@ func (m *gormMenuModel) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// Restore 恢复菜单（清除软删除标记并保存全部字段）
+func (m *gormMenuModel) Restore(ctx context.Context, data *Menu) error {
+	data.DeletedAt = gorm.DeletedAt{}
+	err := m.db.WithContext(ctx).Unscoped().Save(data).Error
+	if err != nil {
+		return fmt.Errorf("恢复菜单失败: %w", err)
+	}
+	return nil
+}
+
 // UpdateEnabled 更新菜单启用状态
 func (m *gormMenuModel) UpdateEnabled(ctx context.Context, id string, enabled bool) error {
 	err := m.db.WithContext(ctx).Model(&Menu{}).Where("id = ?", id).Update("enabled", enabled).Error
@@ -242,27 +265,76 @@ func (m *gormMenuModel) FindByPath(ctx context.Context, path string) ([]*Menu, e
 	return menus, nil
 }
 
+// FindByPermissionKey 根据权限标识查询（用于权限点反查）
+func (m *gormMenuModel) FindByPermissionKey(ctx context.Context, permissionKey string) ([]*Menu, error) {
+	var menus []*Menu
+	err := m.db.WithContext(ctx).Where("permission_key = ?", permissionKey).Order("`order` ASC").Find(&menus).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询菜单失败: %w", err)
+	}
+	return menus, nil
+}
+
+// ReassignGroup 将分组下的菜单改挂到目标分组
+func (m *gormMenuModel) ReassignGroup(ctx context.Context, fromGroupId string, toGroupId *string) (int64, error) {
+	result := m.db.WithContext(ctx).Model(&Menu{}).Where("group_id = ?", fromGroupId).Update("group_id", toGroupId)
+	if result.Error != nil {
+		return 0, fmt.Errorf("迁移菜单分组失败: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// CountByGroup 按分组统计菜单数量
+func (m *gormMenuModel) CountByGroup(ctx context.Context) (map[string]int64, error) {
+	var rows []struct {
+		GroupId string
+		Count   int64
+	}
+	err := m.db.WithContext(ctx).Model(&Menu{}).
+		Select("group_id, COUNT(*) AS count").
+		Where("group_id IS NOT NULL AND group_id <> ''").
+		Group("group_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("按分组统计菜单数量失败: %w", err)
+	}
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.GroupId] = row.Count
+	}
+	return counts, nil
+}
+
 // GetStatistics 获取统计信息
-func (m *gormMenuModel) GetStatistics(ctx context.Context) (*Statistics, error) {
+func (m *gormMenuModel) GetStatistics(ctx context.Context, groupId string) (*Statistics, error) {
 	var stats Statistics
 
+	// 分组过滤（groupId 为空时统计全部菜单）
+	scoped := func() *gorm.DB {
+		query := m.db.WithContext(ctx).Model(&Menu{})
+		if groupId != "" {
+			query = query.Where("group_id = ?", groupId)
+		}
+		return query
+	}
+
 	// 总菜单数
-	if err := m.db.WithContext(ctx).Model(&Menu{}).Count(&stats.Total).Error; err != nil {
+	if err := scoped().Count(&stats.Total).Error; err != nil {
 		return nil, fmt.Errorf("查询总菜单数失败: %w", err)
 	}
 
 	// 启用菜单数
-	if err := m.db.WithContext(ctx).Model(&Menu{}).Where("enabled = ?", true).Count(&stats.Enabled).Error; err != nil {
+	if err := scoped().Where("enabled = ?", true).Count(&stats.Enabled).Error; err != nil {
 		return nil, fmt.Errorf("查询启用菜单数失败: %w", err)
 	}
 
 	// 隐藏菜单数
-	if err := m.db.WithContext(ctx).Model(&Menu{}).Where("visible = ?", false).Count(&stats.Hidden).Error; err != nil {
+	if err := scoped().Where("visible = ?", false).Count(&stats.Hidden).Error; err != nil {
 		return nil, fmt.Errorf("查询隐藏菜单数失败: %w", err)
 	}
 
 	// 未绑定权限菜单数
-	if err := m.db.WithContext(ctx).Model(&Menu{}).Where("permission_key IS NULL OR permission_key = ''").Count(&stats.UnboundPermission).Error; err != nil {
+	if err := scoped().Where("permission_key IS NULL OR permission_key = ''").Count(&stats.UnboundPermission).Error; err != nil {
 		return nil, fmt.Errorf("查询未绑定权限菜单数失败: %w", err)
 	}
 
@@ -12,6 +12,9 @@ type Model interface {
 	// FindOne 根据 ID 查询
 	FindOne(ctx context.Context, id string) (*Menu, error)
 
+	// FindOneIncludingDeleted 根据 ID 查询（包括已删除，用于恢复菜单）
+	FindOneIncludingDeleted(ctx context.Context, id string) (*Menu, error)
+
 	// FindOneByCode 根据 code 查询（全局唯一）
 	FindOneByCode(ctx context.Context, code string) (*Menu, error)
 
@@ -27,6 +30,9 @@ type Model interface {
 	// Update 更新菜单
 	Update(ctx context.Context, data *Menu) error
 
+	// Restore 恢复菜单（清除软删除标记并保存全部字段）
+	Restore(ctx context.Context, data *Menu) error
+
 	// UpdateEnabled 更新菜单启用状态
 	UpdateEnabled(ctx context.Context, id string, enabled bool) error
 
@@ -51,8 +57,17 @@ type Model interface {
 	// FindByPath 根据 path 查询（用于冲突检测）
 	FindByPath(ctx context.Context, path string) ([]*Menu, error)
 
-	// GetStatistics 获取统计信息
-	GetStatistics(ctx context.Context) (*Statistics, error)
+	// FindByPermissionKey 根据权限标识查询（用于权限点反查）
+	FindByPermissionKey(ctx context.Context, permissionKey string) ([]*Menu, error)
+
+	// ReassignGroup 将分组下的菜单改挂到目标分组（toGroupId 为 nil 时清空分组），返回受影响的菜单数
+	ReassignGroup(ctx context.Context, fromGroupId string, toGroupId *string) (int64, error)
+
+	// CountByGroup 按分组统计菜单数量（未分组菜单不计入）
+	CountByGroup(ctx context.Context) (map[string]int64, error)
+
+	// GetStatistics 获取统计信息（groupId 非空时只统计该分组）
+	GetStatistics(ctx context.Context, groupId string) (*Statistics, error)
 
 	// WithTx 使用事务
 	WithTx(tx interface{}) Model
@@ -44,4 +44,19 @@ var (
 
 	// ErrMenuDeleted 菜单已删除
 	ErrMenuDeleted = errorx.New(200145, "菜单已删除")
+
+	// ErrMenuImportInvalidFile 菜单配置文件无效
+	ErrMenuImportInvalidFile = errorx.New(200146, "菜单配置文件无效")
+
+	// ErrMenuImportInvalid 菜单配置校验失败
+	ErrMenuImportInvalid = errorx.New(200147, "菜单配置校验失败")
+
+	// ErrMenuRevertInvalid 审计记录无法用于恢复菜单
+	ErrMenuRevertInvalid = errorx.New(200148, "审计记录无法用于恢复菜单")
+
+	// ErrMenuRevertParentMissing 恢复目标的父菜单不存在或已删除
+	ErrMenuRevertParentMissing = errorx.New(200149, "父菜单不存在或已删除，无法恢复")
+
+	// ErrMenuBatchTooLarge 批量操作菜单数量超出限制
+	ErrMenuBatchTooLarge = errorx.New(200150, "批量操作菜单数量超出限制")
 )
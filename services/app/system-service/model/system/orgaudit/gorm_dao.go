@@ -3,6 +3,7 @@ package orgaudit
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -24,6 +25,9 @@ func (m *gormDAO) Insert(ctx context.Context, data *OrgAudit) (*OrgAudit, error)
 		return nil, fmt.Errorf("generate uuid failed: %w", err)
 	}
 	data.Id = id.String()
+	if data.CreatedAt == "" {
+		data.CreatedAt = time.Now().Format("2006-01-02 15:04:05")
+	}
 
 	if err := m.db.WithContext(ctx).Create(data).Error; err != nil {
 		return nil, fmt.Errorf("create audit log failed: %w", err)
@@ -119,6 +123,56 @@ func (m *gormDAO) FindRecent(ctx context.Context, limit int) ([]*OrgAudit, error
 	return audits, nil
 }
 
+func (m *gormDAO) FindList(ctx context.Context, req *FindListReq) ([]*OrgAudit, int64, error) {
+	query := m.db.WithContext(ctx).Model(&OrgAudit{})
+
+	if req.OrgId != "" {
+		query = query.Where("org_id = ?", req.OrgId)
+	}
+	if req.Operation != "" {
+		query = query.Where("operation = ?", req.Operation)
+	}
+	if req.OperatorId != "" {
+		query = query.Where("operator_id = ?", req.OperatorId)
+	}
+	if req.StartTime != nil {
+		query = query.Where("created_at >= ?", req.StartTime.Format("2006-01-02 15:04:05"))
+	}
+	if req.EndTime != nil {
+		query = query.Where("created_at <= ?", req.EndTime.Format("2006-01-02 15:04:05"))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("count audit logs failed: %w", err)
+	}
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultQueryLimit
+	}
+	if pageSize > MaxQueryLimit {
+		pageSize = MaxQueryLimit
+	}
+
+	var audits []*OrgAudit
+	err := query.
+		Order("created_at DESC").
+		Order("id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&audits).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("find audit logs failed: %w", err)
+	}
+
+	return audits, total, nil
+}
+
 func (m *gormDAO) WithTx(tx interface{}) Model {
 	if db, ok := tx.(*gorm.DB); ok {
 		return &gormDAO{db: db}
@@ -2,6 +2,7 @@ package orgaudit
 
 import (
 	"context"
+	"time"
 )
 
 // OrgAudit 组织架构审计日志实体
@@ -20,6 +21,17 @@ func (OrgAudit) TableName() string {
 	return "sys_organization_audit"
 }
 
+// FindListReq 查询审计日志列表请求参数
+type FindListReq struct {
+	OrgId      string     // 部门ID
+	Operation  string     // 操作类型
+	OperatorId string     // 操作人ID
+	StartTime  *time.Time // 开始时间（包含）
+	EndTime    *time.Time // 结束时间（包含）
+	Page       int        // 页码
+	PageSize   int        // 每页大小
+}
+
 // Model 组织架构审计日志数据访问接口
 type Model interface {
 	// Insert 插入审计日志
@@ -37,6 +49,9 @@ type Model interface {
 	// FindRecent 查询最近的审计日志
 	FindRecent(ctx context.Context, limit int) ([]*OrgAudit, error)
 
+	// FindList 查询审计日志列表（支持分页、筛选和时间范围）
+	FindList(ctx context.Context, req *FindListReq) ([]*OrgAudit, int64, error)
+
 	// WithTx 返回事务版本
 	WithTx(tx interface{}) Model
 }
@@ -0,0 +1,12 @@
+package permission_catalog
+
+import (
+	"gorm.io/gorm"
+)
+
+// NewModel 创建权限目录 Model 实例（GORM）
+func NewModel(db *gorm.DB) Model {
+	return &gormCatalogModel{
+		db: db,
+	}
+}
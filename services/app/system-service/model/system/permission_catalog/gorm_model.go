@@ -0,0 +1,97 @@
+package permission_catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// gormCatalogModel GORM 实现的权限目录 Model
+type gormCatalogModel struct {
+	db *gorm.DB
+}
+
+// Insert 插入目录模块
+func (m *gormCatalogModel) Insert(ctx context.Context, data *CatalogModule) (*CatalogModule, error) {
+	err := m.db.WithContext(ctx).Create(data).Error
+	if err != nil {
+		if isDuplicateError(err) {
+			return nil, ErrCatalogModuleCodeExists
+		}
+		return nil, fmt.Errorf("创建权限目录模块失败: %w", err)
+	}
+	return data, nil
+}
+
+// FindOne 根据 ID 查询
+func (m *gormCatalogModel) FindOne(ctx context.Context, id string) (*CatalogModule, error) {
+	var module CatalogModule
+	err := m.db.WithContext(ctx).Where("id = ?", id).First(&module).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCatalogModuleNotFound
+		}
+		return nil, fmt.Errorf("查询权限目录模块失败: %w", err)
+	}
+	return &module, nil
+}
+
+// FindOneByCodeIncludingDeleted 根据模块编码查询（包括已删除，用于唯一性校验）
+func (m *gormCatalogModel) FindOneByCodeIncludingDeleted(ctx context.Context, code string) (*CatalogModule, error) {
+	var module CatalogModule
+	err := m.db.WithContext(ctx).Unscoped().Where("code = ?", code).First(&module).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCatalogModuleNotFound
+		}
+		return nil, fmt.Errorf("查询权限目录模块失败: %w", err)
+	}
+	return &module, nil
+}
+
+// FindAll 查询全部目录模块（按排序号、编码升序）
+func (m *gormCatalogModel) FindAll(ctx context.Context) ([]*CatalogModule, error) {
+	var list []*CatalogModule
+	err := m.db.WithContext(ctx).Order("sort_order ASC").Order("code ASC").Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询权限目录失败: %w", err)
+	}
+	return list, nil
+}
+
+// Update 更新目录模块
+func (m *gormCatalogModel) Update(ctx context.Context, data *CatalogModule) error {
+	err := m.db.WithContext(ctx).Save(data).Error
+	if err != nil {
+		if isDuplicateError(err) {
+			return ErrCatalogModuleCodeExists
+		}
+		return fmt.Errorf("更新权限目录模块失败: %w", err)
+	}
+	return nil
+}
+
+// Delete 删除目录模块（软删除）
+func (m *gormCatalogModel) Delete(ctx context.Context, id string) error {
+	err := m.db.WithContext(ctx).Delete(&CatalogModule{}, "id = ?", id).Error
+	if err != nil {
+		return fmt.Errorf("删除权限目录模块失败: %w", err)
+	}
+	return nil
+}
+
+// WithTx 使用事务
+func (m *gormCatalogModel) WithTx(tx interface{}) Model {
+	if gormTx, ok := tx.(*gorm.DB); ok {
+		return &gormCatalogModel{db: gormTx}
+	}
+	return m
+}
+
+// isDuplicateError 判断是否为唯一性约束错误
+func isDuplicateError(err error) bool {
+	return strings.Contains(err.Error(), "Duplicate entry") || strings.Contains(err.Error(), "UNIQUE constraint")
+}
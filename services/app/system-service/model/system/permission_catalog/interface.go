@@ -0,0 +1,29 @@
+package permission_catalog
+
+import (
+	"context"
+)
+
+// Model 权限目录数据访问接口
+type Model interface {
+	// Insert 插入目录模块
+	Insert(ctx context.Context, data *CatalogModule) (*CatalogModule, error)
+
+	// FindOne 根据 ID 查询
+	FindOne(ctx context.Context, id string) (*CatalogModule, error)
+
+	// FindOneByCodeIncludingDeleted 根据模块编码查询（包括已删除，用于唯一性校验）
+	FindOneByCodeIncludingDeleted(ctx context.Context, code string) (*CatalogModule, error)
+
+	// FindAll 查询全部目录模块（按排序号、编码升序）
+	FindAll(ctx context.Context) ([]*CatalogModule, error)
+
+	// Update 更新目录模块
+	Update(ctx context.Context, data *CatalogModule) error
+
+	// Delete 删除目录模块（软删除）
+	Delete(ctx context.Context, id string) error
+
+	// WithTx 使用事务
+	WithTx(tx interface{}) Model
+}
@@ -0,0 +1,62 @@
+package permission_catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// CatalogModule 权限目录模块
+// 登记策略矩阵可用的模块、模块允许的动作及数据范围，权限模板保存和发布时据此校验
+type CatalogModule struct {
+	Id          string         `gorm:"primaryKey;size:36" json:"id"`                                                                            // UUID v7
+	Code        string         `gorm:"size:64;not null;index:idx_code" json:"code"`                                                             // 模块编码（策略矩阵的键，全局唯一）
+	Name        string         `gorm:"size:128;not null" json:"name"`                                                                           // 模块名称
+	Description *string        `gorm:"size:500" json:"description,omitempty"`                                                                   // 模块描述
+	Actions     datatypes.JSON `gorm:"type:json;not null" json:"actions"`                                                                       // 允许的动作列表（[]Action）
+	Scopes      datatypes.JSON `gorm:"type:json;not null" json:"scopes"`                                                                        // 允许的数据范围列表（[]string）
+	SortOrder   int            `gorm:"not null;default:0" json:"sort_order"`                                                                    // 排序号（升序）
+	CreatedBy   string         `gorm:"size:36;not null" json:"created_by"`                                                                      // 创建人ID
+	CreatedAt   time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3)" json:"created_at"`                                // 创建时间
+	UpdatedBy   *string        `gorm:"size:36" json:"updated_by,omitempty"`                                                                     // 最后更新人ID
+	UpdatedAt   time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3)" json:"updated_at"` // 最后更新时间
+	DeletedAt   gorm.DeletedAt `gorm:"type:datetime(3);index:uk_code_deleted" json:"-"`                                                         // 删除时间（软删除，不返回）
+}
+
+// TableName 指定表名
+func (CatalogModule) TableName() string {
+	return "permission_catalog_modules"
+}
+
+// Action 模块动作
+type Action struct {
+	Code string `json:"code"` // 动作编码（策略矩阵 actions 的取值）
+	Name string `json:"name"` // 动作名称
+}
+
+// ParseActions 解析模块允许的动作列表
+func (m *CatalogModule) ParseActions() ([]Action, error) {
+	var actions []Action
+	if len(m.Actions) == 0 {
+		return actions, nil
+	}
+	if err := json.Unmarshal(m.Actions, &actions); err != nil {
+		return nil, fmt.Errorf("解析模块动作失败: module=%s, %w", m.Code, err)
+	}
+	return actions, nil
+}
+
+// ParseScopes 解析模块允许的数据范围列表
+func (m *CatalogModule) ParseScopes() ([]string, error) {
+	var scopes []string
+	if len(m.Scopes) == 0 {
+		return scopes, nil
+	}
+	if err := json.Unmarshal(m.Scopes, &scopes); err != nil {
+		return nil, fmt.Errorf("解析模块数据范围失败: module=%s, %w", m.Code, err)
+	}
+	return scopes, nil
+}
@@ -0,0 +1,31 @@
+package permission_catalog
+
+import (
+	"github.com/jinguoxing/idrm-go-base/errorx"
+)
+
+var (
+	// ErrCatalogModuleNotFound 目录模块不存在
+	ErrCatalogModuleNotFound = errorx.New(200200, "权限目录模块不存在")
+
+	// ErrCatalogModuleCodeExists 目录模块编码已存在
+	ErrCatalogModuleCodeExists = errorx.New(200201, "权限目录模块编码已存在")
+
+	// ErrCatalogModuleInUse 目录模块被已发布模板引用
+	ErrCatalogModuleInUse = errorx.New(200202, "权限目录模块被已发布的权限模板引用，无法删除")
+)
+
+// 数据范围常量（与权限模板适用范围一致）
+const (
+	ScopeGlobal       = "global"
+	ScopeOrganization = "organization"
+	ScopeDomain       = "domain"
+	ScopeProject      = "project"
+	// 组织架构数据范围：本部门、本部门及下级部门、仅本人
+	ScopeDepartment     = "department"
+	ScopeDepartmentTree = "department_tree"
+	ScopeSelf           = "self"
+)
+
+// AllScopes 全部数据范围
+var AllScopes = []string{ScopeGlobal, ScopeOrganization, ScopeDomain, ScopeProject, ScopeDepartment, ScopeDepartmentTree, ScopeSelf}
@@ -0,0 +1,12 @@
+package permission_points
+
+import (
+	"gorm.io/gorm"
+)
+
+// NewModel 创建权限点 Model 实例（GORM）
+func NewModel(db *gorm.DB) Model {
+	return &gormPermissionPointModel{
+		db: db,
+	}
+}
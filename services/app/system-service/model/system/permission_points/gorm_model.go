@@ -0,0 +1,150 @@
+package permission_points
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// gormPermissionPointModel GORM 实现的权限点 Model
+type gormPermissionPointModel struct {
+	db *gorm.DB
+}
+
+// Insert 插入权限点
+func (m *gormPermissionPointModel) Insert(ctx context.Context, data *PermissionPoint) (*PermissionPoint, error) {
+	err := m.db.WithContext(ctx).Create(data).Error
+	if err != nil {
+		if isDuplicateError(err) {
+			return nil, ErrPermissionPointKeyExists
+		}
+		return nil, fmt.Errorf("创建权限点失败: %w", err)
+	}
+	return data, nil
+}
+
+// FindOne 根据 ID 查询
+func (m *gormPermissionPointModel) FindOne(ctx context.Context, id string) (*PermissionPoint, error) {
+	var point PermissionPoint
+	err := m.db.WithContext(ctx).Where("id = ?", id).First(&point).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPermissionPointNotFound
+		}
+		return nil, fmt.Errorf("查询权限点失败: %w", err)
+	}
+	return &point, nil
+}
+
+// FindOneByKey 根据权限标识查询
+func (m *gormPermissionPointModel) FindOneByKey(ctx context.Context, key string) (*PermissionPoint, error) {
+	var point PermissionPoint
+	err := m.db.WithContext(ctx).Where("permission_key = ?", key).First(&point).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPermissionPointNotFound
+		}
+		return nil, fmt.Errorf("查询权限点失败: %w", err)
+	}
+	return &point, nil
+}
+
+// FindOneByKeyIncludingDeleted 根据权限标识查询（包括已删除，用于唯一性校验）
+func (m *gormPermissionPointModel) FindOneByKeyIncludingDeleted(ctx context.Context, key string) (*PermissionPoint, error) {
+	var point PermissionPoint
+	err := m.db.WithContext(ctx).Unscoped().Where("permission_key = ?", key).First(&point).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPermissionPointNotFound
+		}
+		return nil, fmt.Errorf("查询权限点失败: %w", err)
+	}
+	return &point, nil
+}
+
+// List 分页查询权限点列表（支持搜索和过滤）
+func (m *gormPermissionPointModel) List(ctx context.Context, filter *ListFilter) ([]*PermissionPoint, int64, error) {
+	var list []*PermissionPoint
+	var total int64
+
+	query := m.db.WithContext(ctx).Model(&PermissionPoint{})
+
+	// 搜索关键词（permission_key/name）
+	if filter.Keyword != "" {
+		keyword := "%" + strings.TrimSpace(filter.Keyword) + "%"
+		query = query.Where("permission_key LIKE ? OR name LIKE ?", keyword, keyword)
+	}
+
+	// 过滤：type
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+
+	// 过滤：module
+	if filter.Module != "" {
+		query = query.Where("module = ?", filter.Module)
+	}
+
+	// 先统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("统计权限点数量失败: %w", err)
+	}
+
+	// 分页参数
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 {
+		filter.PageSize = 10
+	}
+	if filter.PageSize > 100 {
+		filter.PageSize = 100
+	}
+
+	offset := (filter.Page - 1) * filter.PageSize
+
+	// 按权限标识升序排序，便于按模块前缀浏览
+	err := query.Order("permission_key ASC").Limit(filter.PageSize).Offset(offset).Find(&list).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询权限点列表失败: %w", err)
+	}
+
+	return list, total, nil
+}
+
+// Update 更新权限点
+func (m *gormPermissionPointModel) Update(ctx context.Context, data *PermissionPoint) error {
+	err := m.db.WithContext(ctx).Save(data).Error
+	if err != nil {
+		if isDuplicateError(err) {
+			return ErrPermissionPointKeyExists
+		}
+		return fmt.Errorf("更新权限点失败: %w", err)
+	}
+	return nil
+}
+
+// Delete 删除权限点（软删除）
+func (m *gormPermissionPointModel) Delete(ctx context.Context, id string) error {
+	err := m.db.WithContext(ctx).Delete(&PermissionPoint{}, "id = ?", id).Error
+	if err != nil {
+		return fmt.Errorf("删除权限点失败: %w", err)
+	}
+	return nil
+}
+
+// WithTx 使用事务
+func (m *gormPermissionPointModel) WithTx(tx interface{}) Model {
+	if gormTx, ok := tx.(*gorm.DB); ok {
+		return &gormPermissionPointModel{db: gormTx}
+	}
+	return m
+}
+
+// isDuplicateError 判断是否为唯一性约束错误
+func isDuplicateError(err error) bool {
+	return strings.Contains(err.Error(), "Duplicate entry") || strings.Contains(err.Error(), "UNIQUE constraint")
+}
@@ -0,0 +1,32 @@
+package permission_points
+
+import (
+	"context"
+)
+
+// Model 权限点数据访问接口
+type Model interface {
+	// Insert 插入权限点
+	Insert(ctx context.Context, data *PermissionPoint) (*PermissionPoint, error)
+
+	// FindOne 根据 ID 查询
+	FindOne(ctx context.Context, id string) (*PermissionPoint, error)
+
+	// FindOneByKey 根据权限标识查询
+	FindOneByKey(ctx context.Context, key string) (*PermissionPoint, error)
+
+	// FindOneByKeyIncludingDeleted 根据权限标识查询（包括已删除，用于唯一性校验）
+	FindOneByKeyIncludingDeleted(ctx context.Context, key string) (*PermissionPoint, error)
+
+	// List 分页查询权限点列表（支持搜索和过滤）
+	List(ctx context.Context, filter *ListFilter) ([]*PermissionPoint, int64, error)
+
+	// Update 更新权限点
+	Update(ctx context.Context, data *PermissionPoint) error
+
+	// Delete 删除权限点（软删除）
+	Delete(ctx context.Context, id string) error
+
+	// WithTx 使用事务
+	WithTx(tx interface{}) Model
+}
@@ -0,0 +1,37 @@
+package permission_points
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PermissionPoint 权限点
+// 菜单、按钮、接口所绑定的权限标识登记于此，菜单绑定权限时据此校验标识是否存在
+type PermissionPoint struct {
+	Id            string         `gorm:"primaryKey;size:36" json:"id"`                                                                            // UUID v7
+	PermissionKey string         `gorm:"size:128;not null;index:idx_permission_key" json:"permission_key"`                                        // 权限标识（全局唯一）
+	Name          string         `gorm:"size:128;not null" json:"name"`                                                                           // 权限名称
+	Type          string         `gorm:"size:16;not null" json:"type"`                                                                            // 类型：menu/button/api
+	Module        string         `gorm:"size:64;not null;index:idx_module" json:"module"`                                                         // 所属模块（权限目录模块编码）
+	Description   *string        `gorm:"size:500" json:"description,omitempty"`                                                                   // 描述
+	CreatedBy     string         `gorm:"size:36;not null" json:"created_by"`                                                                      // 创建人ID
+	CreatedAt     time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3)" json:"created_at"`                                // 创建时间
+	UpdatedBy     *string        `gorm:"size:36" json:"updated_by,omitempty"`                                                                     // 最后更新人ID
+	UpdatedAt     time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3)" json:"updated_at"` // 最后更新时间
+	DeletedAt     gorm.DeletedAt `gorm:"type:datetime(3);index:uk_permission_key_deleted" json:"-"`                                               // 删除时间（软删除，不返回）
+}
+
+// TableName 指定表名
+func (PermissionPoint) TableName() string {
+	return "permission_points"
+}
+
+// ListFilter 查询权限点列表请求参数
+type ListFilter struct {
+	Keyword  string // 搜索关键词（permission_key/name）
+	Type     string // 类型筛选
+	Module   string // 所属模块筛选
+	Page     int    // 页码（从1开始）
+	PageSize int    // 每页数量
+}
@@ -0,0 +1,23 @@
+package permission_points
+
+import (
+	"github.com/jinguoxing/idrm-go-base/errorx"
+)
+
+var (
+	// ErrPermissionPointNotFound 权限点不存在
+	ErrPermissionPointNotFound = errorx.New(200220, "权限点不存在")
+
+	// ErrPermissionPointKeyExists 权限标识已存在
+	ErrPermissionPointKeyExists = errorx.New(200221, "权限标识已存在")
+
+	// ErrPermissionPointInUse 权限点被菜单引用
+	ErrPermissionPointInUse = errorx.New(200222, "权限点被菜单引用，无法删除")
+)
+
+// 权限点类型常量
+const (
+	TypeMenu   = "menu"
+	TypeButton = "button"
+	TypeAPI    = "api"
+)
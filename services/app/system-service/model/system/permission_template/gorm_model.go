@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -66,6 +67,16 @@ func (m *gormPermissionTemplateModel) FindOneByCodeIncludingDeleted(ctx context.
 	return &template, nil
 }
 
+// FindByParentId 查询直接继承指定模板的子模板（未删除）
+func (m *gormPermissionTemplateModel) FindByParentId(ctx context.Context, parentId string) ([]*PermissionTemplate, error) {
+	var templates []*PermissionTemplate
+	err := m.db.WithContext(ctx).Where("parent_id = ?", parentId).Order("code ASC").Find(&templates).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询子模板失败: %w", err)
+	}
+	return templates, nil
+}
+
 // List 查询权限模板列表（支持筛选和分页）
 func (m *gormPermissionTemplateModel) List(ctx context.Context, filter *ListFilter) ([]*PermissionTemplate, int64, error) {
 	var templates []*PermissionTemplate
@@ -189,14 +200,31 @@ func (m *gormPermissionTemplateModel) Delete(ctx context.Context, id string) err
 }
 
 // GetUsageStats 获取模板使用统计（被角色引用数量）
-// 注意：当前版本返回空统计，后续需要关联角色表实现
+// 统计 roles 表中引用该模板的未删除角色，最后应用时间取最近一次 template_applied_at
 func (m *gormPermissionTemplateModel) GetUsageStats(ctx context.Context, id string) (*UsageStats, error) {
-	// TODO: 后续需要关联 role_templates 或 roles 表查询实际使用情况
-	// 当前版本返回零值
-	stats := &UsageStats{
-		UsedByRoleCount: 0,
-		LastAppliedAt:   nil,
+	stats := &UsageStats{}
+
+	query := m.db.WithContext(ctx).Table("roles").Where("template_id = ? AND deleted_at IS NULL", id)
+	if err := query.Count(&stats.UsedByRoleCount).Error; err != nil {
+		return nil, fmt.Errorf("统计模板关联角色失败: %w", err)
+	}
+	if stats.UsedByRoleCount == 0 {
+		return stats, nil
+	}
+
+	var latest struct {
+		TemplateAppliedAt time.Time
+	}
+	err := m.db.WithContext(ctx).Table("roles").
+		Select("template_applied_at").
+		Where("template_id = ? AND deleted_at IS NULL", id).
+		Order("template_applied_at DESC").
+		Limit(1).
+		Scan(&latest).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询模板最近应用时间失败: %w", err)
 	}
+	stats.LastAppliedAt = &latest.TemplateAppliedAt
 	return stats, nil
 }
 
@@ -18,6 +18,9 @@ type Model interface {
 	// FindOneByCodeIncludingDeleted 根据 code 查询（包括已删除，用于唯一性校验）
 	FindOneByCodeIncludingDeleted(ctx context.Context, code string) (*PermissionTemplate, error)
 
+	// FindByParentId 查询直接继承指定模板的子模板（未删除）
+	FindByParentId(ctx context.Context, parentId string) ([]*PermissionTemplate, error)
+
 	// List 查询权限模板列表（支持筛选和分页）
 	List(ctx context.Context, filter *ListFilter) ([]*PermissionTemplate, int64, error)
 
@@ -15,7 +15,9 @@ type PermissionTemplate struct {
 	Description     *string        `gorm:"size:500" json:"description,omitempty"`                                                   // 模板描述
 	Status          string         `gorm:"size:20;not null;default:'draft';index:idx_status" json:"status"`                          // 模板状态：draft/published/disabled
 	ScopeSuggestion *string        `gorm:"size:50;index:idx_scope_suggestion" json:"scope_suggestion,omitempty"`                     // 推荐适用范围：global/organization/domain/project
-	PolicyMatrix    datatypes.JSON `gorm:"type:json;not null" json:"policy_matrix"`                                                 // 策略矩阵（模块×动作勾选关系）
+	ParentId        *string        `gorm:"size:36;index:idx_parent_id" json:"parent_id,omitempty"`                                  // 父模板ID（继承父模板的有效策略矩阵）
+	PolicyMatrix    datatypes.JSON `gorm:"type:json;not null" json:"policy_matrix"`                                                 // 策略矩阵（模块×动作勾选关系，继承时为自身新增部分）
+	PolicyRemovals  datatypes.JSON `gorm:"type:json" json:"policy_removals"`                                                        // 从父模板继承时显式移除的模块动作（模块 -> 动作列表）
 	AdvancedPerms   datatypes.JSON `gorm:"type:json" json:"advanced_perms"`                                                         // 高级权限点配置
 	Version         int            `gorm:"type:int;not null;default:1" json:"version"`                                               // 版本号（每次发布递增）
 	CreatedBy       string         `gorm:"size:36;not null" json:"created_by"`                                                      // 创建人ID
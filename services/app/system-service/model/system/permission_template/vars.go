@@ -20,8 +20,8 @@ var (
 	// ErrPermissionTemplateInvalidStatus 无效的模板状态
 	ErrPermissionTemplateInvalidStatus = errorx.New(200155, "无效的模板状态")
 
-	// ErrPermissionTemplateNotDraft 模板不是草稿状态
-	ErrPermissionTemplateNotDraft = errorx.New(200156, "只有草稿状态的模板可以编辑")
+	// ErrPermissionTemplateNotDraft 模板不可编辑（已停用）
+	ErrPermissionTemplateNotDraft = errorx.New(200156, "只有草稿或已发布状态的模板可以编辑")
 
 	// ErrPermissionTemplateNotPublished 模板不是已发布状态
 	ErrPermissionTemplateNotPublished = errorx.New(200157, "只有已发布的模板可以停用")
@@ -44,8 +44,26 @@ var (
 	// ErrPermissionTemplateCreateFailed 创建权限模板失败
 	ErrPermissionTemplateCreateFailed = errorx.New(200163, "创建权限模板失败")
 
+	// ErrPermissionTemplateDisabledNotUsable 模板未发布或已停用，不可用于创建角色
+	ErrPermissionTemplateDisabledNotUsable = errorx.New(200166, "模板未发布或已停用，不可用于创建角色")
+
 	// ErrPermissionTemplateStatusTransitionInvalid 无效的状态流转
 	ErrPermissionTemplateStatusTransitionInvalid = errorx.New(200164, "无效的状态流转")
+
+	// ErrPermissionTemplateImpactAckRequired 变更导致较多用户失去权限，需确认影响预览
+	ErrPermissionTemplateImpactAckRequired = errorx.New(200179, "变更将导致较多用户失去权限，请先预览影响并提交确认令牌")
+
+	// ErrPermissionTemplateParentNotFound 父模板不存在
+	ErrPermissionTemplateParentNotFound = errorx.New(200240, "父模板不存在")
+
+	// ErrPermissionTemplateInheritanceCycle 模板继承关系存在循环
+	ErrPermissionTemplateInheritanceCycle = errorx.New(200241, "模板继承关系存在循环")
+
+	// ErrPermissionTemplateHasChildren 模板被子模板继承，无法删除
+	ErrPermissionTemplateHasChildren = errorx.New(200242, "模板被子模板继承，无法删除")
+
+	// ErrPermissionTemplateParentNotPublished 父模板未发布，子模板无法发布
+	ErrPermissionTemplateParentNotPublished = errorx.New(200243, "父模板未发布，子模板无法发布")
 )
 
 const (
@@ -63,6 +81,10 @@ const (
 	ScopeOrganization  = "organization"
 	ScopeDomain        = "domain"
 	ScopeProject       = "project"
+	// 组织架构数据范围：本部门、本部门及下级部门、仅本人
+	ScopeDepartment     = "department"
+	ScopeDepartmentTree = "department_tree"
+	ScopeSelf           = "self"
 )
 
 // ListFilter 查询权限模板列表请求参数
@@ -0,0 +1,17 @@
+package permission_template_audit_logs
+
+import (
+	"gorm.io/gorm"
+)
+
+// NewModel 创建权限模板审计日志 Model 实例（GORM）
+func NewModel(db *gorm.DB) Model {
+	return &gormPermissionTemplateAuditLogModel{
+		db: db,
+	}
+}
+
+// gormPermissionTemplateAuditLogModel GORM 实现的权限模板审计日志 Model
+type gormPermissionTemplateAuditLogModel struct {
+	db *gorm.DB
+}
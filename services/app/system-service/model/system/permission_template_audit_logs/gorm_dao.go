@@ -0,0 +1,89 @@
+package permission_template_audit_logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Insert 插入审计日志
+func (m *gormPermissionTemplateAuditLogModel) Insert(ctx context.Context, data *PermissionTemplateAuditLog) (*PermissionTemplateAuditLog, error) {
+	if data.Id == "" {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, fmt.Errorf("generate uuid failed: %w", err)
+		}
+		data.Id = id.String()
+	}
+
+	err := m.db.WithContext(ctx).Create(data).Error
+	if err != nil {
+		return nil, fmt.Errorf("创建审计日志失败: %w", err)
+	}
+	return data, nil
+}
+
+// FindList 查询审计日志列表（支持分页和筛选）
+func (m *gormPermissionTemplateAuditLogModel) FindList(ctx context.Context, req *FindListReq) ([]*PermissionTemplateAuditLog, int64, error) {
+	var logs []*PermissionTemplateAuditLog
+	var total int64
+
+	query := m.db.WithContext(ctx).Model(&PermissionTemplateAuditLog{})
+
+	// 筛选：template_id
+	if req.TemplateId != "" {
+		query = query.Where("template_id = ?", req.TemplateId)
+	}
+
+	// 筛选：operation_type
+	if req.OperationType != "" {
+		query = query.Where("operation_type = ?", req.OperationType)
+	}
+
+	// 筛选：operator_id
+	if req.OperatorId != "" {
+		query = query.Where("operator_id = ?", req.OperatorId)
+	}
+
+	// 筛选：时间范围
+	if req.StartTime != nil {
+		query = query.Where("created_at >= ?", *req.StartTime)
+	}
+	if req.EndTime != nil {
+		query = query.Where("created_at <= ?", *req.EndTime)
+	}
+
+	// 获取总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("查询审计日志总数失败: %w", err)
+	}
+
+	// 按创建时间倒序排序（同一时间按 ID 倒序，UUID v7 保证写入顺序）
+	query = query.Order("created_at DESC").Order("id DESC")
+
+	// 分页
+	if req.PageSize > 0 {
+		offset := (req.Page - 1) * req.PageSize
+		if offset < 0 {
+			offset = 0
+		}
+		query = query.Offset(offset).Limit(req.PageSize)
+	}
+
+	// 执行查询
+	if err := query.Find(&logs).Error; err != nil {
+		return nil, 0, fmt.Errorf("查询审计日志列表失败: %w", err)
+	}
+
+	return logs, total, nil
+}
+
+// WithTx 使用事务
+func (m *gormPermissionTemplateAuditLogModel) WithTx(tx interface{}) Model {
+	if gormTx, ok := tx.(*gorm.DB); ok {
+		return &gormPermissionTemplateAuditLogModel{db: gormTx}
+	}
+	return m
+}
@@ -0,0 +1,17 @@
+package permission_template_audit_logs
+
+import (
+	"context"
+)
+
+// Model 权限模板审计日志数据访问接口
+type Model interface {
+	// Insert 插入审计日志
+	Insert(ctx context.Context, data *PermissionTemplateAuditLog) (*PermissionTemplateAuditLog, error)
+
+	// FindList 查询审计日志列表（支持分页和筛选）
+	FindList(ctx context.Context, req *FindListReq) ([]*PermissionTemplateAuditLog, int64, error)
+
+	// WithTx 使用事务
+	WithTx(tx interface{}) Model
+}
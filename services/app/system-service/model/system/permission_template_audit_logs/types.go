@@ -0,0 +1,38 @@
+package permission_template_audit_logs
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// PermissionTemplateAuditLog 权限模板审计日志实体
+type PermissionTemplateAuditLog struct {
+	Id            string         `gorm:"primaryKey;size:36" json:"id"`                                             // UUID v7
+	TemplateId    string         `gorm:"size:36;not null;index" json:"template_id"`                                // 模板ID
+	OperationType string         `gorm:"size:20;not null;index" json:"operation_type"`                             // 操作类型：create/update/publish/enable/disable/clone/delete/restore/discard_draft
+	OperatorId    *string        `gorm:"size:36;index" json:"operator_id,omitempty"`                               // 操作人ID
+	OperatorName  *string        `gorm:"size:128" json:"operator_name,omitempty"`                                  // 操作人名称
+	Version       int            `gorm:"type:int;not null;default:1" json:"version"`                               // 操作后的模板版本号
+	Changes       datatypes.JSON `gorm:"type:json" json:"changes,omitempty"`                                       // 变更差异（JSON格式，字段 -> {old, new}）
+	OldValue      datatypes.JSON `gorm:"type:json" json:"old_value,omitempty"`                                     // 旧值（JSON格式）
+	NewValue      datatypes.JSON `gorm:"type:json" json:"new_value,omitempty"`                                     // 新值（JSON格式）
+	Remark        *string        `gorm:"size:512" json:"remark,omitempty"`                                         // 备注
+	CreatedAt     time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3)" json:"created_at"` // 创建时间
+}
+
+// TableName 指定表名
+func (PermissionTemplateAuditLog) TableName() string {
+	return "permission_template_audit_logs"
+}
+
+// FindListReq 查询审计日志列表请求参数
+type FindListReq struct {
+	TemplateId    string     // 模板ID
+	OperationType string     // 操作类型
+	OperatorId    string     // 操作人ID
+	StartTime     *time.Time // 开始时间
+	EndTime       *time.Time // 结束时间
+	Page          int        // 页码
+	PageSize      int        // 每页大小
+}
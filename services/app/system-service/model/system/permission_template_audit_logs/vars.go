@@ -0,0 +1,14 @@
+package permission_template_audit_logs
+
+// 操作类型
+const (
+	OperationCreate       = "create"        // 创建模板
+	OperationUpdate       = "update"        // 编辑模板
+	OperationPublish      = "publish"       // 发布模板
+	OperationEnable       = "enable"        // 重新启用模板
+	OperationDisable      = "disable"       // 停用模板
+	OperationClone        = "clone"         // 复制模板（记录在新模板上）
+	OperationDelete       = "delete"        // 删除模板
+	OperationRestore      = "restore"       // 从历史版本恢复为新草稿（记录在新模板上）
+	OperationDiscardDraft = "discard_draft" // 放弃已发布模板的工作草稿
+)
@@ -0,0 +1,12 @@
+package permission_template_drafts
+
+import (
+	"gorm.io/gorm"
+)
+
+// NewModel 创建权限模板工作草稿 Model 实例（GORM）
+func NewModel(db *gorm.DB) Model {
+	return &gormPermissionTemplateDraftModel{
+		db: db,
+	}
+}
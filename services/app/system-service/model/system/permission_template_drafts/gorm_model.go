@@ -0,0 +1,76 @@
+package permission_template_drafts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// gormPermissionTemplateDraftModel GORM 实现的权限模板工作草稿 Model
+type gormPermissionTemplateDraftModel struct {
+	db *gorm.DB
+}
+
+// Upsert 保存工作草稿（冲突时仅覆盖内容字段，保留创建人和创建时间）
+func (m *gormPermissionTemplateDraftModel) Upsert(ctx context.Context, data *PermissionTemplateDraft) error {
+	err := m.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "template_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"name", "code", "description", "scope_suggestion", "parent_id", "policy_matrix",
+			"policy_removals", "advanced_perms", "base_version", "updated_by", "updated_at",
+		}),
+	}).Create(data).Error
+	if err != nil {
+		return fmt.Errorf("保存权限模板工作草稿失败: %w", err)
+	}
+	return nil
+}
+
+// FindOne 根据模板ID查询工作草稿
+func (m *gormPermissionTemplateDraftModel) FindOne(ctx context.Context, templateId string) (*PermissionTemplateDraft, error) {
+	var draft PermissionTemplateDraft
+	err := m.db.WithContext(ctx).Where("template_id = ?", templateId).First(&draft).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTemplateDraftNotFound
+		}
+		return nil, fmt.Errorf("查询权限模板工作草稿失败: %w", err)
+	}
+	return &draft, nil
+}
+
+// FindByTemplateIds 批量查询工作草稿
+func (m *gormPermissionTemplateDraftModel) FindByTemplateIds(ctx context.Context, templateIds []string) ([]*PermissionTemplateDraft, error) {
+	var list []*PermissionTemplateDraft
+	if len(templateIds) == 0 {
+		return list, nil
+	}
+	err := m.db.WithContext(ctx).Where("template_id IN ?", templateIds).Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询权限模板工作草稿失败: %w", err)
+	}
+	return list, nil
+}
+
+// Delete 删除工作草稿
+func (m *gormPermissionTemplateDraftModel) Delete(ctx context.Context, templateId string) error {
+	result := m.db.WithContext(ctx).Where("template_id = ?", templateId).Delete(&PermissionTemplateDraft{})
+	if result.Error != nil {
+		return fmt.Errorf("删除权限模板工作草稿失败: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrTemplateDraftNotFound
+	}
+	return nil
+}
+
+// WithTx 使用事务
+func (m *gormPermissionTemplateDraftModel) WithTx(tx interface{}) Model {
+	if gormTx, ok := tx.(*gorm.DB); ok {
+		return &gormPermissionTemplateDraftModel{db: gormTx}
+	}
+	return m
+}
@@ -0,0 +1,24 @@
+package permission_template_drafts
+
+import (
+	"context"
+)
+
+// Model 权限模板工作草稿数据访问接口
+// 每个已发布模板最多一份工作草稿，发布后应用到模板并删除，放弃时直接删除
+type Model interface {
+	// Upsert 保存工作草稿（不存在时创建，存在时覆盖内容并保留创建信息）
+	Upsert(ctx context.Context, data *PermissionTemplateDraft) error
+
+	// FindOne 根据模板ID查询工作草稿
+	FindOne(ctx context.Context, templateId string) (*PermissionTemplateDraft, error)
+
+	// FindByTemplateIds 批量查询工作草稿（用于列表展示待发布变更标识）
+	FindByTemplateIds(ctx context.Context, templateIds []string) ([]*PermissionTemplateDraft, error)
+
+	// Delete 删除工作草稿
+	Delete(ctx context.Context, templateId string) error
+
+	// WithTx 使用事务
+	WithTx(tx interface{}) Model
+}
@@ -0,0 +1,31 @@
+package permission_template_drafts
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// PermissionTemplateDraft 已发布权限模板的工作草稿
+// 编辑已发布模板时写入，草稿发布前模板仍按已发布内容授权
+type PermissionTemplateDraft struct {
+	TemplateId      string         `gorm:"primaryKey;size:36" json:"template_id"`                                                                   // 模板ID
+	Name            string         `gorm:"size:128;not null" json:"name"`                                                                           // 模板名称
+	Code            string         `gorm:"size:64;not null" json:"code"`                                                                            // 模板编码
+	Description     *string        `gorm:"size:500" json:"description,omitempty"`                                                                   // 模板描述
+	ScopeSuggestion *string        `gorm:"size:50" json:"scope_suggestion,omitempty"`                                                               // 推荐适用范围
+	ParentId        *string        `gorm:"size:36" json:"parent_id,omitempty"`                                                                      // 父模板ID
+	PolicyMatrix    datatypes.JSON `gorm:"type:json;not null" json:"policy_matrix"`                                                                 // 策略矩阵
+	PolicyRemovals  datatypes.JSON `gorm:"type:json" json:"policy_removals"`                                                                        // 从父模板继承时显式移除的模块动作
+	AdvancedPerms   datatypes.JSON `gorm:"type:json" json:"advanced_perms"`                                                                         // 高级权限点配置
+	BaseVersion     int            `gorm:"type:int;not null" json:"base_version"`                                                                   // 草稿基于的已发布版本号
+	CreatedBy       string         `gorm:"size:36;not null" json:"created_by"`                                                                      // 创建人ID
+	CreatedAt       time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3)" json:"created_at"`                                // 创建时间
+	UpdatedBy       *string        `gorm:"size:36" json:"updated_by,omitempty"`                                                                     // 最后更新人ID
+	UpdatedAt       time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3)" json:"updated_at"` // 最后更新时间
+}
+
+// TableName 指定表名
+func (PermissionTemplateDraft) TableName() string {
+	return "permission_template_drafts"
+}
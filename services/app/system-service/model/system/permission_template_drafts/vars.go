@@ -0,0 +1,10 @@
+package permission_template_drafts
+
+import (
+	"github.com/jinguoxing/idrm-go-base/errorx"
+)
+
+var (
+	// ErrTemplateDraftNotFound 模板没有待发布的工作草稿
+	ErrTemplateDraftNotFound = errorx.New(200178, "权限模板没有待发布的工作草稿")
+)
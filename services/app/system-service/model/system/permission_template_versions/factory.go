@@ -0,0 +1,12 @@
+package permission_template_versions
+
+import (
+	"gorm.io/gorm"
+)
+
+// NewModel 创建权限模板版本快照 Model 实例（GORM）
+func NewModel(db *gorm.DB) Model {
+	return &gormPermissionTemplateVersionModel{
+		db: db,
+	}
+}
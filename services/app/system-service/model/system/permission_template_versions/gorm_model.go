@@ -0,0 +1,72 @@
+package permission_template_versions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// gormPermissionTemplateVersionModel GORM 实现的权限模板版本快照 Model
+type gormPermissionTemplateVersionModel struct {
+	db *gorm.DB
+}
+
+// Insert 插入版本快照
+func (m *gormPermissionTemplateVersionModel) Insert(ctx context.Context, data *PermissionTemplateVersion) (*PermissionTemplateVersion, error) {
+	if data.Id == "" {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, fmt.Errorf("generate uuid failed: %w", err)
+		}
+		data.Id = id.String()
+	}
+
+	err := m.db.WithContext(ctx).Create(data).Error
+	if err != nil {
+		if isDuplicateError(err) {
+			return nil, ErrTemplateVersionExists
+		}
+		return nil, fmt.Errorf("创建权限模板版本快照失败: %w", err)
+	}
+	return data, nil
+}
+
+// FindOne 根据模板ID和版本号查询
+func (m *gormPermissionTemplateVersionModel) FindOne(ctx context.Context, templateId string, version int) (*PermissionTemplateVersion, error) {
+	var snapshot PermissionTemplateVersion
+	err := m.db.WithContext(ctx).Where("template_id = ? AND version = ?", templateId, version).First(&snapshot).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTemplateVersionNotFound
+		}
+		return nil, fmt.Errorf("查询权限模板版本快照失败: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// FindByTemplateId 查询模板的全部版本快照（按版本号降序）
+func (m *gormPermissionTemplateVersionModel) FindByTemplateId(ctx context.Context, templateId string) ([]*PermissionTemplateVersion, error) {
+	var list []*PermissionTemplateVersion
+	err := m.db.WithContext(ctx).Where("template_id = ?", templateId).Order("version DESC").Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询权限模板版本快照失败: %w", err)
+	}
+	return list, nil
+}
+
+// WithTx 使用事务
+func (m *gormPermissionTemplateVersionModel) WithTx(tx interface{}) Model {
+	if gormTx, ok := tx.(*gorm.DB); ok {
+		return &gormPermissionTemplateVersionModel{db: gormTx}
+	}
+	return m
+}
+
+// isDuplicateError 判断是否为唯一性约束错误
+func isDuplicateError(err error) bool {
+	return strings.Contains(err.Error(), "Duplicate entry") || strings.Contains(err.Error(), "UNIQUE constraint")
+}
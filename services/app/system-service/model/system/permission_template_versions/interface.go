@@ -0,0 +1,21 @@
+package permission_template_versions
+
+import (
+	"context"
+)
+
+// Model 权限模板版本快照数据访问接口
+// 快照在发布时写入，之后不可修改或删除
+type Model interface {
+	// Insert 插入版本快照
+	Insert(ctx context.Context, data *PermissionTemplateVersion) (*PermissionTemplateVersion, error)
+
+	// FindOne 根据模板ID和版本号查询
+	FindOne(ctx context.Context, templateId string, version int) (*PermissionTemplateVersion, error)
+
+	// FindByTemplateId 查询模板的全部版本快照（按版本号降序）
+	FindByTemplateId(ctx context.Context, templateId string) ([]*PermissionTemplateVersion, error)
+
+	// WithTx 使用事务
+	WithTx(tx interface{}) Model
+}
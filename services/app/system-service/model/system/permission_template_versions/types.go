@@ -0,0 +1,28 @@
+package permission_template_versions
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// PermissionTemplateVersion 权限模板已发布版本的不可变快照
+// 固定旧版本的角色按快照中的策略矩阵和高级权限点授权
+type PermissionTemplateVersion struct {
+	Id              string         `gorm:"primaryKey;size:36" json:"id"`                                               // UUID v7
+	TemplateId      string         `gorm:"size:36;not null;uniqueIndex:uk_template_version" json:"template_id"`        // 模板ID
+	Version         int            `gorm:"type:int;not null;uniqueIndex:uk_template_version" json:"version"`           // 版本号
+	Name            string         `gorm:"size:128;not null" json:"name"`                                              // 发布时的模板名称
+	Code            string         `gorm:"size:64;not null" json:"code"`                                               // 发布时的模板编码
+	Description     *string        `gorm:"size:500" json:"description,omitempty"`                                      // 发布时的模板描述
+	ScopeSuggestion *string        `gorm:"size:50" json:"scope_suggestion,omitempty"`                                  // 发布时的推荐适用范围
+	PolicyMatrix    datatypes.JSON `gorm:"type:json;not null" json:"policy_matrix"`                                    // 策略矩阵快照
+	AdvancedPerms   datatypes.JSON `gorm:"type:json" json:"advanced_perms"`                                            // 高级权限点快照
+	PublishedBy     string         `gorm:"size:36;not null" json:"published_by"`                                       // 发布人ID
+	PublishedAt     time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3)" json:"published_at"` // 发布时间
+}
+
+// TableName 指定表名
+func (PermissionTemplateVersion) TableName() string {
+	return "permission_template_versions"
+}
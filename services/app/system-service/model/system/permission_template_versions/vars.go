@@ -0,0 +1,13 @@
+package permission_template_versions
+
+import (
+	"github.com/jinguoxing/idrm-go-base/errorx"
+)
+
+var (
+	// ErrTemplateVersionNotFound 模板版本不存在
+	ErrTemplateVersionNotFound = errorx.New(200176, "权限模板版本不存在")
+
+	// ErrTemplateVersionExists 模板版本快照已存在
+	ErrTemplateVersionExists = errorx.New(200177, "权限模板版本快照已存在")
+)
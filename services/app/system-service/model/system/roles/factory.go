@@ -0,0 +1,12 @@
+package roles
+
+import (
+	"gorm.io/gorm"
+)
+
+// NewModel 创建角色 Model 实例（GORM）
+func NewModel(db *gorm.DB) Model {
+	return &gormRoleModel{
+		db: db,
+	}
+}
@@ -0,0 +1,168 @@
+package roles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// gormRoleModel GORM 实现的角色 Model
+type gormRoleModel struct {
+	db *gorm.DB
+}
+
+// Insert 插入角色
+func (m *gormRoleModel) Insert(ctx context.Context, data *Role) (*Role, error) {
+	err := m.db.WithContext(ctx).Create(data).Error
+	if err != nil {
+		if isDuplicateError(err) {
+			return nil, ErrRoleCodeExists
+		}
+		return nil, fmt.Errorf("创建角色失败: %w", err)
+	}
+	return data, nil
+}
+
+// FindOne 根据 ID 查询
+func (m *gormRoleModel) FindOne(ctx context.Context, id string) (*Role, error) {
+	var role Role
+	err := m.db.WithContext(ctx).Where("id = ?", id).First(&role).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("查询角色失败: %w", err)
+	}
+	return &role, nil
+}
+
+// FindOneByCode 根据 code 查询（未删除）
+func (m *gormRoleModel) FindOneByCode(ctx context.Context, code string) (*Role, error) {
+	var role Role
+	err := m.db.WithContext(ctx).Where("code = ?", code).First(&role).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("查询角色失败: %w", err)
+	}
+	return &role, nil
+}
+
+// FindOneByCodeIncludingDeleted 根据 code 查询（包括已删除，用于唯一性校验）
+func (m *gormRoleModel) FindOneByCodeIncludingDeleted(ctx context.Context, code string) (*Role, error) {
+	var role Role
+	err := m.db.WithContext(ctx).Unscoped().Where("code = ?", code).First(&role).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("查询角色失败: %w", err)
+	}
+	return &role, nil
+}
+
+// FindByTemplateId 查询引用指定模板的角色
+func (m *gormRoleModel) FindByTemplateId(ctx context.Context, templateId string) ([]*Role, error) {
+	var list []*Role
+	err := m.db.WithContext(ctx).
+		Where("template_id = ?", templateId).
+		Order("code ASC").
+		Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询模板关联角色失败: %w", err)
+	}
+	return list, nil
+}
+
+// List 查询角色列表（支持筛选和分页）
+func (m *gormRoleModel) List(ctx context.Context, filter *ListFilter) ([]*Role, int64, error) {
+	var list []*Role
+	var total int64
+
+	query := m.db.WithContext(ctx).Model(&Role{})
+
+	// 搜索关键词（name/code）
+	if filter.Keyword != "" {
+		keyword := "%" + strings.TrimSpace(filter.Keyword) + "%"
+		query = query.Where("name LIKE ? OR code LIKE ?", keyword, keyword)
+	}
+
+	// 过滤：scope
+	if filter.Scope != "" {
+		query = query.Where("scope = ?", filter.Scope)
+	}
+
+	// 过滤：org_id
+	if filter.OrgId != "" {
+		query = query.Where("org_id = ?", filter.OrgId)
+	}
+
+	// 过滤：template_id
+	if filter.TemplateId != "" {
+		query = query.Where("template_id = ?", filter.TemplateId)
+	}
+
+	// 先统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("统计角色数量失败: %w", err)
+	}
+
+	// 分页参数
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 {
+		filter.PageSize = 10
+	}
+	if filter.PageSize > 100 {
+		filter.PageSize = 100
+	}
+
+	offset := (filter.Page - 1) * filter.PageSize
+
+	// 按 updated_at 降序排序，支持分页
+	err := query.Order("updated_at DESC").Limit(filter.PageSize).Offset(offset).Find(&list).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询角色列表失败: %w", err)
+	}
+
+	return list, total, nil
+}
+
+// Update 更新角色
+func (m *gormRoleModel) Update(ctx context.Context, data *Role) error {
+	err := m.db.WithContext(ctx).Save(data).Error
+	if err != nil {
+		if isDuplicateError(err) {
+			return ErrRoleCodeExists
+		}
+		return fmt.Errorf("更新角色失败: %w", err)
+	}
+	return nil
+}
+
+// Delete 删除角色（软删除）
+func (m *gormRoleModel) Delete(ctx context.Context, id string) error {
+	err := m.db.WithContext(ctx).Delete(&Role{}, "id = ?", id).Error
+	if err != nil {
+		return fmt.Errorf("删除角色失败: %w", err)
+	}
+	return nil
+}
+
+// WithTx 使用事务
+func (m *gormRoleModel) WithTx(tx interface{}) Model {
+	if gormTx, ok := tx.(*gorm.DB); ok {
+		return &gormRoleModel{db: gormTx}
+	}
+	return m
+}
+
+// isDuplicateError 判断是否为唯一性约束错误
+func isDuplicateError(err error) bool {
+	return strings.Contains(err.Error(), "Duplicate entry") || strings.Contains(err.Error(), "UNIQUE constraint")
+}
@@ -0,0 +1,35 @@
+package roles
+
+import (
+	"context"
+)
+
+// Model 角色数据访问接口
+type Model interface {
+	// Insert 插入角色
+	Insert(ctx context.Context, data *Role) (*Role, error)
+
+	// FindOne 根据 ID 查询
+	FindOne(ctx context.Context, id string) (*Role, error)
+
+	// FindOneByCode 根据 code 查询（未删除）
+	FindOneByCode(ctx context.Context, code string) (*Role, error)
+
+	// FindOneByCodeIncludingDeleted 根据 code 查询（包括已删除，用于唯一性校验）
+	FindOneByCodeIncludingDeleted(ctx context.Context, code string) (*Role, error)
+
+	// FindByTemplateId 查询引用指定模板的角色
+	FindByTemplateId(ctx context.Context, templateId string) ([]*Role, error)
+
+	// List 查询角色列表（支持筛选和分页）
+	List(ctx context.Context, filter *ListFilter) ([]*Role, int64, error)
+
+	// Update 更新角色
+	Update(ctx context.Context, data *Role) error
+
+	// Delete 删除角色（软删除）
+	Delete(ctx context.Context, id string) error
+
+	// WithTx 使用事务
+	WithTx(tx interface{}) Model
+}
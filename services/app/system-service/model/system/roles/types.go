@@ -0,0 +1,41 @@
+package roles
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Role 角色实体
+// 角色由权限模板派生，并固定引用模板的版本号；角色绑定通过 role_id 引用角色
+type Role struct {
+	Id                string         `gorm:"primaryKey;size:36" json:"id"`                                                                            // UUID v7
+	Code              string         `gorm:"size:64;not null;index:idx_code" json:"code"`                                                             // 角色编码（全局唯一）
+	Name              string         `gorm:"size:128;not null" json:"name"`                                                                           // 角色名称
+	Description       *string        `gorm:"size:500" json:"description,omitempty"`                                                                   // 角色描述
+	Scope             string         `gorm:"size:50;not null;default:'global';index:idx_scope" json:"scope"`                                          // 适用范围：global/organization/domain/project
+	OrgId             *string        `gorm:"size:36;index:idx_org_id" json:"org_id,omitempty"`                                                        // 组织范围（scope=organization 时必填）
+	TemplateId        string         `gorm:"size:36;not null;index:idx_template_id" json:"template_id"`                                               // 来源权限模板ID
+	TemplateVersion   int            `gorm:"type:int;not null;default:1" json:"template_version"`                                                     // 固定的模板版本号
+	TemplateAppliedAt time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3)" json:"template_applied_at"`                       // 最近一次应用模板的时间
+	CreatedBy         string         `gorm:"size:36;not null" json:"created_by"`                                                                      // 创建人ID
+	CreatedAt         time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3)" json:"created_at"`                                // 创建时间
+	UpdatedBy         *string        `gorm:"size:36" json:"updated_by,omitempty"`                                                                     // 最后更新人ID
+	UpdatedAt         time.Time      `gorm:"type:datetime(3);not null;default:CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3)" json:"updated_at"` // 最后更新时间
+	DeletedAt         gorm.DeletedAt `gorm:"type:datetime(3);index:uk_code_deleted" json:"-"`                                                         // 删除时间（软删除，不返回）
+}
+
+// TableName 指定表名
+func (Role) TableName() string {
+	return "roles"
+}
+
+// ListFilter 查询角色列表请求参数
+type ListFilter struct {
+	Keyword    string // 搜索关键词（name/code）
+	Scope      string // 适用范围筛选
+	OrgId      string // 组织范围筛选
+	TemplateId string // 来源模板筛选
+	Page       int    // 页码（从1开始）
+	PageSize   int    // 每页数量
+}
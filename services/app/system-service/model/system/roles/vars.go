@@ -0,0 +1,34 @@
+package roles
+
+import (
+	"github.com/jinguoxing/idrm-go-base/errorx"
+)
+
+var (
+	// ErrRoleNotFound 角色不存在
+	ErrRoleNotFound = errorx.New(200180, "角色不存在")
+
+	// ErrRoleCodeExists 角色编码已存在
+	ErrRoleCodeExists = errorx.New(200181, "角色编码已存在")
+
+	// ErrRoleInvalidTemplateVersion 模板版本号无效
+	ErrRoleInvalidTemplateVersion = errorx.New(200182, "模板版本号无效，不能大于模板当前版本")
+
+	// ErrRoleOrgRequired 组织范围角色未指定组织
+	ErrRoleOrgRequired = errorx.New(200183, "组织范围角色必须指定组织")
+
+	// ErrRoleInUse 角色已被绑定，无法删除
+	ErrRoleInUse = errorx.New(200184, "角色已被用户绑定，无法删除")
+)
+
+// 适用范围常量（与权限模板推荐适用范围一致）
+const (
+	ScopeGlobal       = "global"
+	ScopeOrganization = "organization"
+	ScopeDomain       = "domain"
+	ScopeProject      = "project"
+	// 组织架构数据范围：本部门、本部门及下级部门、仅本人
+	ScopeDepartment     = "department"
+	ScopeDepartmentTree = "department_tree"
+	ScopeSelf           = "self"
+)
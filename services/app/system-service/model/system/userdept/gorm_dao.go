@@ -58,6 +58,19 @@ func (m *gormDAO) FindByUserId(ctx context.Context, userId string) ([]*SysUserDe
 	return data, nil
 }
 
+// FindByUserIds 批量查询多个用户的部门关联
+func (m *gormDAO) FindByUserIds(ctx context.Context, userIds []string) ([]*SysUserDept, error) {
+	var data []*SysUserDept
+	if len(userIds) == 0 {
+		return data, nil
+	}
+	err := m.db.WithContext(ctx).Where("user_id IN ?", userIds).Find(&data).Error
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
 // FindPrimaryByUserId 查询用户的主部门
 func (m *gormDAO) FindPrimaryByUserId(ctx context.Context, userId string) (*SysUserDept, error) {
 	var data SysUserDept
@@ -35,6 +35,9 @@ type Model interface {
 	// FindByUserId 查询用户的所有部门关联
 	FindByUserId(ctx context.Context, userId string) ([]*SysUserDept, error)
 
+	// FindByUserIds 批量查询多个用户的部门关联
+	FindByUserIds(ctx context.Context, userIds []string) ([]*SysUserDept, error)
+
 	// FindPrimaryByUserId 查询用户的主部门
 	FindPrimaryByUserId(ctx context.Context, userId string) (*SysUserDept, error)
 
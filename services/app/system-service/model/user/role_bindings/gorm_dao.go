@@ -25,6 +25,50 @@ func (m *gormRoleBindingModel) FindByUserId(ctx context.Context, userId string)
 	return roleBindings, nil
 }
 
+// FindByUserIds 根据多个用户ID批量查询角色绑定列表
+func (m *gormRoleBindingModel) FindByUserIds(ctx context.Context, userIds []string) ([]*RoleBinding, error) {
+	var roleBindings []*RoleBinding
+	if len(userIds) == 0 {
+		return roleBindings, nil
+	}
+	err := m.db.WithContext(ctx).Where("user_id IN ?", userIds).Find(&roleBindings).Error
+	if err != nil {
+		return nil, err
+	}
+	return roleBindings, nil
+}
+
+// CountByRoleId 统计引用指定角色的绑定数量
+func (m *gormRoleBindingModel) CountByRoleId(ctx context.Context, roleId string) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).Model(&RoleBinding{}).Where("role_id = ?", roleId).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// FindUserIdsByRoleRefs 查询引用指定角色ID或权限角色编码的用户ID（去重、升序）
+func (m *gormRoleBindingModel) FindUserIdsByRoleRefs(ctx context.Context, roleIds []string, permissionRoles []string) ([]string, error) {
+	userIds := make([]string, 0)
+	query := m.db.WithContext(ctx).Model(&RoleBinding{})
+	switch {
+	case len(roleIds) > 0 && len(permissionRoles) > 0:
+		query = query.Where("role_id IN ? OR permission_role IN ?", roleIds, permissionRoles)
+	case len(roleIds) > 0:
+		query = query.Where("role_id IN ?", roleIds)
+	case len(permissionRoles) > 0:
+		query = query.Where("permission_role IN ?", permissionRoles)
+	default:
+		return userIds, nil
+	}
+	err := query.Distinct("user_id").Order("user_id").Pluck("user_id", &userIds).Error
+	if err != nil {
+		return nil, err
+	}
+	return userIds, nil
+}
+
 // FindOne 根据 ID 查询
 func (m *gormRoleBindingModel) FindOne(ctx context.Context, id int64) (*RoleBinding, error) {
 	var roleBinding RoleBinding
@@ -318,3 +318,69 @@ func TestDeleteByUserId_MultipleUsers_OnlyDeletesTargetUser(t *testing.T) {
 	assert.Equal(t, userID2.String(), user2After[0].UserId)
 	assert.Equal(t, userID2.String(), user2After[1].UserId)
 }
+
+// TestCountByRoleId_ReturnsBindingCount 测试统计引用指定角色的绑定数量
+func TestCountByRoleId_ReturnsBindingCount(t *testing.T) {
+	db := setupTestDB(t)
+	model := NewModel(db)
+	ctx := context.Background()
+
+	// 准备测试数据 - 两个绑定引用 role-a，一个引用 role-b，一个未引用角色
+	roleA := "role-a"
+	roleB := "role-b"
+	userID1, _ := uuid.NewV7()
+	userID2, _ := uuid.NewV7()
+	bindings := []*RoleBinding{
+		{UserId: userID1.String(), OrgId: "org-001", RoleId: &roleA},
+		{UserId: userID2.String(), OrgId: "org-001", RoleId: &roleA},
+		{UserId: userID2.String(), OrgId: "org-002", RoleId: &roleB},
+		{UserId: userID1.String(), OrgId: "org-002"},
+	}
+	for _, binding := range bindings {
+		_, err := model.Insert(ctx, binding)
+		require.NoError(t, err)
+	}
+
+	// 统计并验证
+	count, err := model.CountByRoleId(ctx, roleA)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = model.CountByRoleId(ctx, "role-none")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+// TestFindUserIdsByRoleRefs_ReturnsDistinctUserIds 测试按角色ID或权限角色编码查询去重用户ID
+func TestFindUserIdsByRoleRefs_ReturnsDistinctUserIds(t *testing.T) {
+	db := setupTestDB(t)
+	model := NewModel(db)
+	ctx := context.Background()
+
+	// 准备测试数据 - user-1 两个绑定引用 role-a，user-2 通过历史编码绑定，user-3 引用其他角色
+	roleA := "role-a"
+	roleB := "role-b"
+	legacyCode := "user_admin"
+	bindings := []*RoleBinding{
+		{UserId: "user-1", OrgId: "org-001", RoleId: &roleA},
+		{UserId: "user-1", OrgId: "org-002", RoleId: &roleA},
+		{UserId: "user-2", OrgId: "org-001", PermissionRole: &legacyCode},
+		{UserId: "user-3", OrgId: "org-001", RoleId: &roleB},
+	}
+	for _, binding := range bindings {
+		_, err := model.Insert(ctx, binding)
+		require.NoError(t, err)
+	}
+
+	userIds, err := model.FindUserIdsByRoleRefs(ctx, []string{roleA}, []string{legacyCode})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user-1", "user-2"}, userIds)
+
+	userIds, err = model.FindUserIdsByRoleRefs(ctx, []string{roleB}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user-3"}, userIds)
+
+	userIds, err = model.FindUserIdsByRoleRefs(ctx, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, userIds)
+}
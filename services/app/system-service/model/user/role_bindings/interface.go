@@ -12,6 +12,15 @@ type Model interface {
 	// FindByUserId 根据用户ID查询角色绑定列表
 	FindByUserId(ctx context.Context, userId string) ([]*RoleBinding, error)
 
+	// FindByUserIds 根据多个用户ID批量查询角色绑定列表
+	FindByUserIds(ctx context.Context, userIds []string) ([]*RoleBinding, error)
+
+	// CountByRoleId 统计引用指定角色的绑定数量
+	CountByRoleId(ctx context.Context, roleId string) (int64, error)
+
+	// FindUserIdsByRoleRefs 查询引用指定角色ID或权限角色编码的用户ID（去重、升序）
+	FindUserIdsByRoleRefs(ctx context.Context, roleIds []string, permissionRoles []string) ([]string, error)
+
 	// FindOne 根据 ID 查询
 	FindOne(ctx context.Context, id int64) (*RoleBinding, error)
 
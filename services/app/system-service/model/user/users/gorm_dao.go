@@ -77,7 +77,7 @@ func (m *gormUserModel) FindList(ctx context.Context, req *FindListReq) ([]*User
 	var users []*User
 	var total int64
 
-	query := m.db.WithContext(ctx).Model(&User{})
+	query := m.scoped(ctx, req.Scope)
 
 	// 应用筛选条件
 	if req.Keyword != "" {
@@ -98,9 +98,11 @@ func (m *gormUserModel) FindList(ctx context.Context, req *FindListReq) ([]*User
 		query = query.Where("id IN (SELECT user_id FROM role_bindings WHERE permission_role = ?)", req.PermissionRole)
 	}
 
-	// 获取总数
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	// 获取总数（游标分页时由调用方在首批查询中获取）
+	if req.Cursor == nil {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
 	// 应用排序
@@ -123,10 +125,27 @@ func (m *gormUserModel) FindList(ctx context.Context, req *FindListReq) ([]*User
 	if sortOrder != "asc" && sortOrder != "desc" {
 		sortOrder = "desc"
 	}
-	query = query.Order(sortField + " " + sortOrder)
+	// 游标分页：按 created_at、id 定位到上一批最后一条之后，避免翻页期间增删用户导致漏读或重复
+	if req.Cursor != nil {
+		sortField = "created_at"
+		if sortOrder == "asc" {
+			query = query.Where("(created_at > ? OR (created_at = ? AND id > ?))",
+				req.Cursor.CreatedAt, req.Cursor.CreatedAt, req.Cursor.Id)
+		} else {
+			query = query.Where("(created_at < ? OR (created_at = ? AND id < ?))",
+				req.Cursor.CreatedAt, req.Cursor.CreatedAt, req.Cursor.Id)
+		}
+	}
+
+	// 追加主键排序，保证分页结果稳定
+	query = query.Order(sortField + " " + sortOrder).Order("id " + sortOrder)
 
 	// 应用分页
-	if req.PageSize > 0 {
+	if req.Cursor != nil {
+		if req.PageSize > 0 {
+			query = query.Limit(req.PageSize)
+		}
+	} else if req.PageSize > 0 {
 		offset := (req.Page - 1) * req.PageSize
 		if offset < 0 {
 			offset = 0
@@ -289,12 +308,12 @@ func (m *gormUserModel) WithTx(tx interface{}) Model {
 }
 
 // GetStatistics 获取用户统计信息
-func (m *gormUserModel) GetStatistics(ctx context.Context) (*Statistics, error) {
+func (m *gormUserModel) GetStatistics(ctx context.Context, scope *DataScopeFilter) (*Statistics, error) {
 	stats := &Statistics{}
 
 	// 1. 统计总用户数
 	var total int64
-	err := m.db.WithContext(ctx).Model(&User{}).Count(&total).Error
+	err := m.scoped(ctx, scope).Count(&total).Error
 	if err != nil {
 		return nil, err
 	}
@@ -304,21 +323,21 @@ func (m *gormUserModel) GetStatistics(ctx context.Context) (*Statistics, error)
 	var activeCount, lockedCount, inactiveCount int64
 
 	// 启用状态（status=1）
-	err = m.db.WithContext(ctx).Model(&User{}).Where("status = ?", 1).Count(&activeCount).Error
+	err = m.scoped(ctx, scope).Where("status = ?", 1).Count(&activeCount).Error
 	if err != nil {
 		return nil, err
 	}
 	stats.Active = activeCount
 
 	// 锁定状态（status=3）
-	err = m.db.WithContext(ctx).Model(&User{}).Where("status = ?", 3).Count(&lockedCount).Error
+	err = m.scoped(ctx, scope).Where("status = ?", 3).Count(&lockedCount).Error
 	if err != nil {
 		return nil, err
 	}
 	stats.Locked = lockedCount
 
 	// 停用状态（status=2）
-	err = m.db.WithContext(ctx).Model(&User{}).Where("status = ?", 2).Count(&inactiveCount).Error
+	err = m.scoped(ctx, scope).Where("status = ?", 2).Count(&inactiveCount).Error
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +345,7 @@ func (m *gormUserModel) GetStatistics(ctx context.Context) (*Statistics, error)
 
 	// 3. 统计无组织归属用户数（dept_id为空或NULL）
 	var noOrgBindingCount int64
-	err = m.db.WithContext(ctx).Model(&User{}).Where("dept_id IS NULL OR dept_id = ''").Count(&noOrgBindingCount).Error
+	err = m.scoped(ctx, scope).Where("dept_id IS NULL OR dept_id = ''").Count(&noOrgBindingCount).Error
 	if err != nil {
 		return nil, err
 	}
@@ -338,7 +357,7 @@ func (m *gormUserModel) GetStatistics(ctx context.Context) (*Statistics, error)
 	subquery := m.db.WithContext(ctx).Table("role_bindings").
 		Select("DISTINCT user_id").
 		Where("permission_role IS NOT NULL AND permission_role != ''")
-	err = m.db.WithContext(ctx).Model(&User{}).
+	err = m.scoped(ctx, scope).
 		Where("id NOT IN (?)", subquery).
 		Count(&noPermissionRoleCount).Error
 	if err != nil {
@@ -349,7 +368,7 @@ func (m *gormUserModel) GetStatistics(ctx context.Context) (*Statistics, error)
 	// 5. 计算近7天活跃率（有last_login_at且在7天内的用户数/总用户数）
 	var recentActiveCount int64
 	sevenDaysAgo := time.Now().AddDate(0, 0, -7)
-	err = m.db.WithContext(ctx).Model(&User{}).
+	err = m.scoped(ctx, scope).
 		Where("last_login_at IS NOT NULL AND last_login_at >= ?", sevenDaysAgo).
 		Count(&recentActiveCount).Error
 	if err != nil {
@@ -366,6 +385,18 @@ func (m *gormUserModel) GetStatistics(ctx context.Context) (*Statistics, error)
 	return stats, nil
 }
 
+// scoped 返回按数据范围过滤的用户查询
+func (m *gormUserModel) scoped(ctx context.Context, scope *DataScopeFilter) *gorm.DB {
+	query := m.db.WithContext(ctx).Model(&User{})
+	if scope == nil {
+		return query
+	}
+	if len(scope.DeptIds) == 0 {
+		return query.Where("id = ?", scope.UserId)
+	}
+	return query.Where("(dept_id IN ? OR id = ?)", scope.DeptIds, scope.UserId)
+}
+
 // Trans 执行事务
 func (m *gormUserModel) Trans(ctx context.Context, fn func(ctx context.Context, model Model) error) error {
 	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
@@ -2,6 +2,7 @@ package users
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"sync"
 	"testing"
@@ -542,6 +543,70 @@ func TestFindList_Pagination_ReturnsCorrectPage(t *testing.T) {
 	assert.NotEqual(t, result1[0].Id, result2[0].Id)
 }
 
+// TestFindList_CursorPagination_StableWhenUsersChange 测试游标分页期间新增、删除用户不会漏读或重复
+func TestFindList_CursorPagination_StableWhenUsersChange(t *testing.T) {
+	db := setupTestDB(t)
+	model := NewModel(db)
+	ctx := context.Background()
+
+	// 准备 7 个用户，部分创建时间相同，验证按 id 定位
+	base := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	var expected []string
+	for i := 0; i < 7; i++ {
+		user := &User{
+			Id:           fmt.Sprintf("user-%d", i),
+			Name:         fmt.Sprintf("User %d", i),
+			Email:        fmt.Sprintf("cursor%d@example.com", i),
+			PasswordHash: "hash",
+			Status:       1,
+			CreatedAt:    base.Add(time.Duration(i/2) * time.Minute),
+		}
+		_, err := model.Insert(ctx, user)
+		require.NoError(t, err)
+		expected = append([]string{user.Id}, expected...)
+	}
+
+	req := &FindListReq{PageSize: 3}
+	first, total, err := model.FindList(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), total)
+	require.Len(t, first, 3)
+
+	// 翻页期间新增更新的用户、删除已读取的用户
+	_, err = model.Insert(ctx, &User{
+		Id: "user-new", Name: "User New", Email: "cursor-new@example.com",
+		PasswordHash: "hash", Status: 1, CreatedAt: base.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Unscoped().Delete(&User{}, "id = ?", first[0].Id).Error)
+
+	var ids []string
+	list := first
+	for {
+		for _, user := range list {
+			ids = append(ids, user.Id)
+		}
+		if len(list) < req.PageSize {
+			break
+		}
+		last := list[len(list)-1]
+		req.Cursor = &Cursor{CreatedAt: last.CreatedAt, Id: last.Id}
+		list, _, err = model.FindList(ctx, req)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, expected, ids)
+
+	// 升序游标（user-6 已删除）
+	req = &FindListReq{PageSize: 4, SortOrder: "asc", Cursor: &Cursor{CreatedAt: base.Add(time.Minute), Id: "user-2"}}
+	list, _, err = model.FindList(ctx, req)
+	require.NoError(t, err)
+	ids = nil
+	for _, user := range list {
+		ids = append(ids, user.Id)
+	}
+	assert.Equal(t, []string{"user-3", "user-4", "user-5", "user-new"}, ids)
+}
+
 // TestFindList_KeywordSearch_ReturnsFilteredResults 测试关键词搜索
 func TestFindList_KeywordSearch_ReturnsFilteredResults(t *testing.T) {
 	db := setupTestDB(t)
@@ -684,6 +749,51 @@ func TestFindList_MultiDimensionFilter_ReturnsFilteredResults(t *testing.T) {
 	assert.Equal(t, user1ID.String(), result3[0].Id)
 }
 
+// TestFindList_DataScope_ReturnsScopedUsers 测试按数据范围过滤用户列表和统计
+func TestFindList_DataScope_ReturnsScopedUsers(t *testing.T) {
+	db := setupTestDBWithRoleBindings(t)
+	model := NewModel(db)
+	ctx := context.Background()
+
+	deptA, deptB := "dept-a", "dept-b"
+	var ids []string
+	for i, deptId := range []*string{&deptA, &deptB, nil} {
+		id, _ := uuid.NewV7()
+		_, err := model.Insert(ctx, &User{
+			Id:            id.String(),
+			Name:          fmt.Sprintf("user-%d", i),
+			Email:         fmt.Sprintf("user-%d@example.com", i),
+			DeptId:        deptId,
+			Status:        1,
+			AccountSource: "local",
+		})
+		require.NoError(t, err)
+		ids = append(ids, id.String())
+	}
+
+	// 部门范围内的用户及本人
+	result, total, err := model.FindList(ctx, &FindListReq{
+		Page: 1, PageSize: 10, SortField: "name", SortOrder: "asc",
+		Scope: &DataScopeFilter{DeptIds: []string{deptA}, UserId: ids[2]},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, result, 2)
+	assert.Equal(t, ids[0], result[0].Id)
+	assert.Equal(t, ids[2], result[1].Id)
+
+	// 仅本人
+	result, total, err = model.FindList(ctx, &FindListReq{Page: 1, PageSize: 10, Scope: &DataScopeFilter{UserId: ids[1]}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, ids[1], result[0].Id)
+
+	stats, err := model.GetStatistics(ctx, &DataScopeFilter{DeptIds: []string{deptA, deptB}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.Total)
+	assert.Equal(t, int64(0), stats.NoOrgBinding)
+}
+
 // TestFindList_SortFunction_ReturnsSortedResults 测试排序功能
 func TestFindList_SortFunction_ReturnsSortedResults(t *testing.T) {
 	db := setupTestDB(t)
@@ -1286,7 +1396,7 @@ func TestGetStatistics_ValidData_ReturnsStatistics(t *testing.T) {
 	require.NoError(t, err)
 
 	// 执行统计
-	stats, err := model.GetStatistics(ctx)
+	stats, err := model.GetStatistics(ctx, nil)
 
 	// 验证结果
 	require.NoError(t, err)
@@ -1307,7 +1417,7 @@ func TestGetStatistics_EmptyDatabase_ReturnsZero(t *testing.T) {
 	ctx := context.Background()
 
 	// 执行统计
-	stats, err := model.GetStatistics(ctx)
+	stats, err := model.GetStatistics(ctx, nil)
 
 	// 验证结果
 	require.NoError(t, err)
@@ -1365,7 +1475,7 @@ func TestGetStatistics_WithRoleBindings_CalculatesNoPermissionRole(t *testing.T)
 	// 由于没有 role_bindings，两个用户都应该被统计为无权限角色
 
 	// 执行统计
-	stats, err := model.GetStatistics(ctx)
+	stats, err := model.GetStatistics(ctx, nil)
 
 	// 验证结果
 	require.NoError(t, err)
@@ -14,8 +14,22 @@ type FindListReq struct {
 	Status         *int8 // 使用指针以支持 0 值的筛选
 	AccountSource  string
 	PermissionRole string
-	SortField      string // name, created_at, last_login_at
-	SortOrder      string // asc, desc
+	SortField      string           // name, created_at, last_login_at
+	SortOrder      string           // asc, desc
+	Scope          *DataScopeFilter // 数据范围过滤，nil 表示不限制
+	Cursor         *Cursor          // 上一批最后一条用户，不为空时按 created_at、id 游标分页（忽略 Page 和 SortField，不统计总数）
+}
+
+// Cursor 游标分页位置（按 created_at、id 定位）
+type Cursor struct {
+	CreatedAt time.Time
+	Id        string
+}
+
+// DataScopeFilter 数据范围过滤条件：主部门在 DeptIds 中的用户，以及 UserId 本人
+type DataScopeFilter struct {
+	DeptIds []string
+	UserId  string
 }
 
 // Model 用户数据访问接口
@@ -56,9 +70,9 @@ type Model interface {
 	// 返回：成功更新的用户ID列表和失败的用户ID及原因
 	BatchUpdateStatus(ctx context.Context, userIds []string, status int8, lockReason *string, lockBy *string) ([]string, []BatchUpdateError, error)
 
-	// GetStatistics 获取用户统计信息
+	// GetStatistics 获取用户统计信息（scope 为 nil 时统计全部用户）
 	// 返回：总用户数、各状态用户数、无组织归属用户数、无权限角色用户数、近7天活跃率
-	GetStatistics(ctx context.Context) (*Statistics, error)
+	GetStatistics(ctx context.Context, scope *DataScopeFilter) (*Statistics, error)
 
 	// Delete 删除用户（软删除）
 	Delete(ctx context.Context, id string) error